@@ -23,4 +23,7 @@ type FeegrantKeeper interface {
 type SwingsetKeeper interface {
 	InboundQueueLength(ctx sdk.Context) (int32, error)
 	GetState(ctx sdk.Context) swingtypes.State
+	GetParams(ctx sdk.Context) swingtypes.Params
+	GetInboundSenderCount(ctx sdk.Context, addr sdk.AccAddress, windowBlocks uint32) uint32
+	AddInboundSenderCount(ctx sdk.Context, addr sdk.AccAddress, n uint32)
 }
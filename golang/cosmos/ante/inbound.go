@@ -1,6 +1,7 @@
 package ante
 
 import (
+	sdkioerrors "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -32,6 +33,13 @@ the number of messages allowed for mempool admission as if its max
 queue length was lower (e.g. 50%). This is the QueueInboundMempool
 entry in the Swingset state QueueAllowed field. At DeliverTx time
 the QueueInbound entry gives the number of allowed messages.
+
+Independently of the queue size, the InboundRateLimit in the Swingset params
+bounds how many inbound messages a single sender may submit within a sliding
+window of blocks, so that one noisy account cannot consume the allowance of
+everyone else. Senders of high priority messages are exempt. The limit is
+enforced in both CheckTx and DeliverTx, counting only the messages which were
+otherwise admitted.
 */
 
 const (
@@ -41,6 +49,9 @@ const (
 // TODO: We don't have a more appropriate error type for this.
 var ErrInboundQueueFull = sdkerrors.ErrMempoolIsFull
 
+// ErrInboundRateLimited is wrapped with the details of the exceeded limit.
+var ErrInboundRateLimited = swingtypes.ErrInboundRateLimited
+
 // inboundAnte is an sdk.AnteDecorator which enforces the allowed size of the inbound queue.
 type inboundAnte struct {
 	sk SwingsetKeeper
@@ -57,8 +68,11 @@ func NewInboundDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
 func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	msgs := tx.GetMsgs()
 	inboundsAllowed := int32(-1)
-	for _, msg := range msgs {
-		inbounds := inboundMessages(msg)
+	for i, msg := range msgs {
+		inbounds, err := ia.inboundMessages(ctx, msg, msgs[:i])
+		if err != nil {
+			return ctx, err
+		}
 		if inbounds == 0 {
 			continue
 		}
@@ -89,10 +103,52 @@ func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 			}()
 			return ctx, ErrInboundQueueFull
 		}
+		if !isHighPriority {
+			if err := ia.checkSenderRate(ctx, msg, inbounds); err != nil {
+				return ctx, err
+			}
+		}
 	}
 	return next(ctx, tx, simulate)
 }
 
+// checkSenderRate enforces the per-sender inbound rate limit on msg, and if it
+// is allowed, counts its inbound messages against the sender.
+func (ia inboundAnte) checkSenderRate(ctx sdk.Context, msg sdk.Msg, inbounds int32) error {
+	rateLimit := ia.sk.GetParams(ctx).InboundRateLimit
+	if !rateLimit.IsEnabled() {
+		return nil
+	}
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return nil
+	}
+	sender := signers[0]
+
+	count := ia.sk.GetInboundSenderCount(ctx, sender, rateLimit.WindowBlocks)
+	if uint64(count)+uint64(inbounds) > uint64(rateLimit.MaxMessages) {
+		mode := "deliver"
+		if ctx.IsCheckTx() {
+			mode = "check"
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "ante", "inbound_rate_limited"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("msg", sdk.MsgTypeURL(msg)),
+				telemetry.NewLabel("mode", mode),
+			},
+		)
+		return sdkioerrors.Wrapf(
+			ErrInboundRateLimited,
+			"sender exceeded %d inbound messages in %d blocks",
+			rateLimit.MaxMessages, rateLimit.WindowBlocks,
+		)
+	}
+	ia.sk.AddInboundSenderCount(ctx, sender, uint32(inbounds))
+	return nil
+}
+
 func (ia inboundAnte) isPriorityMessage(ctx sdk.Context, msg sdk.Msg) (bool, error) {
 	if c, ok := msg.(vm.ControllerAdmissionMsg); ok {
 		return c.IsHighPriority(ctx, ia.sk)
@@ -128,10 +184,14 @@ func (ia inboundAnte) allowedInbound(ctx sdk.Context) (int32, error) {
 	return allowed, nil
 }
 
-// inboundMessages returns the nunber of inbound queue messages in msg.
-func inboundMessages(msg sdk.Msg) int32 {
+// inboundMessages returns the nunber of inbound queue messages in msg, which
+// is preceded in its Tx by precedingMsgs.
+func (ia inboundAnte) inboundMessages(ctx sdk.Context, msg sdk.Msg, precedingMsgs []sdk.Msg) (int32, error) {
+	if c, ok := msg.(vm.StatefulInboundMsgCounter); ok {
+		return c.GetStatefulInboundMsgCount(ctx, ia.sk, precedingMsgs)
+	}
 	if c, ok := msg.(vm.ControllerAdmissionMsg); ok {
-		return c.GetInboundMsgCount()
+		return c.GetInboundMsgCount(), nil
 	}
-	return 0
+	return 0, nil
 }
@@ -27,6 +27,10 @@ func TestInboundAnteHandle(t *testing.T) {
 		mempoolLimit          int32
 		errMsg                string
 		isHighPriorityOwner   bool
+		rateLimit             swingtypes.InboundRateLimit
+		senderCount           uint32
+		uploadFound           bool
+		remainingChunks       uint32
 	}{
 		{
 			name: "empty-empty",
@@ -126,6 +130,92 @@ func TestInboundAnteHandle(t *testing.T) {
 			isHighPriorityOwner: true,
 			inboundLimit:        1,
 		},
+		{
+			name:               "rate-limit-under",
+			tx:                 makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			rateLimit:          swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 3},
+			senderCount:        2,
+		},
+		{
+			name:               "rate-limit-exceeded",
+			tx:                 makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			rateLimit:          swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 3},
+			senderCount:        3,
+			errMsg:             "sender exceeded 3 inbound messages in 5 blocks: " + ErrInboundRateLimited.Error(),
+		},
+		{
+			name:               "rate-limit-checktx",
+			checkTx:            true,
+			tx:                 makeTestTx(&swingtypes.MsgWalletAction{}),
+			mempoolLimit:       10,
+			inboundQueueLength: 5,
+			rateLimit:          swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 3},
+			senderCount:        3,
+			errMsg:             "sender exceeded 3 inbound messages in 5 blocks: " + ErrInboundRateLimited.Error(),
+		},
+		{
+			name:               "rate-limit-disabled",
+			tx:                 makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			rateLimit:          swingtypes.InboundRateLimit{WindowBlocks: 0, MaxMessages: 3},
+			senderCount:        3,
+		},
+		{
+			name:                "rate-limit-priority-exempt",
+			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			isHighPriorityOwner: true,
+			rateLimit:           swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 3},
+			senderCount:         3,
+		},
+		{
+			name:         "rate-limit-non-swingset-msgs",
+			tx:           makeTestTx(&banktypes.MsgSend{}, &banktypes.MsgSend{}),
+			inboundLimit: 1,
+			rateLimit:    swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 1},
+			senderCount:  1,
+		},
+		{
+			name:               "upload-chunk-not-final",
+			tx:                 makeTestTx(&swingtypes.MsgUploadBundleChunk{}),
+			inboundLimit:       10,
+			inboundQueueLength: 10,
+			rateLimit:          swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 3},
+			senderCount:        3,
+			uploadFound:        true,
+			remainingChunks:    2,
+		},
+		{
+			name:               "upload-chunk-final",
+			tx:                 makeTestTx(&swingtypes.MsgUploadBundleChunk{}),
+			inboundLimit:       10,
+			inboundQueueLength: 10,
+			uploadFound:        true,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
+		{
+			name:               "upload-chunk-final-in-tx",
+			tx:                 makeTestTx(&swingtypes.MsgUploadBundleChunk{Index: 1}, &swingtypes.MsgUploadBundleChunk{Index: 2}),
+			inboundLimit:       10,
+			inboundQueueLength: 5,
+			rateLimit:          swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 3},
+			senderCount:        3,
+			uploadFound:        true,
+			remainingChunks:    1,
+			errMsg:             "sender exceeded 3 inbound messages in 5 blocks: " + ErrInboundRateLimited.Error(),
+		},
+		{
+			name:               "upload-chunk-not-begun",
+			tx:                 makeTestTx(&swingtypes.MsgUploadBundleChunk{}),
+			inboundLimit:       10,
+			inboundQueueLength: 10,
+			remainingChunks:    2,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(tt.checkTx)
@@ -140,6 +230,10 @@ func TestInboundAnteHandle(t *testing.T) {
 				mempoolLimit:          tt.mempoolLimit,
 				emptyQueueAllowed:     emptyQueueAllowed,
 				isHighPriorityOwner:   tt.isHighPriorityOwner,
+				rateLimit:             tt.rateLimit,
+				senderCount:           tt.senderCount,
+				uploadFound:           tt.uploadFound,
+				remainingChunks:       tt.remainingChunks,
 			}
 			decorator := NewInboundDecorator(mock)
 			newCtx, err := decorator.AnteHandle(ctx, tt.tx, tt.simulate, nilAnteHandler)
@@ -159,6 +253,37 @@ func TestInboundAnteHandle(t *testing.T) {
 	}
 }
 
+func TestInboundAnteCountsSender(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	added := uint32(0)
+	mock := mockSwingsetKeeper{
+		inboundLimit:     10,
+		rateLimit:        swingtypes.InboundRateLimit{WindowBlocks: 5, MaxMessages: 3},
+		senderCount:      1,
+		addedSenderCount: &added,
+	}
+	decorator := NewInboundDecorator(mock)
+
+	_, err := decorator.AnteHandle(ctx, makeTestTx(&swingtypes.MsgWalletAction{}), false, nilAnteHandler)
+	if err != nil {
+		t.Fatalf("want no error, got %s", err)
+	}
+	if added != 1 {
+		t.Errorf("want 1 message counted against the sender, got %d", added)
+	}
+
+	// Messages rejected for a full queue are not counted.
+	mock.inboundQueueLength = 10
+	decorator = NewInboundDecorator(mock)
+	_, err = decorator.AnteHandle(ctx, makeTestTx(&swingtypes.MsgWalletAction{}), false, nilAnteHandler)
+	if err == nil {
+		t.Fatalf("want queue full error, got none")
+	}
+	if added != 1 {
+		t.Errorf("want 1 message counted against the sender, got %d", added)
+	}
+}
+
 func makeTestTx(msgs ...proto.Message) sdk.Tx {
 	wrappedMsgs := make([]*types.Any, len(msgs))
 	for i, m := range msgs {
@@ -186,6 +311,11 @@ type mockSwingsetKeeper struct {
 	mempoolLimit          int32
 	emptyQueueAllowed     bool
 	isHighPriorityOwner   bool
+	rateLimit             swingtypes.InboundRateLimit
+	senderCount           uint32
+	addedSenderCount      *uint32
+	uploadFound           bool
+	remainingChunks       uint32
 }
 
 var _ SwingsetKeeper = mockSwingsetKeeper{}
@@ -207,6 +337,20 @@ func (msk mockSwingsetKeeper) GetState(ctx sdk.Context) swingtypes.State {
 	}
 }
 
+func (msk mockSwingsetKeeper) GetParams(ctx sdk.Context) swingtypes.Params {
+	return swingtypes.Params{InboundRateLimit: msk.rateLimit}
+}
+
+func (msk mockSwingsetKeeper) GetInboundSenderCount(ctx sdk.Context, addr sdk.AccAddress, windowBlocks uint32) uint32 {
+	return msk.senderCount
+}
+
+func (msk mockSwingsetKeeper) AddInboundSenderCount(ctx sdk.Context, addr sdk.AccAddress, n uint32) {
+	if msk.addedSenderCount != nil {
+		*msk.addedSenderCount += n
+	}
+}
+
 func (msk mockSwingsetKeeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
 	return msk.isHighPriorityOwner, nil
 }
@@ -226,3 +370,11 @@ func (msk mockSwingsetKeeper) GetSmartWalletState(ctx sdk.Context, addr sdk.AccA
 func (msk mockSwingsetKeeper) ChargeForSmartWallet(ctx sdk.Context, addr sdk.AccAddress) error {
 	return fmt.Errorf("not implemented")
 }
+
+func (msk mockSwingsetKeeper) GetSubmittedBundle(ctx sdk.Context, bundleID string) (swingtypes.SubmittedBundle, bool) {
+	panic(fmt.Errorf("not implemented"))
+}
+
+func (msk mockSwingsetKeeper) GetRemainingBundleUploadChunks(ctx sdk.Context, submitter sdk.AccAddress, payloadHash string, index uint32) (uint32, bool) {
+	return msk.remainingChunks, msk.uploadFound
+}
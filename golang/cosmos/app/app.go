@@ -141,6 +141,21 @@ const appName = "agoric"
 // TODO: document this flag in config, likely alongside the genesis path
 const FlagSwingStoreExportDir = "swing-store-export-dir"
 
+// FlagSwingStoreSnapshotExportTimeout is the app.toml setting bounding the
+// swing-store export of a state-sync snapshot, which defaults to
+// swingsetkeeper.DefaultSnapshotExportTimeout.
+const FlagSwingStoreSnapshotExportTimeout = "swingset.snapshot-export-timeout"
+
+// FlagRecordBridge specifies a JSONL file in which to record the messages
+// exchanged with the controller, for diagnosis with `agd debug replay-bridge`.
+// The log is rotated once it exceeds FlagRecordBridgeMaxSize bytes, keeping
+// FlagRecordBridgeMaxFiles rotated logs.
+const (
+	FlagRecordBridge         = "record-bridge"
+	FlagRecordBridgeMaxSize  = "record-bridge-max-size"
+	FlagRecordBridgeMaxFiles = "record-bridge-max-files"
+)
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -200,8 +215,9 @@ var (
 )
 
 var (
-	_ simapp.App              = (*GaiaApp)(nil)
-	_ servertypes.Application = (*GaiaApp)(nil)
+	_ simapp.App                          = (*GaiaApp)(nil)
+	_ servertypes.Application             = (*GaiaApp)(nil)
+	_ servertypes.ApplicationQueryService = (*GaiaApp)(nil)
 )
 
 // GaiaApp extends an ABCI application, but with most of its parameters exported.
@@ -329,7 +345,7 @@ func NewAgoricApp(
 		capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey, icahosttypes.StoreKey,
 		swingset.StoreKey, vstorage.StoreKey, vibc.StoreKey, vlocalchain.StoreKey, vbank.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, swingset.TStoreKey, vbank.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &GaiaApp{
@@ -374,13 +390,19 @@ func NewAgoricApp(
 		appName,
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
-		appCodec,
-		keys[banktypes.StoreKey],
-		app.AccountKeeper,
-		app.GetSubspace(banktypes.ModuleName),
-		app.BlockedAddrs(),
+	// Every module moves coins through the tracking keeper, so that vbank can
+	// send updates of the balances changed in each block to the controller.
+	trackingBankKeeper := vbank.NewTrackingBankKeeper(
+		bankkeeper.NewBaseKeeper(
+			appCodec,
+			keys[banktypes.StoreKey],
+			app.AccountKeeper,
+			app.GetSubspace(banktypes.ModuleName),
+			app.BlockedAddrs(),
+		),
+		tkeys[vbank.TStoreKey],
 	)
+	app.BankKeeper = trackingBankKeeper
 	app.AuthzKeeper = authzkeeper.NewKeeper(
 		keys[authzkeeper.StoreKey],
 		appCodec,
@@ -454,7 +476,22 @@ func NewAgoricApp(
 		app.CheckControllerInited(true)
 		// We use SwingSet-level metering to charge the user for the call.
 		defer app.AgdServer.SetControllerContext(ctx)()
-		return sendToController(sdk.WrapSDKContext(ctx), true, str)
+		recordReply := app.AgdServer.RecordOutbound(ctx.BlockHeight(), str)
+		reply, err := sendToController(sdk.WrapSDKContext(ctx), true, str)
+		recordReply(reply, err)
+		return reply, err
+	}
+
+	if recordPath := cast.ToString(appOpts.Get(FlagRecordBridge)); recordPath != "" {
+		recorder, err := vm.NewTrafficRecorder(
+			recordPath,
+			cast.ToInt64(appOpts.Get(FlagRecordBridgeMaxSize)),
+			cast.ToInt(appOpts.Get(FlagRecordBridgeMaxFiles)),
+		)
+		if err != nil {
+			panic(err)
+		}
+		app.AgdServer.SetTrafficRecorder(recorder)
 	}
 
 	setBootstrapNeeded := func() {
@@ -468,10 +505,11 @@ func NewAgoricApp(
 
 	// The SwingSetKeeper is the Keeper from the SwingSet module
 	app.SwingSetKeeper = swingset.NewKeeper(
-		appCodec, keys[swingset.StoreKey], app.GetSubspace(swingset.ModuleName),
+		appCodec, keys[swingset.StoreKey], tkeys[swingset.TStoreKey], app.GetSubspace(swingset.ModuleName),
 		app.AccountKeeper, app.BankKeeper,
 		app.VstorageKeeper, vbanktypes.ReservePoolName,
 		callToController,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.swingsetPort = app.AgdServer.MustRegisterPortHandler("swingset", swingset.NewPortHandler(app.SwingSetKeeper))
 
@@ -486,7 +524,11 @@ func NewAgoricApp(
 			if err != nil {
 				return "", err
 			}
-			return sendToController(context.Background(), true, string(bz))
+			// Exports and restores run outside of block processing.
+			recordReply := app.AgdServer.RecordOutbound(0, string(bz))
+			reply, err := sendToController(context.Background(), true, string(bz))
+			recordReply(reply, err)
+			return reply, err
 		},
 	)
 
@@ -504,10 +546,14 @@ func NewAgoricApp(
 		&app.SwingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader,
 	)
+	if timeout := appOpts.Get(FlagSwingStoreSnapshotExportTimeout); timeout != nil {
+		app.SwingSetSnapshotter.SetExportTimeout(cast.ToDuration(timeout))
+	}
 
 	app.VibcKeeper = vibc.NewKeeper(
 		appCodec,
-		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper, app.CapabilityKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	).WithScope(keys[vibc.StoreKey], scopedVibcKeeper, app.SwingSetKeeper.PushAction)
 
 	vibcModule := vibc.NewAppModule(app.VibcKeeper, app.BankKeeper)
@@ -515,9 +561,10 @@ func NewAgoricApp(
 	app.vibcPort = app.AgdServer.MustRegisterPortHandler("vibc", vibc.NewReceiver(app.VibcKeeper))
 
 	app.VbankKeeper = vbank.NewKeeper(
-		appCodec, keys[vbank.StoreKey], app.GetSubspace(vbank.ModuleName),
+		appCodec, keys[vbank.StoreKey], tkeys[vbank.TStoreKey], app.GetSubspace(vbank.ModuleName),
 		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
 		app.SwingSetKeeper.PushAction,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	vbankModule := vbank.NewAppModule(app.VbankKeeper)
 	app.vbankPort = app.AgdServer.MustRegisterPortHandler("bank", vbank.NewPortHandler(vbankModule, app.VbankKeeper))
@@ -526,7 +573,7 @@ func NewAgoricApp(
 	govRouter := govv1beta1.NewRouter()
 	govRouter.
 		AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, newParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
@@ -645,7 +692,7 @@ func NewAgoricApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		vbank.NewTrackingBankAppModule(appCodec, trackingBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil),
@@ -775,7 +822,7 @@ func NewAgoricApp(
 	// transactions
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		vbank.NewTrackingBankAppModule(appCodec, trackingBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
@@ -827,6 +874,10 @@ func NewAgoricApp(
 			unreleasedUpgradeHandler(app, name),
 		)
 	}
+	app.UpgradeKeeper.SetUpgradeHandler(
+		paramsToModuleStoresUpgradeName,
+		paramsToModuleStoresUpgradeHandler(app),
+	)
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
@@ -873,6 +924,11 @@ var upgradeNamesOfThisVersion = map[string]bool{
 	"UNRELEASED_TEST_UPGRADE": true,
 }
 
+// paramsToModuleStoresUpgradeName is the upgrade that moves the swingset and
+// vbank params out of their x/params subspaces and into the module stores,
+// without the rest of the unreleased upgrade.
+const paramsToModuleStoresUpgradeName = "agoric-params-to-module-stores"
+
 func isFirstTimeUpgradeOfThisVersion(app *GaiaApp, ctx sdk.Context) bool {
 	for name := range upgradeNamesOfThisVersion {
 		if app.UpgradeKeeper.GetDoneHeight(ctx, name) != 0 {
@@ -946,6 +1002,21 @@ func unreleasedUpgradeHandler(app *GaiaApp, targetUpgrade string) func(sdk.Conte
 	}
 }
 
+// paramsToModuleStoresUpgradeHandler runs the module migrations, which include
+// moving the swingset and vbank params into their module stores.
+func paramsToModuleStoresUpgradeHandler(app *GaiaApp) func(sdk.Context, upgradetypes.Plan, module.VersionMap) (module.VersionMap, error) {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVm module.VersionMap) (module.VersionMap, error) {
+		app.CheckControllerInited(false)
+
+		// Record the plan to send to SwingSet.
+		app.upgradeDetails = &upgradeDetails{
+			Plan: plan,
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVm)
+	}
+}
+
 // normalizeModuleAccount ensures that the given account is a module account,
 // initializing or updating it if necessary. The account name must be listed in maccPerms.
 func normalizeModuleAccount(ctx sdk.Context, ak authkeeper.AccountKeeper, name string) {
@@ -1229,6 +1300,7 @@ func (app *GaiaApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	swingsetkeeper.RegisterNodeGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
@@ -1244,6 +1316,12 @@ func (app *GaiaApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
 }
 
+// RegisterNodeService implements the Application.RegisterNodeService method.
+func (app *GaiaApp) RegisterNodeService(clientCtx client.Context) {
+	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	swingsetkeeper.RegisterNodeService(app.GRPCQueryRouter())
+}
+
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *GaiaApp) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.BaseApp.GRPCQueryRouter(), app.interfaceRegistry, app.Query)
@@ -1284,8 +1362,37 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
+	// The swingset and vbank subspaces remain only for migrating their params
+	// into the module stores.
 	paramsKeeper.Subspace(swingset.ModuleName)
 	paramsKeeper.Subspace(vbank.ModuleName)
 
 	return paramsKeeper
 }
+
+// moduleStoreParamsSubspaces are the legacy x/params subspaces of the modules
+// that now keep their params in their own stores, so that changes to them
+// would have no effect.
+var moduleStoreParamsSubspaces = map[string]bool{
+	swingset.ModuleName: true,
+	vbank.ModuleName:    true,
+}
+
+// newParamChangeProposalHandler returns the x/params proposal handler, but
+// refusing changes to the subspaces of moduleStoreParamsSubspaces.  Since the
+// gov module tries the handler when a proposal is submitted, such a proposal
+// is rejected then, rather than passing and changing nothing.
+func newParamChangeProposalHandler(k paramskeeper.Keeper) govv1beta1.Handler {
+	handler := params.NewParamChangeProposalHandler(k)
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if c, ok := content.(*paramproposal.ParameterChangeProposal); ok {
+			for _, change := range c.Changes {
+				if moduleStoreParamsSubspaces[change.Subspace] {
+					return sdkioerrors.Wrapf(paramproposal.ErrUnknownSubspace,
+						"%s params can only be changed by MsgUpdateParams", change.Subspace)
+				}
+			}
+		}
+		return handler(ctx, content)
+	}
+}
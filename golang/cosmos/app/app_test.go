@@ -0,0 +1,56 @@
+package gaia
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	encodingConfig := params.MakeEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	paramsKeeper := initParamsKeeper(encodingConfig.Marshaler, encodingConfig.Amino, storeKey, tStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	handler := newParamChangeProposalHandler(paramsKeeper)
+	for _, subspace := range []string{swingset.ModuleName, vbank.ModuleName} {
+		proposal := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			{Subspace: "mint", Key: "InflationMax", Value: `"0.2"`},
+			{Subspace: subspace, Key: "beans_per_unit", Value: `[]`},
+		})
+		err := handler(ctx, proposal)
+		if err == nil || !strings.Contains(err.Error(), "MsgUpdateParams") {
+			t.Errorf("got error %v for a change to the %s subspace, want one naming MsgUpdateParams", err, subspace)
+		}
+	}
+
+	// Changes to other subspaces go to the x/params handler.
+	proposal := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		{Subspace: "nonexistent", Key: "Key", Value: `""`},
+	})
+	err := handler(ctx, proposal)
+	if err == nil || strings.Contains(err.Error(), "MsgUpdateParams") {
+		t.Errorf("got error %v, want the x/params handler's unknown subspace error", err)
+	}
+}
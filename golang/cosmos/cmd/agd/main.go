@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/rpc"
 	"net/rpc/jsonrpc"
 	"os"
@@ -18,6 +19,7 @@ import (
 	daemoncmd "github.com/Agoric/agoric-sdk/golang/cosmos/daemon/cmd"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/jsonrpcconn"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/vmsocket"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
@@ -51,13 +53,18 @@ func makeShutdown(cmd *exec.Cmd, writer *os.File) func() error {
 
 // main is the entry point of the agd daemon.  It determines whether to
 // initialize JSON-RPC communications with the separate `--split-vm` VM process,
-// or just to give up control entirely to another binary.
+// or a VM connecting over `--split-vm-listen` or `--split-vm-dial`, or just to
+// give up control entirely to another binary.
 func main() {
 	var vmClient *rpc.Client
+	var vmLink *vmsocket.Link
 	var shutdown func() error
 
 	nodePort := 1
 	sendToNode := func(ctx context.Context, needReply bool, str string) (string, error) {
+		if vmLink != nil {
+			return vmLink.Send(ctx, needReply, str)
+		}
 		if vmClient == nil {
 			return "", errors.New("sendToVM called without VM client set up")
 		}
@@ -85,6 +92,33 @@ func main() {
 		args := []string{"ag-chain-cosmos", "--home", gaia.DefaultNodeHome}
 		args = append(args, os.Args[1:]...)
 
+		framing, err := jsonrpcconn.ParseFraming(cast.ToString(appOpts.Get(daemoncmd.FlagSplitVmFraming)))
+		if err != nil {
+			return err
+		}
+		connOpts := jsonrpcconn.Options{
+			MaxMessageSize: cast.ToInt(appOpts.Get(daemoncmd.FlagSplitVmMaxMessageSize)),
+			Framing:        framing,
+			Logger:         logger.With("module", "agvm-jsonrpc"),
+		}
+
+		listen := cast.ToString(appOpts.Get(daemoncmd.FlagSplitVmListen))
+		dial := cast.ToString(appOpts.Get(daemoncmd.FlagSplitVmDial))
+		if listen != "" || dial != "" {
+			// The VM runs under its own supervisor, and connects over a socket.
+			link, err := NewVMSocketLink(agdServer, logger, listen, dial, nodePort, connOpts)
+			if err != nil {
+				return err
+			}
+			vmLink = link
+			return nil
+		}
+
+		// The VM only speaks other framings over a socket.
+		if framing != jsonrpcconn.FramingJSON {
+			return fmt.Errorf("--%s=%s requires --%s or --%s", daemoncmd.FlagSplitVmFraming, framing, daemoncmd.FlagSplitVmListen, daemoncmd.FlagSplitVmDial)
+		}
+
 		binary := cast.ToString(appOpts.Get(daemoncmd.FlagSplitVm))
 		if binary == "" {
 			binary, lookErr := FindCosmicSwingsetBinary()
@@ -124,7 +158,7 @@ func main() {
 
 		// Multiplex bidirectional JSON-RPC over the pipes.
 		agvmConn := jsonrpcconn.NewConn(agdFromVm, agdToVm)
-		clientConn, serverConn := jsonrpcconn.ClientServerConn(agvmConn)
+		clientConn, serverConn := jsonrpcconn.ClientServerConnWithOptions(agvmConn, connOpts)
 
 		// Set up the VM server.
 		vmServer := rpc.NewServer()
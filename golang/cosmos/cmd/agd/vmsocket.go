@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/jsonrpcconn"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/vmsocket"
+)
+
+// NewVMSocketLink creates a link to an Agoric VM that runs under its own
+// supervisor, either listening for it or dialing it at the given endpoint.
+func NewVMSocketLink(agdServer *vm.AgdServer, logger log.Logger, listen, dial string, nodePort int, connOpts jsonrpcconn.Options) (*vmsocket.Link, error) {
+	if listen != "" && dial != "" {
+		return nil, errors.New("cannot both listen for and dial the VM")
+	}
+
+	var connector vmsocket.Connector
+	if listen != "" {
+		endpoint, err := vmsocket.ParseEndpoint(listen)
+		if err != nil {
+			return nil, err
+		}
+		connector, err = vmsocket.Listen(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("agd listening for VM", "endpoint", endpoint)
+	} else {
+		endpoint, err := vmsocket.ParseEndpoint(dial)
+		if err != nil {
+			return nil, err
+		}
+		connector = vmsocket.Dial(endpoint)
+		logger.Info("agd dialing VM", "endpoint", endpoint)
+	}
+
+	software := fmt.Sprintf("agd %s (%s)", version.Version, version.Commit)
+	return vmsocket.NewLink(connector, agdServer, logger, software, nodePort, connOpts)
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+const (
+	FlagReplayHeight         = "height"
+	FlagReplayMaxDivergences = "max-divergences"
+)
+
+// ReplayBridgeCmd returns the `debug replay-bridge` command, which replays
+// the inbound messages of a controller traffic log recorded with
+// --record-bridge against the node's state, without a VM.
+func ReplayBridgeCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-bridge <record.jsonl>...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Replay recorded messages from the Agoric VM and report divergent results",
+		Long: `Replay the inbound messages of controller traffic logs recorded with
+--record-bridge through the agd port handlers, and report every message whose
+reply or error differs from the recorded one.
+
+The messages are replayed in order against a cached branch of the state in the
+node's home directory, which is never written.  Use a copy of the state taken
+at the height preceding the first record.  State changes made outside of the
+recorded messages, such as by transactions, are not replayed.
+Give rotated logs oldest first.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			records := []vm.TrafficRecord{}
+			for _, path := range args {
+				file, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				fileRecords, err := vm.ReadTrafficRecords(file)
+				file.Close()
+				if err != nil {
+					return fmt.Errorf("%s: %w", path, err)
+				}
+				records = append(records, fileRecords...)
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			noController := func(context.Context, bool, string) (string, error) {
+				return "", errors.New("no controller while replaying")
+			}
+			agdServer := vm.NewAgdServer()
+			app := gaia.NewAgoricApp(
+				noController, agdServer,
+				serverCtx.Logger, db, nil, false, map[int64]bool{},
+				home, 0, encodingConfig, serverCtx.Viper,
+			)
+
+			height, _ := cmd.Flags().GetInt64(FlagReplayHeight)
+			if height == 0 {
+				err = app.LoadLatestVersion()
+			} else {
+				err = app.LoadHeight(height)
+			}
+			if err != nil {
+				return err
+			}
+
+			ms := app.CommitMultiStore().CacheMultiStore()
+			makeContext := func(height int64) sdk.Context {
+				return sdk.NewContext(ms, tmproto.Header{Height: height}, false, serverCtx.Logger)
+			}
+			replayed, divergences := vm.ReplayTraffic(agdServer, records, makeContext)
+
+			maxDivergences, _ := cmd.Flags().GetInt(FlagReplayMaxDivergences)
+			out := cmd.OutOrStdout()
+			for i, divergence := range divergences {
+				if maxDivergences > 0 && i >= maxDivergences {
+					fmt.Fprintf(out, "... %d more divergences\n", len(divergences)-i)
+					break
+				}
+				printTrafficDivergence(out, divergence)
+			}
+			fmt.Fprintf(out, "replayed %d inbound messages, %d diverged\n", replayed, len(divergences))
+			if len(divergences) > 0 {
+				return fmt.Errorf("%d of %d inbound messages diverged", len(divergences), replayed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagReplayHeight, 0, "The height of the state to replay against (defaults to the latest)")
+	cmd.Flags().Int(FlagReplayMaxDivergences, 10, "The maximum number of divergences to print (0 for all)")
+	return cmd
+}
+
+func printTrafficDivergence(out io.Writer, divergence vm.TrafficDivergence) {
+	rec := divergence.Record
+	fmt.Fprintf(out, "seq %d at height %d on port %s diverged:\n", rec.Seq, rec.Height, rec.PortName)
+	fmt.Fprintf(out, "  data:     %s\n", truncateForDisplay(rec.Data))
+	fmt.Fprintf(out, "  recorded: reply %q, error %q\n", rec.Reply, rec.Error)
+	fmt.Fprintf(out, "  replayed: reply %q, error %q\n", divergence.Reply, divergence.Error)
+	if divergence.LastOutbound != nil {
+		fmt.Fprintf(out, "  during outbound seq %d: %s\n", divergence.LastOutbound.Seq, truncateForDisplay(divergence.LastOutbound.Data))
+	}
+}
+
+// truncateForDisplay shortens messages such as bundle installations, which
+// can be megabytes long.
+func truncateForDisplay(str string) string {
+	const maxLength = 1000
+	if len(str) <= maxLength {
+		return str
+	}
+	return fmt.Sprintf("%s... (%d bytes)", str[:maxLength], len(str))
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 
@@ -40,6 +41,8 @@ import (
 	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/jsonrpcconn"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
 )
 
 // Sender is a function that sends a request to the controller.
@@ -102,6 +105,34 @@ func initTendermintConfig() *tmcfg.Config {
 	return cfg
 }
 
+// AgoricAppConfig extends the server configuration in app.toml with the
+// settings of the Agoric modules.
+type AgoricAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	Swingset SwingsetConfig `mapstructure:"swingset"`
+}
+
+// SwingsetConfig holds the [swingset] settings of app.toml.
+type SwingsetConfig struct {
+	// SnapshotExportTimeout bounds the swing-store export of a state-sync
+	// snapshot.
+	SnapshotExportTimeout time.Duration `mapstructure:"snapshot-export-timeout"`
+}
+
+const swingsetConfigTemplate = `
+###############################################################################
+###                         SwingSet Configuration                          ###
+###############################################################################
+
+[swingset]
+
+# The time after which the swing-store export of a state-sync snapshot is
+# aborted, so that a stuck export does not prevent future snapshots.  Set to
+# "0s" for no timeout.
+snapshot-export-timeout = "{{ .Swingset.SnapshotExportTimeout }}"
+`
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
@@ -122,7 +153,13 @@ func initAppConfig() (string, interface{}) {
 	// For now, we set it to zero so that validators don't have to worry about it.
 	srvCfg.MinGasPrices = "0uist"
 
-	return serverconfig.DefaultConfigTemplate, *srvCfg
+	appCfg := AgoricAppConfig{
+		Config: *srvCfg,
+		Swingset: SwingsetConfig{
+			SnapshotExportTimeout: swingsetkeeper.DefaultSnapshotExportTimeout,
+		},
+	}
+	return serverconfig.DefaultConfigTemplate + swingsetConfigTemplate, appCfg
 }
 
 func initRootCmd(sender Sender, rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
@@ -135,6 +172,9 @@ func initRootCmd(sender Sender, rootCmd *cobra.Command, encodingConfig params.En
 		agdServer: vm.NewAgdServer(),
 	}
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(ReplayBridgeCmd(encodingConfig))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(gaia.ModuleBasics, gaia.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, gaia.DefaultNodeHome),
@@ -144,7 +184,7 @@ func initRootCmd(sender Sender, rootCmd *cobra.Command, encodingConfig params.En
 		AddGenesisAccountCmd(encodingConfig.Marshaler, gaia.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(gaia.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd,
 		config.Cmd(),
 		pruning.Cmd(ac.newApp, gaia.DefaultNodeHome),
 		snapshot.Cmd(ac.newApp),
@@ -175,12 +215,30 @@ const (
 	// split-process Agoric VM.  The default is to use an embedded VM.
 	FlagSplitVm      = "split-vm"
 	EmbeddedVmEnvVar = "AGD_EMBEDDED_VM"
+	// FlagSplitVmListen and FlagSplitVmDial connect to a separately supervised
+	// Agoric VM over a unix:// or loopback tcp:// endpoint instead.
+	FlagSplitVmListen = "split-vm-listen"
+	FlagSplitVmDial   = "split-vm-dial"
+	// FlagSplitVmMaxMessageSize and FlagSplitVmFraming configure the JSON-RPC
+	// connection to a split-process Agoric VM.
+	FlagSplitVmMaxMessageSize = "split-vm-max-message-size"
+	FlagSplitVmFraming        = "split-vm-framing"
+	// FlagVmReplyTimeout bounds the wait for an embedded Agoric VM to reply to
+	// each message.
+	FlagVmReplyTimeout = "vm-reply-timeout"
+)
+
+const (
+	DefaultRecordBridgeMaxSize  = 100 << 20
+	DefaultRecordBridgeMaxFiles = 10
 )
 
 // hasVMController returns true if we have a VM (are running in split-vm mode,
 // or with an embedded VM).
 func hasVMController(serverCtx *server.Context) bool {
 	return serverCtx.Viper.GetString(FlagSplitVm) != "" ||
+		serverCtx.Viper.GetString(FlagSplitVmListen) != "" ||
+		serverCtx.Viper.GetString(FlagSplitVmDial) != "" ||
 		os.Getenv(EmbeddedVmEnvVar) != ""
 }
 
@@ -190,10 +248,50 @@ func addAgoricVMFlags(cmd *cobra.Command) {
 		"",
 		"Specify the external Agoric VM program",
 	)
+	cmd.PersistentFlags().String(
+		FlagSplitVmListen,
+		"",
+		"Listen for the Agoric VM to connect at this unix:// or loopback tcp:// endpoint",
+	)
+	cmd.PersistentFlags().String(
+		FlagSplitVmDial,
+		"",
+		"Connect to the Agoric VM listening at this unix:// or loopback tcp:// endpoint",
+	)
+	cmd.PersistentFlags().Int(
+		FlagSplitVmMaxMessageSize,
+		0,
+		"Maximum size in bytes of a JSON-RPC message to or from a split-process Agoric VM (0 for no limit)",
+	)
+	cmd.PersistentFlags().String(
+		FlagSplitVmFraming,
+		string(jsonrpcconn.FramingJSON),
+		`Framing of JSON-RPC messages to and from a split-process Agoric VM ("json", or "length-prefixed" with --split-vm-listen or --split-vm-dial)`,
+	)
+	cmd.PersistentFlags().Duration(
+		FlagVmReplyTimeout,
+		0,
+		"Maximum time to wait for an embedded Agoric VM to reply to each message (0 to wait indefinitely)",
+	)
 }
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	addAgoricVMFlags(startCmd)
+	startCmd.Flags().String(
+		gaia.FlagRecordBridge,
+		"",
+		"Record the messages exchanged with the Agoric VM to this JSONL file",
+	)
+	startCmd.Flags().Int64(
+		gaia.FlagRecordBridgeMaxSize,
+		DefaultRecordBridgeMaxSize,
+		"Rotate the bridge record file once it exceeds this many bytes (0 to disable rotation)",
+	)
+	startCmd.Flags().Int(
+		gaia.FlagRecordBridgeMaxFiles,
+		DefaultRecordBridgeMaxFiles,
+		"Number of rotated bridge record files to keep",
+	)
 }
 
 func queryCommand() *cobra.Command {
@@ -1,6 +1,8 @@
 package cmd_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
@@ -21,3 +23,20 @@ func TestRootCmdConfig(t *testing.T) {
 
 	require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
 }
+
+func TestAppConfigSwingset(t *testing.T) {
+	home := t.TempDir()
+	rootCmd, _ := cmd.NewRootCmd(nil)
+	rootCmd.SetArgs([]string{
+		"config",
+		"keyring-backend",
+		"test",
+		"--home", home,
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "", home))
+
+	appToml, err := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(appToml), "[swingset]")
+	require.Contains(t, string(appToml), `snapshot-export-timeout = "2h0m0s"`)
+}
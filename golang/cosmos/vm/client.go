@@ -3,7 +3,10 @@ package vm
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/rpc"
+	"sync"
+	"time"
 )
 
 // ReceiveMessageMethod is the name of the method we call in order to have the
@@ -12,8 +15,8 @@ const ReceiveMessageMethod = "agvm.ReceiveMessage"
 
 // Message is what we send to the VM.
 type Message struct {
-	Port int
-	Data string
+	Port       int
+	Data       string
 	NeedsReply bool
 }
 
@@ -31,26 +34,59 @@ var _ rpc.ClientCodec = (*ClientCodec)(nil)
 // response, so we'll note such calls by sending with a reply port of 0 and
 // having the WriteRequest() method fabricate a Receive() call to clear the rpc
 // state.
+//
+// Calls may be outstanding concurrently.  Each reply is correlated with its
+// request by the reply port, and replies may arrive in any order.
 type ClientCodec struct {
-	ctx context.Context
+	ctx  context.Context
 	send func(port, rPort int, msg string)
-	outbound map[int]rpc.Request
-	inbound chan *rpc.Response
-	replies map[uint64]string
-	replyToRead uint64
+
+	mtx sync.Mutex
+	// replyTimeout, if nonzero, bounds the wait for each reply.
+	replyTimeout time.Duration
+	// outbound holds the requests awaiting a reply, keyed by reply port.
+	outbound map[int]outboundRequest
+	// responses holds the replies received but not yet read, in order.
+	responses []clientResponse
+	// current is the response whose body is to be read next.
+	current clientResponse
+	// ready is signalled when a response is added.
+	ready     chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+type outboundRequest struct {
+	request rpc.Request
+	timer   *time.Timer
+}
+
+type clientResponse struct {
+	header rpc.Response
+	body   string
 }
 
-// NewClientCodec creates a new ClientCodec.
+// NewClientCodec creates a new ClientCodec.  Outstanding calls fail once ctx
+// is done.
 func NewClientCodec(ctx context.Context, send func(int, int, string)) *ClientCodec {
 	return &ClientCodec{
-		ctx: ctx,
-		send: send,
-		outbound: make(map[int]rpc.Request),
-		inbound: make(chan *rpc.Response),
-		replies: make(map[uint64]string),
+		ctx:      ctx,
+		send:     send,
+		outbound: make(map[int]outboundRequest),
+		ready:    make(chan struct{}, 1),
+		closed:   make(chan struct{}),
 	}
 }
 
+// SetReplyTimeout bounds the wait for the reply to each subsequent request,
+// after which the call fails.  Zero means to wait indefinitely.  The embedded
+// VM's codec is set from the --vm-reply-timeout start flag.
+func (cc *ClientCodec) SetReplyTimeout(timeout time.Duration) {
+	cc.mtx.Lock()
+	defer cc.mtx.Unlock()
+	cc.replyTimeout = timeout
+}
+
 // WriteRequest sends a request to the VM.
 func (cc *ClientCodec) WriteRequest(r *rpc.Request, body interface{}) error {
 	if r.ServiceMethod != ReceiveMessageMethod {
@@ -62,7 +98,25 @@ func (cc *ClientCodec) WriteRequest(r *rpc.Request, body interface{}) error {
 		return fmt.Errorf("body %T is not a Message", body)
 	}
 	rPort := int(r.Seq + 1) // rPort is 1-indexed to indicate it's required
-	cc.outbound[rPort] = *r
+
+	cc.mtx.Lock()
+	select {
+	case <-cc.closed:
+		cc.mtx.Unlock()
+		return rpc.ErrShutdown
+	default:
+	}
+	outb := outboundRequest{request: *r}
+	if msg.NeedsReply && cc.replyTimeout > 0 {
+		timeout := cc.replyTimeout
+		outb.timer = time.AfterFunc(timeout, func() {
+			_ = cc.Receive(rPort, true, fmt.Sprintf("no reply on port %d within %s", rPort, timeout))
+		})
+	}
+	cc.outbound[rPort] = outb
+	cc.mtx.Unlock()
+
+	// The VM may reply before send returns, so we must not hold the lock.
 	var senderReplyPort int
 	if msg.NeedsReply {
 		senderReplyPort = rPort
@@ -74,40 +128,87 @@ func (cc *ClientCodec) WriteRequest(r *rpc.Request, body interface{}) error {
 	return nil
 }
 
-// ReadResponseHeader decodes a response header from the VM.
+// ReadResponseHeader decodes a response header from the VM, waiting for one
+// to arrive unless the codec is closed or its context is done.
 func (cc *ClientCodec) ReadResponseHeader(r *rpc.Response) error {
-	resp := <-cc.inbound
-	*r = *resp
-	cc.replyToRead = r.Seq
-	return nil
+	for {
+		cc.mtx.Lock()
+		if len(cc.responses) > 0 {
+			cc.current = cc.responses[0]
+			cc.responses = cc.responses[1:]
+			*r = cc.current.header
+			cc.mtx.Unlock()
+			return nil
+		}
+		cc.mtx.Unlock()
+
+		select {
+		case <-cc.ready:
+		case <-cc.closed:
+			return io.EOF
+		case <-cc.ctx.Done():
+			return cc.ctx.Err()
+		}
+	}
 }
 
 // ReadResponseBody decodes a response body (currently just string) from the VM.
 func (cc *ClientCodec) ReadResponseBody(body interface{}) error {
+	cc.mtx.Lock()
+	defer cc.mtx.Unlock()
 	if body != nil {
-		*body.(*string) = cc.replies[cc.replyToRead]
+		*body.(*string) = cc.current.body
 	}
-	delete(cc.replies, cc.replyToRead)
+	cc.current = clientResponse{}
 	return nil
 }
 
-// Receive is called by the VM to send a response to the client.
+// Receive is called by the VM to send a response to the client.  It may be
+// called from any goroutine, including from within the send function.
 func (cc *ClientCodec) Receive(rPort int, isError bool, data string) error {
-	outb := cc.outbound[rPort]
+	cc.mtx.Lock()
+	defer cc.mtx.Unlock()
+	outb, ok := cc.outbound[rPort]
+	if !ok {
+		// Perhaps the request has already timed out.
+		return fmt.Errorf("no outstanding request for reply port %d", rPort)
+	}
 	delete(cc.outbound, rPort)
-	resp := &rpc.Response{
-		ServiceMethod: outb.ServiceMethod,
-		Seq: outb.Seq,
+	if outb.timer != nil {
+		outb.timer.Stop()
+	}
+
+	resp := clientResponse{
+		header: rpc.Response{
+			ServiceMethod: outb.request.ServiceMethod,
+			Seq:           outb.request.Seq,
+		},
 	}
 	if isError {
-		resp.Error = data
+		resp.header.Error = data
 	} else {
-		cc.replies[resp.Seq] = data
+		resp.body = data
+	}
+	cc.responses = append(cc.responses, resp)
+	select {
+	case cc.ready <- struct{}{}:
+	default:
+		// The reader has yet to consume an earlier signal.
 	}
-	cc.inbound <- resp
 	return nil
 }
 
+// Close stops waiting for replies, failing the outstanding calls.
 func (cc *ClientCodec) Close() error {
+	cc.closeOnce.Do(func() {
+		cc.mtx.Lock()
+		defer cc.mtx.Unlock()
+		for _, outb := range cc.outbound {
+			if outb.timer != nil {
+				outb.timer.Stop()
+			}
+		}
+		close(cc.closed)
+	})
 	return nil
 }
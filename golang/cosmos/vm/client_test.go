@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/rpc"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -182,3 +185,140 @@ func TestClient_LongBg(t *testing.T) {
 	}
 	<-done
 }
+
+// newEchoClient returns a client whose VM replies to each message with its
+// data after the given delay, or from within the send function if the delay
+// is negative.
+func newEchoClient(ctx context.Context, delay func(data string) time.Duration) (*vm.ClientCodec, Sender) {
+	var codec *vm.ClientCodec
+	sendFunc := func(port, reply int, str string) {
+		if reply == 0 {
+			return
+		}
+		d := delay(str)
+		if d < 0 {
+			_ = codec.Receive(reply, false, "echo "+str)
+			return
+		}
+		time.AfterFunc(d, func() {
+			_ = codec.Receive(reply, false, "echo "+str)
+		})
+	}
+	codec, send := ConnectVMClientCodec(ctx, 42, sendFunc)
+	return codec, send
+}
+
+func runConcurrentCalls(t *testing.T, send Sender, n int) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			data := strconv.Itoa(i)
+			needReply := i%5 != 0
+			ret, err := send(context.Background(), needReply, data)
+			if err != nil {
+				t.Errorf("call %d: %v", i, err)
+				return
+			}
+			expected := "echo " + data
+			if !needReply {
+				expected = "<no-reply-requested>"
+			}
+			if ret != expected {
+				t.Errorf("call %d: got %q, expected %q", i, ret, expected)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestClient_ConcurrentOutOfOrder(t *testing.T) {
+	const n = 50
+	_, send := newEchoClient(context.Background(), func(data string) time.Duration {
+		// Later calls are answered first.
+		i, _ := strconv.Atoi(data)
+		return time.Duration(n-i) * time.Millisecond
+	})
+	runConcurrentCalls(t, send, n)
+}
+
+func TestClient_ConcurrentReplyWithinSend(t *testing.T) {
+	_, send := newEchoClient(context.Background(), func(string) time.Duration {
+		return -1
+	})
+	runConcurrentCalls(t, send, 50)
+}
+
+func TestClient_ReplyTimeout(t *testing.T) {
+	var replyPort int
+	var mtx sync.Mutex
+	codec, send := ConnectVMClientCodec(context.Background(), 42, func(port, reply int, str string) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		replyPort = reply
+	})
+	codec.SetReplyTimeout(20 * time.Millisecond)
+
+	_, err := send(context.Background(), true, "never answered")
+	if err == nil || !strings.Contains(err.Error(), "no reply on port") {
+		t.Fatalf("got error %v, expected a timeout", err)
+	}
+
+	// A late reply is refused, and the client remains usable.
+	mtx.Lock()
+	late := replyPort
+	mtx.Unlock()
+	if err := codec.Receive(late, false, "too late"); err == nil {
+		t.Error("got no error for a reply after the timeout")
+	}
+	if err := codec.Receive(9999, false, "unknown"); err == nil {
+		t.Error("got no error for a reply to an unknown port")
+	}
+	ret, err := send(context.Background(), false, "no reply needed")
+	if err != nil || ret != "<no-reply-requested>" {
+		t.Errorf("got %q, %v after a timeout", ret, err)
+	}
+}
+
+func TestClient_ContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, send := ConnectVMClientCodec(ctx, 42, func(port, reply int, str string) {
+		if str == "cancel" {
+			cancel()
+		}
+	})
+
+	done := make(chan error)
+	go func() {
+		_, err := send(context.Background(), true, "pending")
+		done <- err
+	}()
+	if _, err := send(context.Background(), true, "cancel"); err == nil {
+		t.Error("got no error for a call outstanding at cancellation")
+	}
+	if err := <-done; err == nil {
+		t.Error("got no error for a call outstanding at cancellation")
+	}
+}
+
+func TestClient_Close(t *testing.T) {
+	sent := make(chan struct{})
+	_, send := ConnectVMClientCodec(context.Background(), 42, func(port, reply int, str string) {
+		close(sent)
+	})
+
+	done := make(chan error)
+	go func() {
+		_, err := send(context.Background(), true, "pending")
+		done <- err
+	}()
+	<-sent
+	if _, err := send(context.Background(), false, "shutdown"); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err == nil {
+		t.Error("got no error for a call outstanding at close")
+	}
+}
@@ -21,6 +21,14 @@ type ControllerAdmissionMsg interface {
 	IsHighPriority(sdk.Context, interface{}) (bool, error)
 }
 
+// StatefulInboundMsgCounter is implemented by the ControllerAdmissionMsgs
+// whose number of Swingset messages added to the inboundQueue depends on the
+// chain state and on the messages preceding them in the transaction.  The
+// inbound checks use it instead of GetInboundMsgCount when available.
+type StatefulInboundMsgCounter interface {
+	GetStatefulInboundMsgCount(ctx sdk.Context, data interface{}, precedingMsgs []sdk.Msg) (int32, error)
+}
+
 type PortHandler interface {
 	Receive(context.Context, string) (string, error)
 }
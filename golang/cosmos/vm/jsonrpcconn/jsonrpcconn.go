@@ -8,40 +8,295 @@ standard library only supports client-server. By multiplexing a single
 io.ReadWriteCloser stream into separate server (receives requests) and
 client (receives responses) streams, two RPC halves can share the same
 underlying connection.
+
+Messages are delimited either by the JSON syntax of each object, or by a
+length prefix (see Options).  Each message is checked to be valid JSON and then
+routed by the names of its top-level members, without decoding their values,
+which is left to the reader of its stream.  A message that cannot be routed to
+either stream, because it is not a valid JSON object, its method is not a
+string, or it is neither a request nor a response, is logged and skipped if it
+was delimited.  A message larger than the configured maximum, or whose end
+cannot be found, shuts down the connection with an error describing it, which
+is what readers of both streams then receive.
 */
 package jsonrpcconn
 
 import (
+	"bufio"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"strings"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
 )
 
-// jsonRpcMsg can unmarshal either a JSON-RPC
-// request or response object.
-type jsonRpcMsg struct {
-	Error  json.RawMessage   `json:"error"`
-	Id     json.RawMessage   `json:"id"`
-	Method *string           `json:"method"`
-	Params []json.RawMessage `json:"params"`
-	Result json.RawMessage   `json:"result"`
+// Framing is how messages are delimited on a connection.
+type Framing string
+
+const (
+	// FramingJSON delimits messages by the syntax of the JSON values
+	// themselves, optionally separated by whitespace.
+	FramingJSON Framing = "json"
+	// FramingLengthPrefixed precedes each message with its length in bytes, as
+	// a 4-byte big-endian unsigned integer.  The mux can then bound and skip
+	// messages without scanning them.
+	FramingLengthPrefixed Framing = "length-prefixed"
+)
+
+// ParseFraming returns the framing with the given name, where the empty name
+// is FramingJSON.
+func ParseFraming(name string) (Framing, error) {
+	switch Framing(name) {
+	case "", FramingJSON:
+		return FramingJSON, nil
+	case FramingLengthPrefixed:
+		return FramingLengthPrefixed, nil
+	}
+	return "", fmt.Errorf("unknown JSON-RPC framing %q", name)
+}
+
+// Options configure a multiplexed connection.
+type Options struct {
+	// MaxMessageSize bounds the size in bytes of each message read or written,
+	// not counting any framing.  Zero means no limit.
+	MaxMessageSize int
+	// Framing defaults to FramingJSON.
+	Framing Framing
+	// Logger, if set, logs protocol errors.
+	Logger log.Logger
+}
+
+// maxExcerpt bounds how much of a bad message is kept for diagnosis.
+const maxExcerpt = 128
+
+func excerpt(bz []byte) string {
+	if len(bz) > maxExcerpt {
+		return string(bz[:maxExcerpt]) + "..."
+	}
+	return string(bz)
+}
+
+// OversizeError reports a message larger than the maximum size.  A message
+// read from the connection is not skipped, so the connection is shut down.
+type OversizeError struct {
+	Limit int
+	// Size is the size of the message, or 0 if it is not known because the
+	// message was abandoned after reaching the limit.
+	Size int
+	// Excerpt is the beginning of the message.
+	Excerpt string
+}
+
+func (e *OversizeError) Error() string {
+	if e.Size > 0 {
+		return fmt.Sprintf("JSON-RPC message of %d bytes exceeds limit of %d bytes: %s", e.Size, e.Limit, e.Excerpt)
+	}
+	return fmt.Sprintf("JSON-RPC message exceeds limit of %d bytes: %s", e.Limit, e.Excerpt)
+}
+
+// MalformedError reports a message that is not a valid JSON object.  If the message
+// was delimited it is skipped, but otherwise the connection is shut down.
+type MalformedError struct {
+	Err     error
+	Excerpt string
+}
+
+func (e *MalformedError) Error() string {
+	return fmt.Sprintf("malformed JSON-RPC message: %s: %s", e.Err, e.Excerpt)
+}
+
+func (e *MalformedError) Unwrap() error {
+	return e.Err
+}
+
+// UnroutableError reports a JSON object that is neither a request, having a
+// method, nor a response, having an id and a result or an error.  It is
+// skipped.
+type UnroutableError struct {
+	Excerpt string
+}
+
+func (e *UnroutableError) Error() string {
+	return fmt.Sprintf("JSON-RPC message is neither a request nor a response: %s", e.Excerpt)
+}
+
+// jsonRpcMembers records which members of a JSON-RPC request or response
+// object are present, enough to route it.  A null method is absent, as it
+// would be when decoded, but the other members are present even if null.
+type jsonRpcMembers struct {
+	error, id, method, result bool
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func skipSpace(bz []byte, i int) int {
+	for i < len(bz) && isSpace(bz[i]) {
+		i++
+	}
+	return i
+}
+
+// skipString returns the index after the string starting at i, or -1 if it is
+// unterminated.
+func skipString(bz []byte, i int) int {
+	for i++; i < len(bz); i++ {
+		switch bz[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// skipValue returns the index after the value starting at i, or -1 if its end
+// cannot be found.  Only strings and brackets are matched, so the contents of
+// the value may still be invalid.
+func skipValue(bz []byte, i int) int {
+	if i >= len(bz) {
+		return -1
+	}
+	switch bz[i] {
+	case '"':
+		return skipString(bz, i)
+	case '{', '[':
+		depth := 0
+		for i < len(bz) {
+			switch bz[i] {
+			case '"':
+				i = skipString(bz, i)
+				if i < 0 {
+					return -1
+				}
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return i + 1
+				}
+			}
+			i++
+		}
+		return -1
+	}
+	start := i
+	for i < len(bz) && !isSpace(bz[i]) && bz[i] != ',' && bz[i] != '}' && bz[i] != ']' {
+		i++
+	}
+	if i == start {
+		return -1
+	}
+	return i
+}
+
+// scanMembers finds the members at the top level of a JSON object, skipping
+// over their values, and then checks that the whole object is valid JSON.
+// Names are matched without regard to case, as encoding/json does.
+func scanMembers(raw []byte) (jsonRpcMembers, error) {
+	var members jsonRpcMembers
+	malformed := func(format string, args ...interface{}) error {
+		return &MalformedError{Err: fmt.Errorf(format, args...), Excerpt: excerpt(raw)}
+	}
+
+	i := skipSpace(raw, 0)
+	if i >= len(raw) || raw[i] != '{' {
+		return members, malformed("message is not an object")
+	}
+	i = skipSpace(raw, i+1)
+	for i < len(raw) && raw[i] != '}' {
+		if raw[i] != '"' {
+			return members, malformed("expected a member name at offset %d", i)
+		}
+		end := skipString(raw, i)
+		if end < 0 {
+			return members, malformed("unterminated member name")
+		}
+		name := strings.ToLower(string(raw[i+1 : end-1]))
+		i = skipSpace(raw, end)
+		if i >= len(raw) || raw[i] != ':' {
+			return members, malformed("expected ':' after member %q", name)
+		}
+		i = skipSpace(raw, i+1)
+		start := i
+		i = skipValue(raw, i)
+		if i < 0 {
+			return members, malformed("cannot find the end of member %q", name)
+		}
+		value := raw[start:i]
+
+		switch name {
+		case "error":
+			members.error = true
+		case "id":
+			members.id = true
+		case "method":
+			if string(value) != "null" {
+				if value[0] != '"' {
+					return members, malformed("method is not a string")
+				}
+				members.method = true
+			}
+		case "result":
+			members.result = true
+		}
+
+		i = skipSpace(raw, i)
+		if i < len(raw) && raw[i] == ',' {
+			i = skipSpace(raw, i+1)
+			continue
+		}
+		if i >= len(raw) || raw[i] != '}' {
+			return members, malformed("expected ',' or '}' at offset %d", i)
+		}
+	}
+	if i >= len(raw) {
+		return members, malformed("unterminated object")
+	}
+	if skipSpace(raw, i+1) != len(raw) {
+		return members, malformed("trailing data after the object")
+	}
+	// The values were only skipped, so they may still be invalid.
+	if !json.Valid(raw) {
+		return members, malformed("message is not valid JSON")
+	}
+	return members, nil
 }
 
 // mux holds the underlying connection and the pipe reader/writer
 // pairs for the server (request) and client (response) sides.
-// Any protocol error or closing any channel will cause shutdown.
+// Any I/O error, oversize or undelimited message, or closing any channel will
+// cause shutdown.
 type mux struct {
 	conn       io.ReadWriteCloser
+	reader     *bufio.Reader
+	opts       Options
+	writeMtx   *sync.Mutex
 	reqReader  *io.PipeReader
 	reqWriter  *io.PipeWriter
 	respReader *io.PipeReader
 	respWriter *io.PipeWriter
 }
 
-func newMux(conn io.ReadWriteCloser) mux {
+func newMux(conn io.ReadWriteCloser, opts Options) mux {
+	if opts.Framing == "" {
+		opts.Framing = FramingJSON
+	}
 	reqReader, reqWriter := io.Pipe()
 	respReader, respWriter := io.Pipe()
 	m := mux{
 		conn:       conn,
+		reader:     bufio.NewReader(conn),
+		opts:       opts,
+		writeMtx:   &sync.Mutex{},
 		reqReader:  reqReader,
 		reqWriter:  reqWriter,
 		respReader: respReader,
@@ -51,41 +306,172 @@ func newMux(conn io.ReadWriteCloser) mux {
 	return m
 }
 
-func (m mux) input() {
+func (m mux) report(err error) {
+	if m.opts.Logger != nil {
+		m.opts.Logger.Error("JSON-RPC protocol error", "err", err)
+	}
+}
+
+func (m mux) readMessage() ([]byte, error) {
+	if m.opts.Framing == FramingLengthPrefixed {
+		return m.readFrame()
+	}
+	return m.readJSONObject()
+}
+
+// readFrame reads a length-prefixed message.
+func (m mux) readFrame() ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(m.reader, header[:]); err != nil {
+		return nil, err
+	}
+	size := int(binary.BigEndian.Uint32(header[:]))
+	if m.opts.MaxMessageSize > 0 && size > m.opts.MaxMessageSize {
+		// The message is not read, so only what has already arrived is shown.
+		start, _ := m.reader.Peek(m.reader.Buffered())
+		return nil, &OversizeError{Limit: m.opts.MaxMessageSize, Size: size, Excerpt: excerpt(start)}
+	}
+	message := make([]byte, size)
+	if _, err := io.ReadFull(m.reader, message); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return message, nil
+}
+
+// readJSONObject reads the bytes of the next JSON object, finding its end by
+// matching brackets outside of strings.  Its contents are checked when it is
+// routed.
+func (m mux) readJSONObject() ([]byte, error) {
+	var c byte
 	var err error
-	dec := json.NewDecoder(m.conn)
 	for {
-		// read the next JSON value, preserve its wire format
-		var raw json.RawMessage
-		err = dec.Decode(&raw)
+		c, err = m.reader.ReadByte()
 		if err != nil {
+			return nil, err
+		}
+		if c != ' ' && c != '\t' && c != '\n' && c != '\r' {
 			break
 		}
+	}
+	if c != '{' {
+		rest, _ := m.reader.Peek(m.reader.Buffered())
+		return nil, &MalformedError{
+			Err:     fmt.Errorf("message starts with %q, not an object", c),
+			Excerpt: excerpt(append([]byte{c}, rest...)),
+		}
+	}
 
-		// parse as JSON-RPC
-		var msg jsonRpcMsg
-		err = json.Unmarshal(raw, &msg)
+	message := []byte{c}
+	closers := []byte{'}'}
+	inString, escaped := false, false
+	for len(closers) > 0 {
+		c, err = m.reader.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		message = append(message, c)
+		if m.opts.MaxMessageSize > 0 && len(message) > m.opts.MaxMessageSize {
+			return nil, &OversizeError{Limit: m.opts.MaxMessageSize, Excerpt: excerpt(message)}
+		}
+		switch {
+		case inString:
+			if escaped {
+				escaped = false
+			} else if c == '\\' {
+				escaped = true
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '{':
+			closers = append(closers, '}')
+		case c == '[':
+			closers = append(closers, ']')
+		case c == '}' || c == ']':
+			if c != closers[len(closers)-1] {
+				// We no longer know where the message ends.
+				return nil, &MalformedError{
+					Err:     fmt.Errorf("mismatched %q", c),
+					Excerpt: excerpt(message),
+				}
+			}
+			closers = closers[:len(closers)-1]
+		}
+	}
+	return message, nil
+}
+
+func (m mux) input() {
+	var err error
+	for {
+		// read the next message, preserving its wire format
+		var raw []byte
+		raw, err = m.readMessage()
 		if err != nil {
 			break
 		}
 
+		// find the JSON-RPC members, leaving their values to the consumer
+		msg, scanErr := scanMembers(raw)
+		if scanErr != nil {
+			m.report(scanErr)
+			continue
+		}
+
 		// send to one of the outputs
-		if msg.Method != nil {
-			// presume a request, the consumer will handle any missing fields
+		switch {
+		case msg.method:
+			// a request, the consumer will handle any missing fields
 			_, err = m.reqWriter.Write(raw)
-		} else {
-			// presume a response, the consumer will handle any missing fields
+		case msg.id && (msg.result || msg.error):
+			// a response, the consumer will handle any missing fields
 			_, err = m.respWriter.Write(raw)
+		default:
+			m.report(&UnroutableError{Excerpt: excerpt(raw)})
+			continue
 		}
 		if err != nil {
 			break
 		}
 	}
+	var protocolErr *OversizeError
+	var malformedErr *MalformedError
+	if errors.As(err, &protocolErr) || errors.As(err, &malformedErr) {
+		m.report(err)
+	}
 	m.reqWriter.CloseWithError(err)
 	m.respWriter.CloseWithError(err)
 	m.conn.Close()
 }
 
+// write writes a whole message to the connection.
+func (m mux) write(p []byte) (int, error) {
+	if m.opts.MaxMessageSize > 0 && len(p) > m.opts.MaxMessageSize {
+		err := &OversizeError{Limit: m.opts.MaxMessageSize, Size: len(p), Excerpt: excerpt(p)}
+		m.report(err)
+		return 0, err
+	}
+	if m.opts.Framing != FramingLengthPrefixed {
+		return m.conn.Write(p)
+	}
+	frame := make([]byte, 4+len(p))
+	binary.BigEndian.PutUint32(frame, uint32(len(p)))
+	copy(frame[4:], p)
+	m.writeMtx.Lock()
+	defer m.writeMtx.Unlock()
+	if _, err := m.conn.Write(frame); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
 // clientChan is a view of the mux for the client channel.
 type clientChan mux
 
@@ -101,7 +487,7 @@ func (c clientChan) Read(p []byte) (int, error) {
 
 // Write implements the io.Writer interface.
 func (c clientChan) Write(p []byte) (int, error) {
-	return c.conn.Write(p)
+	return mux(c).write(p)
 }
 
 // serverChan is a view of the mux for the server channel.
@@ -119,7 +505,7 @@ func (s serverChan) Read(p []byte) (int, error) {
 
 // Write implements the io.Writer interface.
 func (s serverChan) Write(p []byte) (int, error) {
-	return s.conn.Write(p)
+	return mux(s).write(p)
 }
 
 // ClientServerConn multiplexes an input/output stream for the JSON-RPCv1
@@ -127,7 +513,12 @@ func (s serverChan) Write(p []byte) (int, error) {
 // Full JSON objects must be written atomically to either stream to
 // interleave correctly.
 func ClientServerConn(conn io.ReadWriteCloser) (clientConn io.ReadWriteCloser, serverConn io.ReadWriteCloser) {
-	m := newMux(conn)
+	return ClientServerConnWithOptions(conn, Options{})
+}
+
+// ClientServerConnWithOptions is ClientServerConn with the given options.
+func ClientServerConnWithOptions(conn io.ReadWriteCloser, opts Options) (clientConn io.ReadWriteCloser, serverConn io.ReadWriteCloser) {
+	m := newMux(conn, opts)
 	clientConn = clientChan(m)
 	serverConn = serverChan(m)
 	return
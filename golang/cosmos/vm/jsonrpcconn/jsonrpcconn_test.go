@@ -1,12 +1,21 @@
 package jsonrpcconn_test
 
 import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/rpc"
 	"net/rpc/jsonrpc"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/jsonrpcconn"
 )
 
@@ -124,3 +133,189 @@ func TestJsonRPC(t *testing.T) {
 	leftClient.Close()
 	rightClient.Close()
 }
+
+type recordingLogger struct {
+	log.Logger
+	mtx  sync.Mutex
+	errs []error
+}
+
+func (l *recordingLogger) Error(msg string, keyvals ...interface{}) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for i := 1; i < len(keyvals); i += 2 {
+		if err, ok := keyvals[i].(error); ok {
+			l.errs = append(l.errs, err)
+		}
+	}
+}
+
+func (l *recordingLogger) errors() []error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return append([]error{}, l.errs...)
+}
+
+func frame(msg string) []byte {
+	bz := make([]byte, 4+len(msg))
+	binary.BigEndian.PutUint32(bz, uint32(len(msg)))
+	copy(bz[4:], msg)
+	return bz
+}
+
+func TestSkipsUnroutableMessages(t *testing.T) {
+	for _, framing := range []jsonrpcconn.Framing{jsonrpcconn.FramingJSON, jsonrpcconn.FramingLengthPrefixed} {
+		t.Run(string(framing), func(t *testing.T) {
+			left, right := net.Pipe()
+			logger := &recordingLogger{Logger: log.NewNopLogger()}
+			_, serverConn := jsonrpcconn.ClientServerConnWithOptions(left, jsonrpcconn.Options{Framing: framing, Logger: logger})
+			server := rpc.NewServer()
+			if err := server.RegisterName("foo", new(Arith)); err != nil {
+				t.Fatal(err)
+			}
+			go server.ServeCodec(jsonrpc.NewServerCodec(serverConn))
+
+			messages := []string{
+				`{"id": 1, "params": [1, 2]}`,
+				`{"id": 2, "method": 7, "params": [1]}`,
+				// Only top-level members are routed.
+				`{"params": ["{\"method\": \"foo.Add\"}"], "Method": null, "id": 4}`,
+				// Balanced, but not valid JSON.
+				`{"method": "foo.Add", "params": [tru], "id": 5}`,
+				`{"id": 3, "method": "foo.Add", "params": [{"A": 1, "B": 2}]}`,
+			}
+			go func() {
+				for _, msg := range messages {
+					bz := []byte(msg + "\n")
+					if framing == jsonrpcconn.FramingLengthPrefixed {
+						bz = frame(msg)
+					}
+					if _, err := right.Write(bz); err != nil {
+						t.Error(err)
+					}
+				}
+			}()
+
+			// Only the request is answered.
+			reader := bufio.NewReader(right)
+			if framing == jsonrpcconn.FramingLengthPrefixed {
+				if _, err := reader.Discard(4); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var resp struct {
+				Id     int
+				Result int
+			}
+			if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			if resp.Id != 3 || resp.Result != 3 {
+				t.Errorf("got response %+v, expected id 3 with result 3", resp)
+			}
+
+			errs := logger.errors()
+			if len(errs) != 4 {
+				t.Fatalf("got logged errors %v, expected 4", errs)
+			}
+			var unroutable *jsonrpcconn.UnroutableError
+			if !errors.As(errs[0], &unroutable) {
+				t.Errorf("got %v, expected an unroutable message", errs[0])
+			}
+			var malformed *jsonrpcconn.MalformedError
+			if !errors.As(errs[1], &malformed) {
+				t.Errorf("got %v, expected a malformed message", errs[1])
+			}
+			if !errors.As(errs[2], &unroutable) {
+				t.Errorf("got %v, expected an unroutable message", errs[2])
+			}
+			if !errors.As(errs[3], &malformed) {
+				t.Errorf("got %v, expected a malformed message", errs[3])
+			}
+			right.Close()
+		})
+	}
+}
+
+func TestOversizeMessage(t *testing.T) {
+	big := `{"id": 1, "result": "` + strings.Repeat("x", 100) + `"}`
+	testCases := []struct {
+		framing jsonrpcconn.Framing
+		input   []byte
+		size    int
+	}{
+		{jsonrpcconn.FramingJSON, []byte(big), 0},
+		// The end of this message is never sent, but it need not be waited for.
+		{jsonrpcconn.FramingJSON, []byte(big[:80]), 0},
+		{jsonrpcconn.FramingLengthPrefixed, frame(big), len(big)},
+	}
+	for _, tc := range testCases {
+		left, right := net.Pipe()
+		logger := &recordingLogger{Logger: log.NewNopLogger()}
+		opts := jsonrpcconn.Options{MaxMessageSize: 64, Framing: tc.framing, Logger: logger}
+		clientConn, serverConn := jsonrpcconn.ClientServerConnWithOptions(left, opts)
+		go func() {
+			_, _ = right.Write(tc.input)
+		}()
+
+		// Both streams fail with the reason.
+		for _, stream := range []io.Reader{clientConn, serverConn} {
+			_, err := stream.Read(make([]byte, 10))
+			var oversize *jsonrpcconn.OversizeError
+			if !errors.As(err, &oversize) {
+				t.Fatalf("%s: got %v, expected an oversize message", tc.framing, err)
+			}
+			if oversize.Limit != 64 || oversize.Size != tc.size || !strings.HasPrefix(oversize.Excerpt, `{"id": 1`) {
+				t.Errorf("%s: got %+v", tc.framing, oversize)
+			}
+		}
+		if errs := logger.errors(); len(errs) != 1 {
+			t.Errorf("%s: got logged errors %v, expected 1", tc.framing, errs)
+		}
+
+		// Oversize messages are not sent.
+		if _, err := clientConn.Write([]byte(big)); err == nil {
+			t.Errorf("%s: wrote an oversize message", tc.framing)
+		}
+		right.Close()
+	}
+}
+
+func TestUndelimitedMessage(t *testing.T) {
+	for _, input := range []string{" \n[1, 2]", `{"id": 1, "result": [1}`} {
+		left, right := net.Pipe()
+		clientConn, _ := jsonrpcconn.ClientServerConn(left)
+		go func() {
+			_, _ = right.Write([]byte(input))
+		}()
+		_, err := clientConn.Read(make([]byte, 10))
+		var malformed *jsonrpcconn.MalformedError
+		if !errors.As(err, &malformed) || !strings.Contains(input, malformed.Excerpt) {
+			t.Errorf("%q: got %v, expected a malformed message", input, err)
+		}
+		right.Close()
+	}
+}
+
+func TestLengthPrefixedJsonRPC(t *testing.T) {
+	left, right := net.Pipe()
+	opts := jsonrpcconn.Options{Framing: jsonrpcconn.FramingLengthPrefixed, MaxMessageSize: 1024}
+	leftClientConn, _ := jsonrpcconn.ClientServerConnWithOptions(left, opts)
+	_, rightServerConn := jsonrpcconn.ClientServerConnWithOptions(right, opts)
+
+	leftClient := jsonrpc.NewClient(leftClientConn)
+	rightServer := rpc.NewServer()
+	if err := rightServer.RegisterName("bar", new(Arith)); err != nil {
+		t.Fatal(err)
+	}
+	go rightServer.ServeCodec(jsonrpc.NewServerCodec(rightServerConn))
+
+	var reply int
+	if err := leftClient.Call("bar.Mul", Args{6, 7}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply != 42 {
+		t.Errorf("bar.Mul want 42, got %d", reply)
+	}
+	leftClient.Close()
+}
@@ -20,6 +20,8 @@ type AgdServer struct {
 	// portToName[nameToPort[s]] == s && nameToPort[portToName[i]] == i for all i, s
 	portToName map[int]string
 	nameToPort map[string]int
+	// recorder, if set, records the traffic with the controller.
+	recorder *TrafficRecorder
 }
 
 var wrappedEmptySDKContext = sdk.WrapSDKContext(
@@ -53,25 +55,75 @@ func (s *AgdServer) SetControllerContext(ctx sdk.Context) func() {
 	}
 }
 
-// getContextAndHandler returns the current context and the handler for the
-// given port number.
-func (s *AgdServer) getContextAndHandler(port int) (context.Context, PortHandler) {
+// SetTrafficRecorder sets the recorder of the traffic with the controller, or
+// stops recording if it is nil.
+func (s *AgdServer) SetTrafficRecorder(recorder *TrafficRecorder) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.recorder = recorder
+}
+
+func (s *AgdServer) getRecorder() *TrafficRecorder {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.recorder
+}
+
+// RecordOutbound records a message about to be sent to the controller at the
+// given block height, if recording, and returns a function to record the
+// controller's reply to it.
+func (s *AgdServer) RecordOutbound(height int64, data string) func(reply string, err error) {
+	recorder := s.getRecorder()
+	if recorder == nil {
+		return func(string, error) {}
+	}
+	// Recording is best-effort, and must not affect the outcome of the call.
+	seq, _ := recorder.Record(TrafficRecord{
+		Height:    height,
+		Direction: TrafficOutbound,
+		Data:      data,
+	})
+	return func(reply string, err error) {
+		_, _ = recorder.Record(TrafficRecord{
+			Height:    height,
+			Direction: TrafficReply,
+			ReplyTo:   seq,
+			Reply:     reply,
+			Error:     errorString(err),
+		})
+	}
+}
+
+// getContextAndHandler returns the current context, the handler and the name
+// for the given port number.
+func (s *AgdServer) getContextAndHandler(port int) (context.Context, PortHandler, string) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 	ctx := s.currentCtx
 	handler := s.portToHandler[port]
-	return ctx, handler
+	return ctx, handler, s.portToName[port]
 }
 
 // ReceiveMessage is the method the VM calls in order to have agd receive a
 // Message.
 func (s *AgdServer) ReceiveMessage(msg *Message, reply *string) error {
-	ctx, handler := s.getContextAndHandler(msg.Port)
+	ctx, handler, name := s.getContextAndHandler(msg.Port)
 	if handler == nil {
 		return fmt.Errorf("unregistered port %d", msg.Port)
 	}
 	resp, err := handler.Receive(ctx, msg.Data)
 	*reply = resp
+	if recorder := s.getRecorder(); recorder != nil {
+		_, _ = recorder.Record(TrafficRecord{
+			Height:    sdk.UnwrapSDKContext(ctx).BlockHeight(),
+			Direction: TrafficInbound,
+			Port:      msg.Port,
+			PortName:  name,
+			Data:      msg.Data,
+			Reply:     resp,
+			Error:     errorString(err),
+		})
+	}
 	return err
 }
 
@@ -0,0 +1,246 @@
+package vm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Directions of recorded controller traffic.
+const (
+	// TrafficOutbound is a message sent by agd to the controller.
+	TrafficOutbound = "outbound"
+	// TrafficInbound is a message sent by the controller to an agd port.
+	TrafficInbound = "inbound"
+	// TrafficReply is the controller's reply to an outbound message.
+	TrafficReply = "reply"
+)
+
+// TrafficRecord is a single line of a controller traffic log.  An outbound
+// message is recorded before it is sent, and its reply in a separate record
+// once it returns, so that the inbound messages the controller sends while
+// processing it are recorded between the two.
+type TrafficRecord struct {
+	Seq       uint64 `json:"seq"`
+	Time      string `json:"time"`
+	Height    int64  `json:"height"`
+	Direction string `json:"direction"`
+	// Port and PortName identify the agd port of an inbound message.
+	Port     int    `json:"port,omitempty"`
+	PortName string `json:"portName,omitempty"`
+	// ReplyTo is the sequence number of the outbound message of a reply.
+	ReplyTo uint64 `json:"replyTo,omitempty"`
+	Data    string `json:"data"`
+	Reply   string `json:"reply"`
+	Error   string `json:"error,omitempty"`
+}
+
+// TrafficRecorder appends controller traffic to a JSONL log, rotating it once
+// it reaches a maximum size.  Rotated logs are renamed with a numeric suffix,
+// "path.1" being the most recent, and only the newest are kept.
+type TrafficRecorder struct {
+	mtx      sync.Mutex
+	path     string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+	seq      uint64
+}
+
+// NewTrafficRecorder opens a traffic log at path, appending to any existing
+// log.  A maxSize of zero disables rotation, and maxFiles is the number of
+// rotated logs to keep.
+func NewTrafficRecorder(path string, maxSize int64, maxFiles int) (*TrafficRecorder, error) {
+	r := &TrafficRecorder{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *TrafficRecorder) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+// rotate closes the current log, shifts the rotated logs and opens a new one.
+func (r *TrafficRecorder) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	if r.maxFiles <= 0 {
+		if err := os.Remove(r.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return r.open()
+	}
+	oldest := fmt.Sprintf("%s.%d", r.path, r.maxFiles)
+	if err := os.Remove(oldest); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := r.maxFiles - 1; i >= 1; i-- {
+		from := fmt.Sprintf("%s.%d", r.path, i)
+		to := fmt.Sprintf("%s.%d", r.path, i+1)
+		if err := os.Rename(from, to); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+// Record appends a record to the log, assigning its sequence number and time,
+// and returns the sequence number.
+func (r *TrafficRecorder) Record(rec TrafficRecord) (uint64, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.file == nil {
+		return 0, errors.New("traffic recorder is closed")
+	}
+
+	r.seq++
+	rec.Seq = r.seq
+	rec.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return rec.Seq, err
+	}
+	line = append(line, '\n')
+
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(line)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return rec.Seq, err
+		}
+	}
+	n, err := r.file.Write(line)
+	r.size += int64(n)
+	return rec.Seq, err
+}
+
+// Close closes the log.
+func (r *TrafficRecorder) Close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// ReadTrafficRecords decodes the records of a traffic log.
+func ReadTrafficRecords(reader io.Reader) ([]TrafficRecord, error) {
+	records := []TrafficRecord{}
+	decoder := json.NewDecoder(reader)
+	for {
+		var rec TrafficRecord
+		err := decoder.Decode(&rec)
+		if err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return records, fmt.Errorf("record %d: %w", len(records)+1, err)
+		}
+		records = append(records, rec)
+	}
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// TrafficDivergence describes an inbound message whose replay produced a
+// different result than was recorded.
+type TrafficDivergence struct {
+	Record TrafficRecord
+	// LastOutbound is the most recent outbound message still awaiting its
+	// reply when the record was made, which is the action that the controller
+	// was processing.  It is nil if that message precedes the log.
+	LastOutbound *TrafficRecord
+	Reply        string
+	Error        string
+}
+
+// ReplayTraffic replays the inbound messages of a traffic log through the
+// server's port handlers, in order, using the context that makeContext returns
+// for the block height of each message.  Ports are matched by name, since
+// port numbers depend on registration order.  Outbound messages and their
+// replies are not replayed, since they go to and come from the controller.  It
+// returns the number of inbound messages replayed and the divergences found.
+func ReplayTraffic(s *AgdServer, records []TrafficRecord, makeContext func(height int64) sdk.Context) (int, []TrafficDivergence) {
+	replayed := 0
+	divergences := []TrafficDivergence{}
+	// pending are the outbound messages awaiting their reply, most recent last.
+	pending := []*TrafficRecord{}
+	for i := range records {
+		rec := records[i]
+		switch rec.Direction {
+		case TrafficOutbound:
+			pending = append(pending, &records[i])
+			continue
+		case TrafficReply:
+			for j := len(pending) - 1; j >= 0; j-- {
+				if pending[j].Seq == rec.ReplyTo {
+					pending = append(pending[:j], pending[j+1:]...)
+					break
+				}
+			}
+			continue
+		case TrafficInbound:
+		default:
+			continue
+		}
+		var lastOutbound *TrafficRecord
+		if n := len(pending); n > 0 {
+			lastOutbound = pending[n-1]
+		}
+
+		replayed++
+		var reply string
+		var err error
+		port := s.GetPort(rec.PortName)
+		if port == 0 {
+			err = fmt.Errorf("unregistered port name %q", rec.PortName)
+		} else {
+			func() {
+				defer s.SetControllerContext(makeContext(rec.Height))()
+				err = s.ReceiveMessage(&Message{Port: port, Data: rec.Data}, &reply)
+			}()
+		}
+		if reply != rec.Reply || errorString(err) != rec.Error {
+			divergences = append(divergences, TrafficDivergence{
+				Record:       rec,
+				LastOutbound: lastOutbound,
+				Reply:        reply,
+				Error:        errorString(err),
+			})
+		}
+	}
+	return replayed, divergences
+}
@@ -0,0 +1,154 @@
+package vm_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+type echoPortHandler struct {
+	prefix string
+}
+
+func (h echoPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	if str == "fail" {
+		return "", fmt.Errorf("failed at height %d", sdk.UnwrapSDKContext(ctx).BlockHeight())
+	}
+	return h.prefix + str, nil
+}
+
+func readTrafficFile(t *testing.T, path string) []vm.TrafficRecord {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	defer file.Close()
+	records, err := vm.ReadTrafficRecords(file)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	return records
+}
+
+func TestTrafficRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bridge.jsonl")
+	recorder, err := vm.NewTrafficRecorder(path, 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	agdServer := vm.NewAgdServer()
+	agdServer.MustRegisterPortHandler("first", echoPortHandler{"1:"})
+	port := agdServer.MustRegisterPortHandler("echo", echoPortHandler{"echo:"})
+	agdServer.SetTrafficRecorder(recorder)
+
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(7)
+	recordReply := agdServer.RecordOutbound(ctx.BlockHeight(), `{"type":"BEGIN_BLOCK"}`)
+	func() {
+		defer agdServer.SetControllerContext(ctx)()
+		var reply string
+		if err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: "hi"}, &reply); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: "fail"}, &reply); err == nil {
+			t.Fatalf("expected error")
+		}
+	}()
+	recordReply("true", nil)
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	records := readTrafficFile(t, path)
+	if len(records) != 4 {
+		t.Fatalf("got %d records, want 4", len(records))
+	}
+	if rec := records[0]; rec.Seq != 1 || rec.Direction != vm.TrafficOutbound || rec.Height != 7 || rec.Data != `{"type":"BEGIN_BLOCK"}` {
+		t.Errorf("unexpected outbound record %+v", rec)
+	}
+	if rec := records[1]; rec.Direction != vm.TrafficInbound || rec.PortName != "echo" || rec.Port != port || rec.Reply != "echo:hi" || rec.Error != "" {
+		t.Errorf("unexpected inbound record %+v", rec)
+	}
+	if rec := records[2]; rec.Seq != 3 || rec.Error != "failed at height 7" {
+		t.Errorf("unexpected inbound error record %+v", rec)
+	}
+	if rec := records[3]; rec.Direction != vm.TrafficReply || rec.ReplyTo != 1 || rec.Height != 7 || rec.Reply != "true" {
+		t.Errorf("unexpected reply record %+v", rec)
+	}
+}
+
+func TestTrafficRecorderRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bridge.jsonl")
+	recorder, err := vm.NewTrafficRecorder(path, 200, 2)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := recorder.Record(vm.TrafficRecord{Direction: vm.TrafficOutbound, Data: fmt.Sprintf("action %d", i)}); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	current := readTrafficFile(t, path)
+	previous := readTrafficFile(t, path+".1")
+	oldest := readTrafficFile(t, path+".2")
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected only 2 rotated logs, got error %v", err)
+	}
+	if len(current) == 0 || current[len(current)-1].Seq != 10 {
+		t.Errorf("current log should end with the last record, got %+v", current)
+	}
+	if len(previous) == 0 || previous[len(previous)-1].Seq+1 != current[0].Seq {
+		t.Errorf("rotated log should precede the current one, got %+v", previous)
+	}
+	if len(oldest) == 0 || oldest[len(oldest)-1].Seq+1 != previous[0].Seq {
+		t.Errorf("oldest log should precede the rotated one, got %+v", oldest)
+	}
+}
+
+func TestReplayTraffic(t *testing.T) {
+	agdServer := vm.NewAgdServer()
+	agdServer.MustRegisterPortHandler("echo", echoPortHandler{"echo:"})
+
+	records := []vm.TrafficRecord{
+		{Seq: 1, Direction: vm.TrafficOutbound, Height: 5, Data: "action"},
+		{Seq: 2, Direction: vm.TrafficInbound, Height: 5, Port: 9, PortName: "echo", Data: "hi", Reply: "echo:hi"},
+		{Seq: 3, Direction: vm.TrafficInbound, Height: 5, PortName: "echo", Data: "there", Reply: "echo:elsewhere"},
+		{Seq: 4, Direction: vm.TrafficReply, Height: 5, ReplyTo: 1, Reply: "true"},
+		{Seq: 5, Direction: vm.TrafficOutbound, Height: 6, Data: "other action"},
+		{Seq: 6, Direction: vm.TrafficInbound, Height: 6, PortName: "echo", Data: "fail", Error: "failed at height 6"},
+		{Seq: 7, Direction: vm.TrafficInbound, Height: 6, PortName: "gone", Data: "hi", Reply: "hi"},
+		{Seq: 8, Direction: vm.TrafficReply, Height: 6, ReplyTo: 5, Reply: "true"},
+		{Seq: 9, Direction: vm.TrafficInbound, Height: 6, PortName: "echo", Data: "late", Reply: "echo:early"},
+	}
+	makeContext := func(height int64) sdk.Context {
+		return sdk.Context{}.WithContext(context.Background()).WithBlockHeader(tmproto.Header{Height: height})
+	}
+	replayed, divergences := vm.ReplayTraffic(agdServer, records, makeContext)
+	if replayed != 5 {
+		t.Errorf("got %d replayed, want 5", replayed)
+	}
+	if len(divergences) != 3 {
+		t.Fatalf("got divergences %+v, want 3", divergences)
+	}
+	if d := divergences[0]; d.Record.Seq != 3 || d.Reply != "echo:there" || d.LastOutbound == nil || d.LastOutbound.Seq != 1 {
+		t.Errorf("unexpected divergence %+v", d)
+	}
+	if d := divergences[1]; d.Record.Seq != 7 || d.Error != `unregistered port name "gone"` || d.LastOutbound == nil || d.LastOutbound.Seq != 5 {
+		t.Errorf("unexpected divergence %+v", d)
+	}
+	// Once every outbound message has its reply, none is being processed.
+	if d := divergences[2]; d.Record.Seq != 9 || d.LastOutbound != nil {
+		t.Errorf("unexpected divergence %+v", d)
+	}
+}
@@ -0,0 +1,388 @@
+package vmsocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/jsonrpcconn"
+)
+
+// Connector establishes raw connections to the VM.
+type Connector interface {
+	// Connect blocks until there is a new connection, or the connector is
+	// closed.
+	Connect() (net.Conn, error)
+	Close() error
+}
+
+type listenConnector struct {
+	listener net.Listener
+}
+
+// Listen returns a connector that accepts connections from the VM at the
+// endpoint.  A stale Unix socket is removed first.
+func Listen(endpoint Endpoint) (Connector, error) {
+	if endpoint.Network == "unix" {
+		if info, err := os.Stat(endpoint.Address); err == nil && info.Mode()&os.ModeSocket != 0 {
+			if err := os.Remove(endpoint.Address); err != nil {
+				return nil, err
+			}
+		}
+	}
+	listener, err := net.Listen(endpoint.Network, endpoint.Address)
+	if err != nil {
+		return nil, err
+	}
+	return listenConnector{listener: listener}, nil
+}
+
+func (c listenConnector) Connect() (net.Conn, error) {
+	return c.listener.Accept()
+}
+
+func (c listenConnector) Close() error {
+	return c.listener.Close()
+}
+
+// RedialInterval is how long a dialing connector waits between attempts.
+var RedialInterval = time.Second
+
+type dialConnector struct {
+	endpoint Endpoint
+	closed   chan struct{}
+	once     sync.Once
+}
+
+// Dial returns a connector that dials the VM at the endpoint, retrying until
+// the VM is listening.
+func Dial(endpoint Endpoint) Connector {
+	return &dialConnector{endpoint: endpoint, closed: make(chan struct{})}
+}
+
+func (c *dialConnector) Connect() (net.Conn, error) {
+	for {
+		conn, err := net.DialTimeout(c.endpoint.Network, c.endpoint.Address, RedialInterval)
+		if err == nil {
+			return conn, nil
+		}
+		select {
+		case <-c.closed:
+			return nil, net.ErrClosed
+		case <-time.After(RedialInterval):
+		}
+	}
+}
+
+func (c *dialConnector) Close() error {
+	c.once.Do(func() { close(c.closed) })
+	return nil
+}
+
+// Link sends messages to a VM over connections from a Connector, serving the
+// VM's calls to agd on the same connections.  It keeps a connection to the VM
+// in the background, and tracks whether a block is in progress from the
+// lifecycle actions it sends, so that it only reconnects between blocks.
+type Link struct {
+	connector Connector
+	server    *rpc.Server
+	logger    log.Logger
+	software  string
+	nodePort  int
+	connOpts  jsonrpcconn.Options
+
+	mtx sync.Mutex
+	// changed is signalled when the client, failed or closed change.
+	changed *sync.Cond
+	client  *rpc.Client
+	// inBlock is set from BEGIN_BLOCK, or an in-consensus AG_COSMOS_INIT,
+	// until AFTER_COMMIT_BLOCK.
+	inBlock bool
+	// lastCommitted is the height of the last AFTER_COMMIT_BLOCK.
+	lastCommitted int64
+	// initAction is the AG_COSMOS_INIT to re-send to a reconnected VM.
+	initAction string
+	// failed is set once the connection has been lost within a block.
+	failed error
+	closed bool
+}
+
+// NewLink returns a link that serves the agdServer to the VM, and sends
+// messages to the VM's nodePort, and starts connecting to the VM.  The
+// software description is sent in agd's Hello, and the connOpts configure the
+// JSON-RPC connection, whose framing the VM must also use.
+func NewLink(connector Connector, agdServer *vm.AgdServer, logger log.Logger, software string, nodePort int, connOpts jsonrpcconn.Options) (*Link, error) {
+	if connOpts.Framing == "" {
+		connOpts.Framing = jsonrpcconn.FramingJSON
+	}
+	server := rpc.NewServer()
+	if err := server.RegisterName("agd", agdServer); err != nil {
+		return nil, err
+	}
+	l := &Link{
+		connector: connector,
+		server:    server,
+		logger:    logger,
+		software:  software,
+		nodePort:  nodePort,
+		connOpts:  connOpts,
+	}
+	l.changed = sync.NewCond(&l.mtx)
+	go l.maintain()
+	return l, nil
+}
+
+type linkAction struct {
+	Type           string          `json:"type"`
+	BlockHeight    int64           `json:"blockHeight"`
+	IsBootstrap    bool            `json:"isBootstrap"`
+	UpgradeDetails json.RawMessage `json:"upgradeDetails"`
+}
+
+// Send sends a message to the VM, connecting first if needed.  It has the
+// signature of the sendToController argument of NewAgoricApp.
+func (l *Link) Send(ctx context.Context, needReply bool, str string) (string, error) {
+	if str == "shutdown" {
+		return "", l.Close()
+	}
+
+	var action linkAction
+	// Messages that are not actions do not affect the block state.
+	_ = json.Unmarshal([]byte(str), &action)
+
+	msg := vm.Message{
+		Port:       l.nodePort,
+		NeedsReply: needReply,
+		Data:       str,
+	}
+	for {
+		client, wasInBlock, err := l.prepare(action, str)
+		if err != nil {
+			return "", err
+		}
+		var reply string
+		err = client.Call(vm.ReceiveMessageMethod, msg, &reply)
+		if err == nil {
+			l.completed(action)
+			return reply, nil
+		}
+		if _, ok := err.(rpc.ServerError); ok {
+			return "", err
+		}
+		if errors.Is(err, rpc.ErrShutdown) && !wasInBlock {
+			// The client had already shut down, so the message was not sent, and
+			// we may reconnect before trying again.
+			l.mtx.Lock()
+			l.inBlock = false
+			l.mtx.Unlock()
+			l.disconnected(client, err)
+			continue
+		}
+		l.disconnected(client, err)
+		return "", fmt.Errorf("connection to VM lost: %w", err)
+	}
+}
+
+// prepare waits for a connected client, and updates the block state for the
+// action about to be sent.  It returns the client and whether a block was
+// already in progress.
+func (l *Link) prepare(action linkAction, str string) (*rpc.Client, bool, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for l.client == nil && l.failed == nil && !l.closed {
+		l.changed.Wait()
+	}
+	if l.closed {
+		return nil, false, errors.New("VM link is closed")
+	}
+	if l.failed != nil {
+		return nil, false, l.failed
+	}
+
+	wasInBlock := l.inBlock
+	switch action.Type {
+	case "AG_COSMOS_INIT":
+		l.initAction = str
+		if action.IsBootstrap || len(action.UpgradeDetails) > 0 {
+			l.inBlock = true
+		}
+	case "BEGIN_BLOCK":
+		l.inBlock = true
+	}
+	return l.client, wasInBlock, nil
+}
+
+// completed updates the block state after an action has been processed.
+func (l *Link) completed(action linkAction) {
+	if action.Type != "AFTER_COMMIT_BLOCK" {
+		return
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.inBlock = false
+	l.lastCommitted = action.BlockHeight
+}
+
+// disconnected forgets a client whose connection was lost, refusing to
+// continue if a block was in progress.
+func (l *Link) disconnected(client *rpc.Client, err error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.client != client {
+		return
+	}
+	l.client = nil
+	l.changed.Broadcast()
+	_ = client.Close()
+	if l.closed {
+		return
+	}
+	if l.inBlock && l.failed == nil {
+		l.failed = fmt.Errorf("connection to VM lost within a block, refusing to continue: %w", err)
+		l.logger.Error("connection to VM lost within a block", "err", err)
+	} else {
+		l.logger.Info("connection to VM lost between blocks", "err", err)
+	}
+}
+
+// maintain connects to the VM whenever there is no connection, until the
+// link is closed or has failed.
+func (l *Link) maintain() {
+	for {
+		l.mtx.Lock()
+		for l.client != nil && l.failed == nil && !l.closed {
+			l.changed.Wait()
+		}
+		if l.failed != nil || l.closed {
+			l.mtx.Unlock()
+			return
+		}
+		l.mtx.Unlock()
+
+		client, err := l.connect()
+		l.mtx.Lock()
+		if err != nil {
+			if !l.closed {
+				l.logger.Error("cannot connect to VM", "err", err)
+				l.failed = err
+			}
+		} else if l.closed {
+			_ = client.Close()
+		} else {
+			l.client = client
+		}
+		l.changed.Broadcast()
+		l.mtx.Unlock()
+	}
+}
+
+// connect waits for a VM that passes the handshake, and re-sends the last
+// AG_COSMOS_INIT to it, if any.
+func (l *Link) connect() (*rpc.Client, error) {
+	l.mtx.Lock()
+	ours := Hello{
+		Software:    l.software,
+		BlockHeight: l.lastCommitted,
+		Framing:     string(l.connOpts.Framing),
+	}
+	initAction := l.initAction
+	l.mtx.Unlock()
+
+	validate := func(theirs Hello) error {
+		// Once we have committed a block, a VM reporting no blocks has lost its
+		// state, or cannot tell us, and either way cannot be reinitialized.
+		if ours.BlockHeight != 0 && theirs.BlockHeight != ours.BlockHeight {
+			return fmt.Errorf("VM has committed block %d, not %d", theirs.BlockHeight, ours.BlockHeight)
+		}
+		if framing, err := jsonrpcconn.ParseFraming(theirs.Framing); err != nil || framing != l.connOpts.Framing {
+			return fmt.Errorf("VM uses framing %q, not %q", theirs.Framing, l.connOpts.Framing)
+		}
+		return nil
+	}
+
+	for {
+		l.logger.Info("waiting for VM connection")
+		raw, err := l.connector.Connect()
+		if err != nil {
+			return nil, err
+		}
+		theirs, conn, err := AgdHandshake(raw, ours, validate)
+		if err != nil {
+			l.logger.Error("refused VM connection", "err", err)
+			continue
+		}
+		l.logger.Info("VM connected", "software", theirs.Software, "blockHeight", theirs.BlockHeight)
+
+		// Multiplex bidirectional JSON-RPC over the connection.
+		clientConn, serverConn := jsonrpcconn.ClientServerConnWithOptions(conn, l.connOpts)
+		client := jsonrpc.NewClient(clientConn)
+		go func() {
+			l.server.ServeCodec(jsonrpc.NewServerCodec(serverConn))
+			l.disconnected(client, errors.New("VM closed the connection"))
+		}()
+
+		if initAction != "" {
+			if err := reinitVM(client, l.nodePort, initAction); err != nil {
+				l.logger.Error("cannot reinitialize VM", "err", err)
+				_ = client.Close()
+				continue
+			}
+		}
+		return client, nil
+	}
+}
+
+// reinitVM re-sends AG_COSMOS_INIT to a VM that has restarted, as a restart
+// rather than a bootstrap or upgrade, since those were processed before the
+// last committed block.
+func reinitVM(client *rpc.Client, nodePort int, initAction string) error {
+	var props map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(initAction), &props); err != nil {
+		return err
+	}
+	props["isBootstrap"] = json.RawMessage("false")
+	delete(props, "upgradeDetails")
+	bz, err := json.Marshal(props)
+	if err != nil {
+		return err
+	}
+
+	msg := vm.Message{
+		Port:       nodePort,
+		NeedsReply: true,
+		Data:       string(bz),
+	}
+	var reply string
+	if err := client.Call(vm.ReceiveMessageMethod, msg, &reply); err != nil {
+		return err
+	}
+	var ok bool
+	if err := json.Unmarshal([]byte(reply), &ok); err != nil || !ok {
+		return fmt.Errorf("negative init response %q", reply)
+	}
+	return nil
+}
+
+// Close closes the link and its connector.
+func (l *Link) Close() error {
+	l.mtx.Lock()
+	l.closed = true
+	client := l.client
+	l.client = nil
+	l.changed.Broadcast()
+	l.mtx.Unlock()
+	if client != nil {
+		_ = client.Close()
+	}
+	return l.connector.Close()
+}
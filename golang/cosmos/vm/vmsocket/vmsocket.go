@@ -0,0 +1,236 @@
+/*
+Package vmsocket connects agd to a separately supervised Agoric VM over a Unix
+domain socket or a loopback TCP connection, rather than the pipes of a child
+process.
+
+Either side may listen.  Once connected, the VM sends a newline-terminated
+JSON Hello, agd checks it and replies with its own, and then both speak the
+same multiplexed JSON-RPC as over pipes (see package jsonrpcconn).  Each Hello
+names the protocol and its version, which must match exactly.  agd's reply
+carries an error if it refuses the connection.
+
+If the connection is lost between blocks, agd waits for the VM to come back
+and re-sends AG_COSMOS_INIT, as a restart of the VM.  If it is lost within a
+block, the VM's state can no longer be reconciled with the block, so agd
+refuses to continue.
+
+The VM's side is packages/cosmic-swingset/src/vm-socket.js, which
+ag-chain-cosmos uses when AGVM_DIAL (for --split-vm-listen) or AGVM_LISTEN (for
+--split-vm-dial) is set, with AGVM_FRAMING matching --split-vm-framing.  It
+exits once its connection is lost, for its supervisor to restart it.
+*/
+package vmsocket
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ProtocolName identifies the agd-VM protocol in a Hello.
+	ProtocolName = "agvm-jsonrpc"
+	// ProtocolVersion is the version of the protocol spoken after the Hello.
+	ProtocolVersion = 1
+
+	// RoleAgd and RoleVM are the roles of the Hello senders.
+	RoleAgd = "agd"
+	RoleVM  = "vm"
+)
+
+// HandshakeTimeout bounds the exchange of Hellos on a new connection.
+var HandshakeTimeout = 10 * time.Second
+
+// Endpoint is a Unix domain socket or loopback TCP address.
+type Endpoint struct {
+	// Network is "unix" or "tcp".
+	Network string
+	Address string
+}
+
+func (e Endpoint) String() string {
+	if e.Network == "unix" {
+		return "unix://" + e.Address
+	}
+	return e.Network + "://" + e.Address
+}
+
+// ParseEndpoint parses an endpoint of the form unix:///path/to/socket or
+// tcp://127.0.0.1:port.  TCP endpoints must be on a loopback interface, since
+// the connection is neither authenticated nor encrypted.
+func ParseEndpoint(s string) (Endpoint, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return Endpoint{}, fmt.Errorf("invalid VM endpoint %q: %w", s, err)
+	}
+	switch u.Scheme {
+	case "unix":
+		path := u.Path
+		if u.Host != "" {
+			// unix://relative/path
+			path = u.Host + u.Path
+		}
+		if path == "" {
+			return Endpoint{}, fmt.Errorf("VM endpoint %q has no socket path", s)
+		}
+		return Endpoint{Network: "unix", Address: path}, nil
+	case "tcp":
+		host, port, err := net.SplitHostPort(u.Host)
+		if err != nil {
+			return Endpoint{}, fmt.Errorf("invalid VM endpoint %q: %w", s, err)
+		}
+		if port == "" {
+			return Endpoint{}, fmt.Errorf("VM endpoint %q has no port", s)
+		}
+		if host != "localhost" {
+			ip := net.ParseIP(host)
+			if ip == nil || !ip.IsLoopback() {
+				return Endpoint{}, fmt.Errorf("VM endpoint %q is not a loopback address", s)
+			}
+		}
+		return Endpoint{Network: "tcp", Address: u.Host}, nil
+	default:
+		return Endpoint{}, fmt.Errorf("VM endpoint %q must have a unix or tcp scheme", s)
+	}
+}
+
+// Hello is the first message sent by each side of a connection.
+type Hello struct {
+	Protocol string `json:"protocol"`
+	Version  int    `json:"version"`
+	Role     string `json:"role"`
+	// Software describes the sender's build, for diagnostics only.
+	Software string `json:"software,omitempty"`
+	// BlockHeight is the sender's last committed block height, or 0 if none.
+	// Once agd has committed a block, it refuses a VM at any other height,
+	// including 0.
+	BlockHeight int64 `json:"blockHeight,omitempty"`
+	// Framing is the jsonrpcconn framing used after the Hello, where empty
+	// means JSON framing.
+	Framing string `json:"framing,omitempty"`
+	// Error, if set, is why the sender is refusing the connection.
+	Error string `json:"error,omitempty"`
+}
+
+// check returns an error if the Hello does not match the protocol spoken by
+// us, or is from an unexpected role.
+func (h Hello) check(role string) error {
+	if h.Error != "" {
+		return fmt.Errorf("peer refused connection: %s", h.Error)
+	}
+	if h.Protocol != ProtocolName {
+		return fmt.Errorf("peer speaks protocol %q, not %q", h.Protocol, ProtocolName)
+	}
+	if h.Version != ProtocolVersion {
+		return fmt.Errorf("peer speaks %s version %d, not %d", ProtocolName, h.Version, ProtocolVersion)
+	}
+	if h.Role != role {
+		return fmt.Errorf("peer has role %q, not %q", h.Role, role)
+	}
+	return nil
+}
+
+// handshakeConn is a connection whose first bytes have been read into a
+// buffer by the handshake.
+type handshakeConn struct {
+	net.Conn
+	reader io.Reader
+}
+
+func (c handshakeConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+func readHello(conn net.Conn) (Hello, *bufio.Reader, error) {
+	var hello Hello
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return hello, nil, fmt.Errorf("cannot read hello: %w", err)
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(line)), &hello); err != nil {
+		return hello, nil, fmt.Errorf("invalid hello %q: %w", line, err)
+	}
+	return hello, reader, nil
+}
+
+func writeHello(conn net.Conn, hello Hello) error {
+	hello.Protocol = ProtocolName
+	hello.Version = ProtocolVersion
+	bz, err := json.Marshal(hello)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Write(append(bz, '\n')); err != nil {
+		return fmt.Errorf("cannot send hello: %w", err)
+	}
+	return nil
+}
+
+// AgdHandshake performs agd's side of the handshake on a new connection.  It
+// reads the VM's Hello, checks it and then any further requirements of
+// validate, which may be nil, and replies with ours, including the reason for
+// any refusal.  It returns the VM's Hello and the connection to use for
+// JSON-RPC, or closes the connection on error.
+func AgdHandshake(conn net.Conn, ours Hello, validate func(Hello) error) (Hello, net.Conn, error) {
+	ours.Role = RoleAgd
+	if err := conn.SetDeadline(time.Now().Add(HandshakeTimeout)); err != nil {
+		conn.Close()
+		return Hello{}, nil, err
+	}
+	theirs, reader, err := readHello(conn)
+	if err == nil {
+		err = theirs.check(RoleVM)
+	}
+	if err == nil && validate != nil {
+		err = validate(theirs)
+	}
+	if err != nil {
+		ours.Error = err.Error()
+		_ = writeHello(conn, ours)
+		conn.Close()
+		return theirs, nil, err
+	}
+	if err := writeHello(conn, ours); err != nil {
+		conn.Close()
+		return theirs, nil, err
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return theirs, nil, err
+	}
+	return theirs, handshakeConn{Conn: conn, reader: reader}, nil
+}
+
+// VMHandshake performs the VM's side of the handshake on a new connection,
+// sending our Hello and then reading agd's.  It returns agd's Hello and the
+// connection to use for JSON-RPC, or closes the connection on error.
+func VMHandshake(conn net.Conn, ours Hello) (Hello, net.Conn, error) {
+	ours.Role = RoleVM
+	if err := conn.SetDeadline(time.Now().Add(HandshakeTimeout)); err != nil {
+		conn.Close()
+		return Hello{}, nil, err
+	}
+	err := writeHello(conn, ours)
+	var theirs Hello
+	var reader *bufio.Reader
+	if err == nil {
+		theirs, reader, err = readHello(conn)
+	}
+	if err == nil {
+		err = theirs.check(RoleAgd)
+	}
+	if err == nil {
+		err = conn.SetDeadline(time.Time{})
+	}
+	if err != nil {
+		conn.Close()
+		return theirs, nil, err
+	}
+	return theirs, handshakeConn{Conn: conn, reader: reader}, nil
+}
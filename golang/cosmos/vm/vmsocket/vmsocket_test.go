@@ -0,0 +1,274 @@
+package vmsocket
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/jsonrpcconn"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	testCases := []struct {
+		endpoint string
+		expected Endpoint
+		errMsg   string
+	}{
+		{"unix:///tmp/agvm.sock", Endpoint{"unix", "/tmp/agvm.sock"}, ""},
+		{"unix://agvm.sock", Endpoint{"unix", "agvm.sock"}, ""},
+		{"tcp://127.0.0.1:26699", Endpoint{"tcp", "127.0.0.1:26699"}, ""},
+		{"tcp://[::1]:26699", Endpoint{"tcp", "[::1]:26699"}, ""},
+		{"tcp://localhost:26699", Endpoint{"tcp", "localhost:26699"}, ""},
+		{"tcp://10.0.0.1:26699", Endpoint{}, "not a loopback address"},
+		{"tcp://127.0.0.1", Endpoint{}, "invalid VM endpoint"},
+		{"unix://", Endpoint{}, "no socket path"},
+		{"http://127.0.0.1:80", Endpoint{}, "must have a unix or tcp scheme"},
+	}
+	for _, tc := range testCases {
+		endpoint, err := ParseEndpoint(tc.endpoint)
+		if tc.errMsg != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+				t.Errorf("%s: got error %v, expected %q", tc.endpoint, err, tc.errMsg)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tc.endpoint, err)
+		} else if endpoint != tc.expected {
+			t.Errorf("%s: got %+v, expected %+v", tc.endpoint, endpoint, tc.expected)
+		}
+	}
+}
+
+func TestHandshakeVersionMismatch(t *testing.T) {
+	agdConn, vmConn := net.Pipe()
+	done := make(chan error)
+	go func() {
+		_, _, err := AgdHandshake(agdConn, Hello{}, nil)
+		done <- err
+	}()
+
+	_, err := vmConn.Write([]byte(`{"protocol":"agvm-jsonrpc","version":2,"role":"vm"}` + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hello, _, err := readHello(vmConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(hello.Error, "version 2, not 1") {
+		t.Errorf("got refusal %q, expected a version mismatch", hello.Error)
+	}
+	if err := <-done; err == nil {
+		t.Error("got no error from agd handshake")
+	}
+}
+
+// fakeVM is a VM that records the messages it receives, and calls the echo
+// port of agd while handling END_BLOCK.
+type fakeVM struct {
+	t      *testing.T
+	conn   net.Conn
+	client *rpc.Client
+
+	mtx      sync.Mutex
+	received []string
+}
+
+type fakeVMReceiver struct {
+	vm *fakeVM
+}
+
+func (r fakeVMReceiver) ReceiveMessage(msg *vm.Message, reply *string) error {
+	r.vm.mtx.Lock()
+	r.vm.received = append(r.vm.received, msg.Data)
+	r.vm.mtx.Unlock()
+	if strings.Contains(msg.Data, "END_BLOCK") {
+		return r.vm.client.Call("agd.ReceiveMessage", vm.Message{Port: 1, Data: "ping"}, reply)
+	}
+	*reply = "true"
+	return nil
+}
+
+func startFakeVM(t *testing.T, endpoint Endpoint, blockHeight int64) (*fakeVM, error) {
+	raw, err := net.Dial(endpoint.Network, endpoint.Address)
+	if err != nil {
+		return nil, err
+	}
+	_, conn, err := VMHandshake(raw, Hello{Software: "fake", BlockHeight: blockHeight})
+	if err != nil {
+		return nil, err
+	}
+	f := &fakeVM{t: t, conn: conn}
+	clientConn, serverConn := jsonrpcconn.ClientServerConn(conn)
+	f.client = jsonrpc.NewClient(clientConn)
+	server := rpc.NewServer()
+	if err := server.RegisterName("agvm", fakeVMReceiver{vm: f}); err != nil {
+		t.Fatal(err)
+	}
+	go server.ServeCodec(jsonrpc.NewServerCodec(serverConn))
+	return f, nil
+}
+
+func (f *fakeVM) types() []string {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	types := make([]string, len(f.received))
+	for i, str := range f.received {
+		var action linkAction
+		_ = json.Unmarshal([]byte(str), &action)
+		types[i] = action.Type
+	}
+	return types
+}
+
+type echoPort struct{}
+
+func (echoPort) Receive(ctx context.Context, str string) (string, error) {
+	return "echo " + str, nil
+}
+
+func newTestLink(t *testing.T) (*Link, Endpoint) {
+	return newTestLinkWithOptions(t, jsonrpcconn.Options{})
+}
+
+func newTestLinkWithOptions(t *testing.T, connOpts jsonrpcconn.Options) (*Link, Endpoint) {
+	endpoint := Endpoint{Network: "unix", Address: filepath.Join(t.TempDir(), "agvm.sock")}
+	connector, err := Listen(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	agdServer := vm.NewAgdServer()
+	agdServer.MustRegisterPortHandler("echo", echoPort{})
+	link, err := NewLink(connector, agdServer, log.NewNopLogger(), "test", 1, connOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { link.Close() })
+	return link, endpoint
+}
+
+func sendAll(t *testing.T, link *Link, actions ...string) {
+	for _, action := range actions {
+		reply, err := link.Send(context.Background(), true, action)
+		if err != nil {
+			t.Fatalf("%s: %v", action, err)
+		}
+		if strings.Contains(action, "END_BLOCK") && reply != "echo ping" {
+			t.Errorf("got END_BLOCK reply %q, expected the VM's call to agd", reply)
+		}
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("timed out")
+}
+
+const (
+	initAction        = `{"type":"AG_COSMOS_INIT","isBootstrap":true,"upgradeDetails":{"plan":{}},"storagePort":1}`
+	beginBlock        = `{"type":"BEGIN_BLOCK","blockHeight":1}`
+	endBlock          = `{"type":"END_BLOCK","blockHeight":1}`
+	commitBlock       = `{"type":"COMMIT_BLOCK","blockHeight":1}`
+	afterCommitBlock  = `{"type":"AFTER_COMMIT_BLOCK","blockHeight":1}`
+	beginSecondBlock  = `{"type":"BEGIN_BLOCK","blockHeight":2}`
+	endSecondBlock    = `{"type":"END_BLOCK","blockHeight":2}`
+	expectedFirstRun  = "AG_COSMOS_INIT BEGIN_BLOCK END_BLOCK COMMIT_BLOCK AFTER_COMMIT_BLOCK"
+	expectedSecondRun = "AG_COSMOS_INIT BEGIN_BLOCK"
+)
+
+func TestLinkReconnectsBetweenBlocks(t *testing.T) {
+	link, endpoint := newTestLink(t)
+
+	first, err := startFakeVM(t, endpoint, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendAll(t, link, initAction, beginBlock, endBlock, commitBlock, afterCommitBlock)
+	if got := strings.Join(first.types(), " "); got != expectedFirstRun {
+		t.Errorf("got %s, expected %s", got, expectedFirstRun)
+	}
+
+	// The VM restarts between blocks.
+	first.conn.Close()
+	waitFor(t, func() bool {
+		link.mtx.Lock()
+		defer link.mtx.Unlock()
+		return link.client == nil
+	})
+
+	// A VM that has not committed the same block is refused.
+	if _, err := startFakeVM(t, endpoint, 5); err == nil || !strings.Contains(err.Error(), "committed block 5, not 1") {
+		t.Errorf("got error %v, expected a height mismatch", err)
+	}
+	if _, err := startFakeVM(t, endpoint, 0); err == nil || !strings.Contains(err.Error(), "committed block 0, not 1") {
+		t.Errorf("got error %v, expected a height mismatch", err)
+	}
+
+	second, err := startFakeVM(t, endpoint, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendAll(t, link, beginSecondBlock)
+	if got := strings.Join(second.types(), " "); got != expectedSecondRun {
+		t.Errorf("got %s, expected %s", got, expectedSecondRun)
+	}
+
+	// The VM is reinitialized as a restart.
+	second.mtx.Lock()
+	reinit := second.received[0]
+	second.mtx.Unlock()
+	var props map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(reinit), &props); err != nil {
+		t.Fatal(err)
+	}
+	if string(props["isBootstrap"]) != "false" || props["upgradeDetails"] != nil || string(props["storagePort"]) != "1" {
+		t.Errorf("got reinit %s, expected a restart with the same ports", reinit)
+	}
+}
+
+func TestLinkRefusesMidBlock(t *testing.T) {
+	link, endpoint := newTestLink(t)
+
+	first, err := startFakeVM(t, endpoint, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendAll(t, link, initAction, beginBlock)
+
+	// The VM goes away within the block, and is not waited for.
+	first.conn.Close()
+	for i := 0; i < 2; i++ {
+		_, err = link.Send(context.Background(), true, endSecondBlock)
+		if err == nil {
+			t.Fatal("got no error after losing the VM within a block")
+		}
+	}
+	if !strings.Contains(err.Error(), "refusing to continue") {
+		t.Errorf("got error %v, expected a refusal to continue", err)
+	}
+}
+
+func TestLinkRefusesFramingMismatch(t *testing.T) {
+	_, endpoint := newTestLinkWithOptions(t, jsonrpcconn.Options{Framing: jsonrpcconn.FramingLengthPrefixed})
+
+	_, err := startFakeVM(t, endpoint, 0)
+	if err == nil || !strings.Contains(err.Error(), `uses framing "", not "length-prefixed"`) {
+		t.Errorf("got error %v, expected a framing mismatch", err)
+	}
+}
@@ -0,0 +1,142 @@
+package vmtest
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// ChainID is the chain ID of the apps made by NewApp.
+const ChainID = "agoric-vmtest"
+
+// App is an in-memory Agoric app driven by a fake controller.
+type App struct {
+	*gaia.GaiaApp
+	Controller *Controller
+	// Header is the header of the last block begun.
+	Header tmproto.Header
+}
+
+type appOptions map[string]interface{}
+
+func (opts appOptions) Get(key string) interface{} {
+	return opts[key]
+}
+
+// NewApp returns an in-memory Agoric app whose controller is a fake.  The
+// controller can be scripted before the chain is initialized.
+func NewApp(tb testing.TB) *App {
+	tb.Helper()
+	home := tb.TempDir()
+	appOpts := appOptions{
+		gaia.FlagSwingStoreExportDir: filepath.Join(home, "swing-store-export"),
+	}
+	agdServer := vm.NewAgdServer()
+	controller := NewController(agdServer)
+	app := gaia.NewAgoricApp(
+		controller.Send, agdServer,
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		home, 0, gaia.MakeEncodingConfig(), appOpts,
+	)
+	return &App{
+		GaiaApp:    app,
+		Controller: controller,
+		Header: tmproto.Header{
+			ChainID: ChainID,
+			Time:    time.Unix(1_700_000_000, 0).UTC(),
+		},
+	}
+}
+
+// DefaultGenesis returns the default genesis state of the app with a single
+// bonded validator, without which the chain cannot start.
+func (a *App) DefaultGenesis(tb testing.TB) gaia.GenesisState {
+	tb.Helper()
+	cdc := a.AppCodec()
+	genesis := gaia.NewDefaultGenesisState()
+
+	pubKey := ed25519.GenPrivKeyFromSecret([]byte(ChainID)).PubKey()
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	delegator := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(pubKey.Address()))
+	valAddr := sdk.ValAddress(pubKey.Address())
+	bondAmt := sdk.DefaultPowerReduction
+	validator := stakingtypes.Validator{
+		OperatorAddress:   valAddr.String(),
+		ConsensusPubkey:   pkAny,
+		Status:            stakingtypes.Bonded,
+		Tokens:            bondAmt,
+		DelegatorShares:   sdk.OneDec(),
+		UnbondingTime:     time.Unix(0, 0).UTC(),
+		Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.ZeroInt(),
+	}
+	delegation := stakingtypes.NewDelegation(delegator.GetAddress(), valAddr, sdk.OneDec())
+
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{delegator})
+	genesis[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+	stakingGenesis := stakingtypes.NewGenesisState(
+		stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, []stakingtypes.Delegation{delegation},
+	)
+	genesis[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+	bonded := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   bonded,
+	}}
+	bankGenesis.Supply = bonded
+	genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+	return genesis
+}
+
+// InitChain initializes the chain from the genesis state, which bootstraps
+// the controller.  As with Tendermint, the genesis state is committed with the
+// first block.
+func (a *App) InitChain(tb testing.TB, genesis gaia.GenesisState) {
+	tb.Helper()
+	appState, err := json.Marshal(genesis)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	a.GaiaApp.InitChain(abci.RequestInitChain{
+		ChainId:       ChainID,
+		Time:          a.Header.Time,
+		AppStateBytes: appState,
+	})
+}
+
+// NextBlock runs a block, calling deliver, if not nil, with the block's
+// context between BeginBlock and EndBlock, and then commits it.
+func (a *App) NextBlock(deliver func(ctx sdk.Context)) {
+	a.Header.Height++
+	a.Header.Time = a.Header.Time.Add(5 * time.Second)
+	a.BeginBlock(abci.RequestBeginBlock{Header: a.Header})
+	if deliver != nil {
+		deliver(a.NewContext(false, a.Header))
+	}
+	a.EndBlock(abci.RequestEndBlock{Height: a.Header.Height})
+	a.Commit()
+}
+
+// QueryContext returns a context on the last committed state.
+func (a *App) QueryContext() sdk.Context {
+	return a.NewContext(true, a.Header)
+}
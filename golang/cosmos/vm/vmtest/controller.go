@@ -0,0 +1,351 @@
+// Package vmtest provides a scriptable stand-in for the JS controller, so that
+// flows through the Agoric app and its port handlers can be tested in pure Go.
+package vmtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// Names of the inbound queues that the controller drains at END_BLOCK.
+const (
+	ActionQueue       = "actionQueue"
+	HighPriorityQueue = "highPriorityQueue"
+)
+
+// StoragePortName is the controller's name for the vstorage port, as derived
+// from the storagePort property of AG_COSMOS_INIT.
+const StoragePortName = "storage"
+
+// QueueContext is the context recorded with an action on an inbound queue.
+type QueueContext struct {
+	BlockHeight int64  `json:"blockHeight"`
+	TxHash      string `json:"txHash"`
+	MsgIdx      int    `json:"msgIdx"`
+}
+
+// Action is an action sent to the controller by the app, or consumed from one
+// of the inbound queues.
+type Action struct {
+	vm.ActionHeader
+	// Raw is the JSON of the whole action.
+	Raw json.RawMessage
+	// Queue is the inbound queue the action was consumed from, or empty if it
+	// was sent directly.
+	Queue string
+	// Context is the queue context of a consumed action.
+	Context *QueueContext
+}
+
+// Decode unmarshals the JSON of the action into v.
+func (a Action) Decode(v interface{}) error {
+	return json.Unmarshal(a.Raw, v)
+}
+
+// ActionHandler handles an action, returning the reply to send to the app.
+// The replies to queued actions are discarded.
+type ActionHandler func(action Action) (string, error)
+
+// Controller is a fake controller whose Send method can be passed to
+// NewAgoricApp as its sendToController.  Like the JS controller, it answers
+// the AG_COSMOS_INIT handshake and the block lifecycle actions, and drains the
+// inbound queues through the vstorage port at END_BLOCK.  Handlers for any
+// action type, sent or queued, can be scripted with Handle.
+type Controller struct {
+	server *vm.AgdServer
+
+	mtx      sync.Mutex
+	handlers map[string]ActionHandler
+	// ports maps the controller's port names to port numbers, once inited.
+	ports    map[string]int
+	inited   bool
+	shutdown bool
+	sent     []Action
+	consumed []Action
+}
+
+// NewController returns a controller that calls back into the ports of the
+// given server.
+func NewController(server *vm.AgdServer) *Controller {
+	c := &Controller{
+		server:   server,
+		handlers: map[string]ActionHandler{},
+		ports:    map[string]int{},
+	}
+	c.handlers["AG_COSMOS_INIT"] = c.handleInit
+	c.handlers["BEGIN_BLOCK"] = c.requireInited
+	c.handlers["END_BLOCK"] = c.handleEndBlock
+	c.handlers["COMMIT_BLOCK"] = c.requireInited
+	c.handlers["AFTER_COMMIT_BLOCK"] = c.requireInited
+	return c
+}
+
+// Handle scripts the handler of an action type, replacing any previous one,
+// including the built-in handling of the lifecycle actions.  A replacement
+// END_BLOCK handler should call DrainQueues to consume the inbound queues.
+func (c *Controller) Handle(actionType string, handler ActionHandler) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.handlers[actionType] = handler
+}
+
+// Send receives a message from the app.  It has the signature of the
+// sendToController argument of NewAgoricApp.
+func (c *Controller) Send(ctx context.Context, needReply bool, str string) (string, error) {
+	if str == "shutdown" {
+		c.mtx.Lock()
+		c.shutdown = true
+		c.mtx.Unlock()
+		return "", nil
+	}
+
+	action, err := parseAction(json.RawMessage(str))
+	if err != nil {
+		return "", err
+	}
+
+	c.mtx.Lock()
+	if c.shutdown {
+		c.mtx.Unlock()
+		return "", fmt.Errorf("controller is shut down")
+	}
+	c.sent = append(c.sent, action)
+	handler := c.handlers[action.Type]
+	c.mtx.Unlock()
+
+	if handler == nil {
+		return "", fmt.Errorf("Unrecognized action %s", action.Type)
+	}
+	reply, err := handler(action)
+	if !needReply {
+		return "", err
+	}
+	return reply, err
+}
+
+func parseAction(raw json.RawMessage) (Action, error) {
+	action := Action{Raw: raw}
+	if err := json.Unmarshal(raw, &action.ActionHeader); err != nil {
+		return action, fmt.Errorf("cannot parse action: %w", err)
+	}
+	if action.Type == "" {
+		return action, fmt.Errorf("action has no type: %s", raw)
+	}
+	return action, nil
+}
+
+func (c *Controller) handleInit(action Action) (string, error) {
+	var props map[string]json.RawMessage
+	if err := action.Decode(&props); err != nil {
+		return "", err
+	}
+	// Every property ending in "Port" is a port number, named without the
+	// suffix, as in chain-main.js.
+	ports := map[string]int{}
+	for key, value := range props {
+		if !strings.HasSuffix(key, "Port") {
+			continue
+		}
+		var port int
+		if err := json.Unmarshal(value, &port); err != nil {
+			return "", fmt.Errorf("cannot parse %s: %w", key, err)
+		}
+		ports[strings.TrimSuffix(key, "Port")] = port
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.inited {
+		return "", fmt.Errorf("controller already inited")
+	}
+	c.ports = ports
+	c.inited = true
+	return "true", nil
+}
+
+func (c *Controller) requireInited(action Action) (string, error) {
+	if !c.Inited() {
+		return "", fmt.Errorf("%s before AG_COSMOS_INIT", action.Type)
+	}
+	return "true", nil
+}
+
+func (c *Controller) handleEndBlock(action Action) (string, error) {
+	if _, err := c.requireInited(action); err != nil {
+		return "", err
+	}
+	if err := c.DrainQueues(); err != nil {
+		return "", err
+	}
+	return "true", nil
+}
+
+// Inited returns whether the controller has received AG_COSMOS_INIT.
+func (c *Controller) Inited() bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.inited
+}
+
+// Port returns the port number of the given controller port name, such as
+// "storage", "vbank", "vibc", "vlocalchain" or "swingset", or 0 if the app did
+// not announce it.
+func (c *Controller) Port(name string) int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.ports[name]
+}
+
+// Call sends a message to the named port of the app, as the controller does
+// while handling an action.  A msg that is not a string is marshalled to JSON.
+// The port handler runs in the context of the call into the controller.
+func (c *Controller) Call(portName string, msg interface{}) (string, error) {
+	port := c.Port(portName)
+	if port == 0 {
+		return "", fmt.Errorf("unknown port %q", portName)
+	}
+	data, ok := msg.(string)
+	if !ok {
+		bz, err := json.Marshal(msg)
+		if err != nil {
+			return "", err
+		}
+		data = string(bz)
+	}
+	var reply string
+	err := c.server.ReceiveMessage(&vm.Message{Port: port, Data: data}, &reply)
+	return reply, err
+}
+
+type storageMessage struct {
+	Method string        `json:"method"`
+	Args   []interface{} `json:"args"`
+}
+
+// StorageGet returns the value of a vstorage path, and whether it has one.
+func (c *Controller) StorageGet(path string) (string, bool, error) {
+	reply, err := c.Call(StoragePortName, storageMessage{Method: "get", Args: []interface{}{path}})
+	if err != nil {
+		return "", false, err
+	}
+	var value *string
+	if err := json.Unmarshal([]byte(reply), &value); err != nil {
+		return "", false, err
+	}
+	if value == nil {
+		return "", false, nil
+	}
+	return *value, true, nil
+}
+
+// StorageSet sets vstorage paths without notification, as the controller does
+// for its queues.  Each entry is a [path, value] pair, or a [path] to delete.
+func (c *Controller) StorageSet(entries ...[]string) error {
+	args := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		args[i] = entry
+	}
+	_, err := c.Call(StoragePortName, storageMessage{Method: "setWithoutNotify", Args: args})
+	return err
+}
+
+func (c *Controller) getQueueIndex(path string) (uint64, error) {
+	value, ok, err := c.StorageGet(path)
+	if err != nil || !ok {
+		return 0, err
+	}
+	index, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid queue index %s: %w", path, err)
+	}
+	return index, nil
+}
+
+// DrainQueues consumes the highPriorityQueue and then the actionQueue.
+func (c *Controller) DrainQueues() error {
+	for _, queue := range []string{HighPriorityQueue, ActionQueue} {
+		if err := c.DrainQueue(queue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type queueRecord struct {
+	Action  json.RawMessage `json:"action"`
+	Context QueueContext    `json:"context"`
+}
+
+// DrainQueue consumes every action of an inbound queue in order, passing each
+// to the handler of its type, and then clears the queue as makeQueue does.
+// Actions without a handler are only recorded.  An error from a handler
+// aborts the drain, leaving the queue unchanged.
+func (c *Controller) DrainQueue(queue string) error {
+	head, err := c.getQueueIndex(queue + ".head")
+	if err != nil {
+		return err
+	}
+	tail, err := c.getQueueIndex(queue + ".tail")
+	if err != nil {
+		return err
+	}
+	if head >= tail {
+		return nil
+	}
+
+	deletes := make([][]string, 0, tail-head+2)
+	for i := head; i < tail; i++ {
+		path := fmt.Sprintf("%s.%d", queue, i)
+		value, ok, err := c.StorageGet(path)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("missing queue entry %s", path)
+		}
+		var record queueRecord
+		if err := json.Unmarshal([]byte(value), &record); err != nil {
+			return fmt.Errorf("cannot parse queue entry %s: %w", path, err)
+		}
+		action, err := parseAction(record.Action)
+		if err != nil {
+			return fmt.Errorf("queue entry %s: %w", path, err)
+		}
+		action.Queue = queue
+		action.Context = &record.Context
+
+		c.mtx.Lock()
+		c.consumed = append(c.consumed, action)
+		handler := c.handlers[action.Type]
+		c.mtx.Unlock()
+
+		if handler != nil {
+			if _, err := handler(action); err != nil {
+				return fmt.Errorf("queue entry %s: %w", path, err)
+			}
+		}
+		deletes = append(deletes, []string{path})
+	}
+	deletes = append(deletes, []string{queue + ".head"}, []string{queue + ".tail"})
+	return c.StorageSet(deletes...)
+}
+
+// Sent returns the actions sent to the controller by the app, in order.
+func (c *Controller) Sent() []Action {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return append([]Action{}, c.sent...)
+}
+
+// Consumed returns the actions consumed from the inbound queues, in order.
+func (c *Controller) Consumed() []Action {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return append([]Action{}, c.consumed...)
+}
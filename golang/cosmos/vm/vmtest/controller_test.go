@@ -0,0 +1,163 @@
+package vmtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/vmtest"
+	vbanktypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+type testAction struct {
+	*vm.ActionHeader `actionType:"TEST_ACTION"`
+	Name             string `json:"name"`
+}
+
+func actionTypes(actions []vmtest.Action) []string {
+	types := make([]string, len(actions))
+	for i, action := range actions {
+		types[i] = action.Type
+	}
+	return types
+}
+
+func newInitedApp(t *testing.T) *vmtest.App {
+	app := vmtest.NewApp(t)
+	app.InitChain(t, app.DefaultGenesis(t))
+	return app
+}
+
+func TestControllerLifecycle(t *testing.T) {
+	app := newInitedApp(t)
+	if !app.Controller.Inited() {
+		t.Fatal("controller not inited by InitChain")
+	}
+	for _, name := range []string{"storage", "swingset", "vbank", "vibc", "vlocalchain"} {
+		if app.Controller.Port(name) == 0 {
+			t.Errorf("port %q not announced by AG_COSMOS_INIT", name)
+		}
+	}
+
+	app.NextBlock(nil)
+	got := fmt.Sprint(actionTypes(app.Controller.Sent()))
+	expected := fmt.Sprint([]string{
+		"AG_COSMOS_INIT", "BEGIN_BLOCK", "END_BLOCK", "COMMIT_BLOCK", "AFTER_COMMIT_BLOCK",
+	})
+	if got != expected {
+		t.Errorf("got sent actions %s, expected %s", got, expected)
+	}
+
+	begin := app.Controller.Sent()[1]
+	if begin.BlockHeight != 1 {
+		t.Errorf("got BEGIN_BLOCK height %d, expected 1", begin.BlockHeight)
+	}
+	var beginBlock struct {
+		ChainID string `json:"chainID"`
+	}
+	if err := begin.Decode(&beginBlock); err != nil {
+		t.Fatal(err)
+	}
+	if beginBlock.ChainID != vmtest.ChainID {
+		t.Errorf("got BEGIN_BLOCK chainID %q, expected %q", beginBlock.ChainID, vmtest.ChainID)
+	}
+}
+
+func TestControllerUnrecognizedAction(t *testing.T) {
+	controller := vmtest.NewController(vm.NewAgdServer())
+	_, err := controller.Send(context.Background(), true, `{"type":"NO_SUCH_ACTION"}`)
+	if err == nil || err.Error() != "Unrecognized action NO_SUCH_ACTION" {
+		t.Errorf("got error %v, expected Unrecognized action", err)
+	}
+	_, err = controller.Send(context.Background(), true, `{"type":"BEGIN_BLOCK"}`)
+	if err == nil {
+		t.Error("got no error for BEGIN_BLOCK before AG_COSMOS_INIT")
+	}
+}
+
+func TestControllerDrainsQueues(t *testing.T) {
+	app := newInitedApp(t)
+
+	// Each consumed action writes to vstorage through the port.
+	app.Controller.Handle("TEST_ACTION", func(action vmtest.Action) (string, error) {
+		var msg testAction
+		if err := action.Decode(&msg); err != nil {
+			return "", err
+		}
+		return app.Controller.Call(vmtest.StoragePortName, map[string]interface{}{
+			"method": "set",
+			"args":   [][]string{{"published.test." + msg.Name, action.Queue}},
+		})
+	})
+
+	app.NextBlock(func(ctx sdk.Context) {
+		for _, name := range []string{"a", "b"} {
+			if err := app.SwingSetKeeper.PushAction(ctx, &testAction{Name: name}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := app.SwingSetKeeper.PushHighPriorityAction(ctx, &testAction{Name: "c"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	consumed := app.Controller.Consumed()
+	names := make([]string, len(consumed))
+	for i, action := range consumed {
+		var msg testAction
+		if err := action.Decode(&msg); err != nil {
+			t.Fatal(err)
+		}
+		names[i] = msg.Name
+		if action.Context == nil || action.Context.BlockHeight != 1 {
+			t.Errorf("got context %+v for action %q, expected block height 1", action.Context, msg.Name)
+		}
+	}
+	if fmt.Sprint(names) != "[c a b]" {
+		t.Errorf("got consumed actions %v, expected [c a b]", names)
+	}
+
+	ctx := app.QueryContext()
+	for name, queue := range map[string]string{"a": vmtest.ActionQueue, "b": vmtest.ActionQueue, "c": vmtest.HighPriorityQueue} {
+		entry := app.VstorageKeeper.GetEntry(ctx, "published.test."+name)
+		if entry.StringValue() != queue {
+			t.Errorf("got published.test.%s %q, expected %q", name, entry.StringValue(), queue)
+		}
+	}
+	for _, queue := range []string{vmtest.ActionQueue, vmtest.HighPriorityQueue} {
+		if children := app.VstorageKeeper.GetChildren(ctx, queue); len(children.Children) != 0 {
+			t.Errorf("got %s entries %v after draining", queue, children.Children)
+		}
+	}
+}
+
+func TestControllerCallsPorts(t *testing.T) {
+	app := newInitedApp(t)
+
+	var reserveAddr string
+	app.Controller.Handle("BEGIN_BLOCK", func(action vmtest.Action) (string, error) {
+		reply, err := app.Controller.Call("vbank", map[string]string{
+			"type":       "VBANK_GET_MODULE_ACCOUNT_ADDRESS",
+			"moduleName": vbanktypes.ReservePoolName,
+		})
+		if err != nil {
+			return "", err
+		}
+		return "true", json.Unmarshal([]byte(reply), &reserveAddr)
+	})
+	app.NextBlock(nil)
+
+	expected := authtypes.NewModuleAddress(vbanktypes.ReservePoolName).String()
+	if reserveAddr != expected {
+		t.Errorf("got reserve address %q, expected %q", reserveAddr, expected)
+	}
+
+	if _, err := app.Controller.Call("nonexistent", "{}"); err == nil {
+		t.Error("got no error calling an unknown port")
+	}
+}
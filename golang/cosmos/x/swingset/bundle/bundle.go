@@ -0,0 +1,243 @@
+// Package bundle parses and validates endoZipBase64 bundles, the format of the
+// JavaScript bundles installed on the SwingSet controller, as produced by
+// @endo/bundle-source and checked by @endo/check-bundle.
+//
+// An endoZipBase64 bundle is a JSON object carrying a base64-encoded zip
+// archive and the hex SHA-512 of the archive's compartment-map.json.  Since the
+// compartment map records the SHA-512 of every module in the archive, that hash
+// identifies the whole bundle, and "b1-" followed by it is the bundle ID.
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// ModuleFormat is the only bundle format accepted by the controller.
+	ModuleFormat = "endoZipBase64"
+
+	// IDPrefix prefixes the hash of a bundle to form its ID.
+	IDPrefix = "b1-"
+
+	// CompartmentMapName is the name of the compartment map in the archive.
+	CompartmentMapName = "compartment-map.json"
+)
+
+var (
+	// ErrFormat is returned for bundles that are not well-formed endoZipBase64
+	// JSON.
+	ErrFormat = errors.New("malformed endoZipBase64 bundle")
+
+	// ErrArchive is returned for bundles whose zip archive is unreadable or
+	// inconsistent with its compartment map.
+	ErrArchive = errors.New("invalid bundle archive")
+
+	// ErrHashMismatch is returned when a hash computed from the archive does
+	// not match the one declared for it.
+	ErrHashMismatch = errors.New("bundle hash mismatch")
+)
+
+var sha512HexRE = regexp.MustCompile(`^[0-9a-f]{128}$`)
+
+// Bundle is the JSON form of an endoZipBase64 bundle.
+type Bundle struct {
+	ModuleFormat        string `json:"moduleFormat"`
+	EndoZipBase64       string `json:"endoZipBase64"`
+	EndoZipBase64Sha512 string `json:"endoZipBase64Sha512"`
+}
+
+// Parse decodes bundle JSON and checks its shape, without examining the
+// archive.
+func Parse(bundleJSON []byte) (*Bundle, error) {
+	var b Bundle
+	if err := json.Unmarshal(bundleJSON, &b); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrFormat, err)
+	}
+	if err := b.CheckShape(); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// CheckShape performs the cheap checks of a bundle: its module format and the
+// form of its declared hash.
+func (b Bundle) CheckShape() error {
+	if b.ModuleFormat != ModuleFormat {
+		return fmt.Errorf("%w: moduleFormat must be %q, not %q", ErrFormat, ModuleFormat, b.ModuleFormat)
+	}
+	if b.EndoZipBase64 == "" {
+		return fmt.Errorf("%w: endoZipBase64 is empty", ErrFormat)
+	}
+	if !sha512HexRE.MatchString(b.EndoZipBase64Sha512) {
+		return fmt.Errorf("%w: endoZipBase64Sha512 must be a lowercase hex SHA-512", ErrFormat)
+	}
+	return nil
+}
+
+// ID returns the bundle ID derived from the declared hash.  It is only
+// trustworthy once Validate has succeeded.
+func (b Bundle) ID() string {
+	return IDPrefix + b.EndoZipBase64Sha512
+}
+
+// compartmentMap is the subset of an archived compartment map that is
+// validated.
+type compartmentMap struct {
+	Entry *struct {
+		Compartment string `json:"compartment"`
+		Module      string `json:"module"`
+	} `json:"entry"`
+	Compartments map[string]struct {
+		Location string                       `json:"location"`
+		Modules  map[string]compartmentModule `json:"modules"`
+	} `json:"compartments"`
+}
+
+// compartmentModule describes a module of a compartment.  A module stored in
+// the archive has a location and its SHA-512, whereas a module linked from
+// another compartment or provided by the host has neither.
+type compartmentModule struct {
+	Location string `json:"location"`
+	Sha512   string `json:"sha512"`
+}
+
+// Validate decodes and checks the archive of a bundle: the zip structure, the
+// presence and consistency of compartment-map.json, the hash of every archived
+// module, and finally the declared hash of the bundle.
+func (b Bundle) Validate() error {
+	if err := b.CheckShape(); err != nil {
+		return err
+	}
+	data, err := base64.StdEncoding.DecodeString(b.EndoZipBase64)
+	if err != nil {
+		return fmt.Errorf("%w: endoZipBase64: %s", ErrFormat, err)
+	}
+	files, err := readZip(data)
+	if err != nil {
+		return err
+	}
+
+	mapBytes, ok := files[CompartmentMapName]
+	if !ok {
+		return fmt.Errorf("%w: missing %s", ErrArchive, CompartmentMapName)
+	}
+	var cmap compartmentMap
+	if err := json.Unmarshal(mapBytes, &cmap); err != nil {
+		return fmt.Errorf("%w: %s: %s", ErrArchive, CompartmentMapName, err)
+	}
+	if cmap.Entry == nil {
+		return fmt.Errorf("%w: %s has no entry", ErrArchive, CompartmentMapName)
+	}
+	entry, ok := cmap.Compartments[cmap.Entry.Compartment]
+	if !ok {
+		return fmt.Errorf("%w: entry compartment %q not found", ErrArchive, cmap.Entry.Compartment)
+	}
+	if _, ok := entry.Modules[cmap.Entry.Module]; !ok {
+		return fmt.Errorf("%w: entry module %q not found in compartment %q", ErrArchive, cmap.Entry.Module, cmap.Entry.Compartment)
+	}
+
+	// Check in a deterministic order, so that the same error is reported on
+	// every node.
+	for _, name := range sortedKeys(cmap.Compartments) {
+		compartment := cmap.Compartments[name]
+		for _, specifier := range sortedKeys(compartment.Modules) {
+			module := compartment.Modules[specifier]
+			if module.Location == "" {
+				continue
+			}
+			path := compartment.Location + "/" + module.Location
+			content, ok := files[path]
+			if !ok {
+				return fmt.Errorf("%w: module %q of compartment %q missing at %s", ErrArchive, specifier, name, path)
+			}
+			if module.Sha512 != "" && hashHex(content) != module.Sha512 {
+				return fmt.Errorf("%w: module %q of compartment %q", ErrHashMismatch, specifier, name)
+			}
+		}
+	}
+
+	if actual := hashHex(mapBytes); actual != b.EndoZipBase64Sha512 {
+		return fmt.Errorf("%w: computed %s, declared %s", ErrHashMismatch, actual, b.EndoZipBase64Sha512)
+	}
+	return nil
+}
+
+// ValidateJSON parses and validates bundle JSON, returning its bundle ID.
+func ValidateJSON(bundleJSON []byte) (string, error) {
+	b, err := Parse(bundleJSON)
+	if err != nil {
+		return "", err
+	}
+	if err := b.Validate(); err != nil {
+		return "", err
+	}
+	return b.ID(), nil
+}
+
+// CheckID verifies that a bundle's ID is the expected one.
+func (b Bundle) CheckID(expectedID string) error {
+	if !strings.HasPrefix(expectedID, IDPrefix) {
+		return fmt.Errorf("%w: bundle ID %q must start with %q", ErrFormat, expectedID, IDPrefix)
+	}
+	if id := b.ID(); id != expectedID {
+		return fmt.Errorf("%w: bundle ID is %s, expected %s", ErrHashMismatch, id, expectedID)
+	}
+	return nil
+}
+
+// readZip returns the contents of every file in a zip archive, by name.
+func readZip(data []byte) (map[string][]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrArchive, err)
+	}
+	files := make(map[string][]byte, len(reader.File))
+	// Endo archives are stored without compression, so their total content
+	// cannot exceed the size of the archive.
+	remaining := int64(len(data))
+	for _, f := range reader.File {
+		if _, dup := files[f.Name]; dup {
+			return nil, fmt.Errorf("%w: duplicate entry %s", ErrArchive, f.Name)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrArchive, f.Name, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(rc, remaining+1))
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrArchive, f.Name, err)
+		}
+		remaining -= int64(len(content))
+		if remaining < 0 {
+			return nil, fmt.Errorf("%w: content is larger than the archive", ErrArchive)
+		}
+		files[f.Name] = content
+	}
+	return files, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func hashHex(data []byte) string {
+	sum := sha512.Sum512(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,152 @@
+package bundle_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle/bundletest"
+)
+
+// replaceEntry returns a copy of archive with the content of one entry
+// replaced.
+func replaceEntry(t *testing.T, archive []byte, name, content string) []byte {
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range r.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Name == name {
+			data = []byte(content)
+		}
+		out, err := w.CreateHeader(&zip.FileHeader{Name: f.Name, Method: zip.Store})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := out.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestValidate(t *testing.T) {
+	modules := map[string]string{
+		"index.js": `{"source":"export * from './lib.js';"}`,
+		"lib.js":   `{"source":"export const x = 1;"}`,
+	}
+	good := bundletest.NewBundle(bundletest.NewArchive("index.js", modules))
+
+	badModule := bundletest.NewBundle(bundletest.NewArchive("index.js", modules))
+	tampered := replaceEntry(t, bundletest.NewArchive("index.js", modules),
+		bundletest.EntryCompartment+"/lib.js", `{"source":"export const x = 2;"}`)
+	badModule.EndoZipBase64 = base64.StdEncoding.EncodeToString(tampered)
+
+	badHash := good
+	badHash.EndoZipBase64Sha512 = strings.Repeat("0", 128)
+
+	for _, tt := range []struct {
+		name    string
+		bundle  bundle.Bundle
+		wantErr error
+	}{
+		{
+			name:   "good",
+			bundle: good,
+		},
+		{
+			name:    "wrong format",
+			bundle:  bundle.Bundle{ModuleFormat: "getExport", EndoZipBase64: good.EndoZipBase64, EndoZipBase64Sha512: good.EndoZipBase64Sha512},
+			wantErr: bundle.ErrFormat,
+		},
+		{
+			name:    "uppercase hash",
+			bundle:  bundle.Bundle{ModuleFormat: bundle.ModuleFormat, EndoZipBase64: good.EndoZipBase64, EndoZipBase64Sha512: strings.ToUpper(good.EndoZipBase64Sha512)},
+			wantErr: bundle.ErrFormat,
+		},
+		{
+			name:    "bad base64",
+			bundle:  bundle.Bundle{ModuleFormat: bundle.ModuleFormat, EndoZipBase64: "!!", EndoZipBase64Sha512: good.EndoZipBase64Sha512},
+			wantErr: bundle.ErrFormat,
+		},
+		{
+			name:    "not a zip",
+			bundle:  bundle.Bundle{ModuleFormat: bundle.ModuleFormat, EndoZipBase64: base64.StdEncoding.EncodeToString([]byte("nope")), EndoZipBase64Sha512: good.EndoZipBase64Sha512},
+			wantErr: bundle.ErrArchive,
+		},
+		{
+			name:    "missing entry module",
+			bundle:  bundletest.NewBundle(bundletest.NewArchive("main.js", modules)),
+			wantErr: bundle.ErrArchive,
+		},
+		{
+			name:    "tampered module",
+			bundle:  badModule,
+			wantErr: bundle.ErrHashMismatch,
+		},
+		{
+			name:    "wrong declared hash",
+			bundle:  badHash,
+			wantErr: bundle.ErrHashMismatch,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bundle.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	bundleJSON, wantID := bundletest.NewBundleJSON("42")
+	id, err := bundle.ValidateJSON([]byte(bundleJSON))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if id != wantID {
+		t.Errorf("got ID %s, want %s", id, wantID)
+	}
+
+	b, err := bundle.Parse([]byte(bundleJSON))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := b.CheckID(wantID); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	otherJSON, otherID := bundletest.NewBundleJSON("43")
+	if err := b.CheckID(otherID); !errors.Is(err, bundle.ErrHashMismatch) {
+		t.Errorf("got error %v, want %v", err, bundle.ErrHashMismatch)
+	}
+	if otherJSON == bundleJSON {
+		t.Errorf("different bundles have the same JSON")
+	}
+
+	if _, err := bundle.ValidateJSON([]byte("true")); !errors.Is(err, bundle.ErrFormat) {
+		t.Errorf("got error %v, want %v", err, bundle.ErrFormat)
+	}
+}
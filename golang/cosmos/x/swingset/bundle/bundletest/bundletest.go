@@ -0,0 +1,122 @@
+// Package bundletest builds small endoZipBase64 bundles for tests.
+package bundletest
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle"
+)
+
+// EntryCompartment is the location of the single compartment of the bundles.
+const EntryCompartment = "test-v1.0.0"
+
+func hashHex(data []byte) string {
+	sum := sha512.Sum512(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// NewArchive returns a zip archive with a single compartment holding modules,
+// keyed by location, whose entry module is entry.
+func NewArchive(entry string, modules map[string]string) []byte {
+	moduleMap := map[string]interface{}{}
+	for location, source := range modules {
+		moduleMap["./"+location] = map[string]string{
+			"location": location,
+			"parser":   "pre-mjs-json",
+			"sha512":   hashHex([]byte(source)),
+		}
+	}
+	compartmentMap, err := json.Marshal(map[string]interface{}{
+		"tags": []string{},
+		"entry": map[string]string{
+			"compartment": EntryCompartment,
+			"module":      "./" + entry,
+		},
+		"compartments": map[string]interface{}{
+			EntryCompartment: map[string]interface{}{
+				"name":     "test",
+				"label":    "test-v1.0.0",
+				"location": EntryCompartment,
+				"modules":  moduleMap,
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	locations := make([]string, 0, len(modules))
+	for location := range modules {
+		locations = append(locations, location)
+	}
+	sort.Strings(locations)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	write := func(name string, content []byte) {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+		if err != nil {
+			panic(err)
+		}
+		if _, err := f.Write(content); err != nil {
+			panic(err)
+		}
+	}
+	write(bundle.CompartmentMapName, compartmentMap)
+	for _, location := range locations {
+		write(EntryCompartment+"/"+location, []byte(modules[location]))
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// NewBundle returns an endoZipBase64 bundle of archive, declaring the hash of
+// its compartment map.
+func NewBundle(archive []byte) bundle.Bundle {
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		panic(err)
+	}
+	hash := ""
+	for _, f := range r.File {
+		if f.Name != bundle.CompartmentMapName {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			panic(err)
+		}
+		var content bytes.Buffer
+		if _, err := content.ReadFrom(rc); err != nil {
+			panic(err)
+		}
+		rc.Close()
+		hash = hashHex(content.Bytes())
+	}
+	return bundle.Bundle{
+		ModuleFormat:        bundle.ModuleFormat,
+		EndoZipBase64:       base64.StdEncoding.EncodeToString(archive),
+		EndoZipBase64Sha512: hash,
+	}
+}
+
+// NewBundleJSON returns the JSON of a valid bundle whose entry module exports
+// value, along with its bundle ID.
+func NewBundleJSON(value string) (string, string) {
+	b := NewBundle(NewArchive("index.js", map[string]string{
+		"index.js": `{"exports":["default"],"imports":[],"source":"export default ` + value + `;"}`,
+	}))
+	bz, err := json.Marshal(b)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz), b.ID()
+}
@@ -20,6 +20,14 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 	swingsetQueryCmd.AddCommand(
 		GetCmdGetEgress(storeKey),
 		GetCmdQueryParams(storeKey),
+		GetCmdQueryState(storeKey),
+		GetCmdQueryHighPrioritySenders(storeKey),
+		GetCmdQueryBundleUpload(storeKey),
+		GetCmdQueryBundles(storeKey),
+		GetCmdQueryBundle(storeKey),
+		GetCmdQueryCoreEvals(storeKey),
+		GetCmdQueryCoreEval(storeKey),
+		GetCmdQuerySwingStoreExportStatus(storeKey),
 		GetCmdMailbox(storeKey),
 	)
 
@@ -51,6 +59,212 @@ func GetCmdQueryParams(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+func GetCmdQueryState(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Args:  cobra.NoArgs,
+		Short: "Query swingset state, including the current fee multiplier",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.State(cmd.Context(), &types.QueryStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.State)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryHighPrioritySenders(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "high-priority-senders",
+		Args:  cobra.NoArgs,
+		Short: "List the governance-registered high priority senders",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HighPrioritySenders(cmd.Context(), &types.QueryHighPrioritySendersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "high-priority-senders")
+	return cmd
+}
+
+func GetCmdQueryBundleUpload(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle-upload <submitter> <payload-hash>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the progress of a chunked bundle upload",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BundleUpload(cmd.Context(), &types.QueryBundleUploadRequest{
+				Submitter:   args[0],
+				PayloadHash: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryBundles(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundles",
+		Args:  cobra.NoArgs,
+		Short: "List the bundles submitted for installation",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Bundles(cmd.Context(), &types.QueryBundlesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bundles")
+	return cmd
+}
+
+func GetCmdQueryBundle(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle <bundle-id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a bundle submitted for installation",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Bundle(cmd.Context(), &types.QueryBundleRequest{
+				BundleId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Bundle)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCoreEvals(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "core-evals",
+		Args:  cobra.NoArgs,
+		Short: "Query the core-evals passed by governance",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CoreEvals(cmd.Context(), &types.QueryCoreEvalsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "core-evals")
+	return cmd
+}
+
+func GetCmdQueryCoreEval(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "core-eval <content-hash>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a core-eval passed by governance by its content hash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CoreEval(cmd.Context(), &types.QueryCoreEvalRequest{
+				ContentHash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.CoreEval)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdGetEgress(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "egress <account>",
@@ -115,3 +329,30 @@ func GetCmdMailbox(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQuerySwingStoreExportStatus queries the swing-store export or restore
+// in progress on the node.
+func GetCmdQuerySwingStoreExportStatus(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-status",
+		Args:  cobra.NoArgs,
+		Short: "Query the swing-store export or restore in progress on the node",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			nodeClient := types.NewNodeClient(clientCtx)
+
+			res, err := nodeClient.SwingStoreExportStatus(cmd.Context(), &types.SwingStoreExportStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
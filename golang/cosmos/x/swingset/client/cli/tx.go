@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
 	"io"
 	"os"
@@ -11,19 +12,29 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	FlagAllowSpend = "allow-spend"
-	FlagCompress   = "compress"
+	FlagAllowSpend     = "allow-spend"
+	FlagCompress       = "compress"
+	FlagBundleID       = "bundle-id"
+	FlagSkipValidation = "skip-validation"
+	FlagChunkSize      = "chunk-size"
 )
 
+// DefaultBundleUploadChunkSize is the default size of the chunks of a bundle
+// sent by upload-bundle, which keeps each transaction well under the default
+// consensus limit on transaction size.
+const DefaultBundleUploadChunkSize = 256 * 1024
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	swingsetTxCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -37,6 +48,7 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		GetCmdDeliver(),
 		GetCmdProvisionOne(),
 		GetCmdInstallBundle(),
+		GetCmdUploadBundle(),
 		GetCmdWalletAction(),
 	)
 
@@ -104,7 +116,8 @@ func GetCmdInstallBundle() *cobra.Command {
 The argument indicates how to read input JSON ("@-" for standard input,
 "@..." for a file path, and otherwise directly as in
 "install-bundle '{...}'").
-Input should be endoZipBase64 JSON, but this is not verified.
+Input must be endoZipBase64 JSON, whose archive and hash are verified
+before broadcast unless --skip-validation is given.
 https://github.com/endojs/endo/tree/master/packages/bundle-source`,
 		Args: cobra.ExactArgs(1),
 
@@ -114,19 +127,9 @@ https://github.com/endojs/endo/tree/master/packages/bundle-source`,
 				return err
 			}
 
-			jsonIn := args[0]
-			if strings.HasPrefix(jsonIn, "@") {
-				var jsonBytes []byte
-				fname := jsonIn[1:]
-				if fname == "-" {
-					jsonBytes, err = io.ReadAll(os.Stdin)
-				} else {
-					jsonBytes, err = os.ReadFile(fname)
-				}
-				if err != nil {
-					return err
-				}
-				jsonIn = string(jsonBytes)
+			jsonIn, err := readBundleArg(cmd, args[0])
+			if err != nil {
+				return err
 			}
 
 			msg := types.NewMsgInstallBundle(jsonIn, cctx.GetFromAddress())
@@ -154,10 +157,204 @@ https://github.com/endojs/endo/tree/master/packages/bundle-source`,
 		},
 	}
 	cmd.Flags().Bool(FlagCompress, true, "Compress the bundle in transit")
+	cmd.Flags().Bool(FlagSkipValidation, false, "Do not verify the bundle before broadcast")
+	cmd.Flags().String(FlagBundleID, "", "The expected bundle ID (b1-...), verified unless --skip-validation is given")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readBundleArg reads the bundle JSON indicated by a command argument, and
+// verifies it unless the command's --skip-validation flag is given.
+func readBundleArg(cmd *cobra.Command, arg string) (string, error) {
+	jsonIn := arg
+	if strings.HasPrefix(jsonIn, "@") {
+		var jsonBytes []byte
+		var err error
+		fname := jsonIn[1:]
+		if fname == "-" {
+			jsonBytes, err = io.ReadAll(os.Stdin)
+		} else {
+			jsonBytes, err = os.ReadFile(fname)
+		}
+		if err != nil {
+			return "", err
+		}
+		jsonIn = string(jsonBytes)
+	}
+
+	skipValidation, err := cmd.Flags().GetBool(FlagSkipValidation)
+	if err != nil {
+		return "", err
+	}
+	if skipValidation {
+		return jsonIn, nil
+	}
+	b, err := bundle.Parse([]byte(jsonIn))
+	if err != nil {
+		return "", err
+	}
+	if err := b.Validate(); err != nil {
+		return "", err
+	}
+	expectedID, err := cmd.Flags().GetString(FlagBundleID)
+	if err != nil {
+		return "", err
+	}
+	if expectedID != "" {
+		if err := b.CheckID(expectedID); err != nil {
+			return "", err
+		}
+	}
+	return jsonIn, nil
+}
+
+// GetCmdUploadBundle is the CLI command for installing a bundle too large for
+// a single transaction, by sending it in chunks over several transactions.
+func GetCmdUploadBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-bundle {<bundle JSON> | @- | @<file>}",
+		Short: "install a bundle by uploading it in chunks",
+		Long: `install a bundle by uploading it in chunks.
+The argument indicates how to read input JSON, as for install-bundle.
+The bundle is split into chunks of at most --chunk-size bytes, each sent
+in its own transaction, the first of which also begins the upload.  The
+bundle is installed when the transaction with its last chunk is executed.
+The transactions are signed with consecutive account sequence numbers and
+broadcast one after another, stopping at the first that fails.
+
+With --gas=auto, each transaction is simulated against the committed
+state, so all but the first need the previous transaction to be committed:
+use --broadcast-mode=block.  With --generate-only, the unsigned
+transactions are printed one per line.`,
+		Args: cobra.ExactArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			jsonIn, err := readBundleArg(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			payload := []byte(jsonIn)
+			uncompressedSize := int64(0)
+			compress, err := cmd.Flags().GetBool(FlagCompress)
+			if err != nil {
+				return err
+			}
+			if compress {
+				msg := types.NewMsgInstallBundle(jsonIn, cctx.GetFromAddress())
+				if err := msg.Compress(); err != nil {
+					return err
+				}
+				payload = msg.CompressedBundle
+				uncompressedSize = msg.UncompressedSize
+			}
+
+			chunkSize, err := cmd.Flags().GetInt(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+			begin, chunks, err := types.NewBundleUploadMsgs(payload, uncompressedSize, chunkSize, cctx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			// The first transaction begins the upload along with its first chunk.
+			txMsgs := [][]sdk.Msg{{begin}}
+			for i, chunk := range chunks {
+				if i == 0 {
+					txMsgs[0] = append(txMsgs[0], chunk)
+				} else {
+					txMsgs = append(txMsgs, []sdk.Msg{chunk})
+				}
+			}
+			for _, msgs := range txMsgs {
+				for _, msg := range msgs {
+					if err := msg.ValidateBasic(); err != nil {
+						return err
+					}
+				}
+			}
+
+			txf := tx.NewFactoryCLI(cctx, cmd.Flags())
+			if cctx.GenerateOnly {
+				for i, msgs := range txMsgs {
+					if err := txf.WithSequence(txf.Sequence()+uint64(i)).PrintUnsignedTx(cctx, msgs...); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			if txf.SimulateAndExecute() && len(txMsgs) > 1 && cctx.BroadcastMode != flags.BroadcastBlock {
+				return fmt.Errorf("--gas=auto requires --broadcast-mode=%s to simulate the %d transactions of the upload", flags.BroadcastBlock, len(txMsgs))
+			}
+			txf, err = txf.Prepare(cctx)
+			if err != nil {
+				return err
+			}
+
+			if !cctx.SkipConfirm {
+				prompt := fmt.Sprintf("upload %d bytes in %d transactions", len(payload), len(txMsgs))
+				ok, err := input.GetConfirmation(prompt, bufio.NewReader(os.Stdin), os.Stderr)
+				if err != nil || !ok {
+					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled upload")
+					return err
+				}
+			}
+
+			firstSequence := txf.Sequence()
+			for i, msgs := range txMsgs {
+				res, err := broadcastUploadTx(cctx, txf.WithSequence(firstSequence+uint64(i)), msgs)
+				if err != nil {
+					return errors.Wrapf(err, "transaction %d of %d", i+1, len(txMsgs))
+				}
+				if err := cctx.PrintProto(res); err != nil {
+					return err
+				}
+				if res.Code != 0 {
+					return fmt.Errorf("transaction %d of %d failed with code %d: %s", i+1, len(txMsgs), res.Code, res.RawLog)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool(FlagCompress, true, "Compress the bundle in transit")
+	cmd.Flags().Bool(FlagSkipValidation, false, "Do not verify the bundle before broadcast")
+	cmd.Flags().String(FlagBundleID, "", "The expected bundle ID (b1-...), verified unless --skip-validation is given")
+	cmd.Flags().Int(FlagChunkSize, DefaultBundleUploadChunkSize, "The maximum number of bytes of the bundle in each transaction")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// broadcastUploadTx signs and broadcasts a transaction of a bundle upload,
+// estimating its gas if needed.
+func broadcastUploadTx(cctx client.Context, txf tx.Factory, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(cctx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txb, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, cctx.GetFromName(), txb, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := cctx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return cctx.BroadcastTx(txBytes)
+}
+
 // GetCmdProvision is the CLI command for sending a Provision transaction
 func GetCmdProvisionOne() *cobra.Command {
 	cmd := &cobra.Command{
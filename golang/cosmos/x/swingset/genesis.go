@@ -3,6 +3,7 @@ package swingset
 import (
 	// "os"
 	"fmt"
+	"strings"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
@@ -17,6 +18,29 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, sender := range data.HighPrioritySenders {
+		if _, err := sdk.AccAddressFromBech32(sender.Address); err != nil {
+			return fmt.Errorf("invalid high priority sender address %q: %w", sender.Address, err)
+		}
+		if err := types.ValidateHighPrioritySenderNamespace(sender.Namespace); err != nil {
+			return err
+		}
+	}
+	for _, bundle := range data.SubmittedBundles {
+		if !strings.HasPrefix(bundle.BundleID, types.BundleIDPrefix) {
+			return fmt.Errorf("invalid submitted bundle ID %q", bundle.BundleID)
+		}
+		switch bundle.Status {
+		case types.SubmittedBundleStatusPending, types.SubmittedBundleStatusInstalled:
+		default:
+			return fmt.Errorf("invalid status %q of submitted bundle %s", bundle.Status, bundle.BundleID)
+		}
+	}
+	for _, record := range data.CoreEvals {
+		if err := record.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -33,6 +57,15 @@ func DefaultGenesisState() *types.GenesisState {
 func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, data *types.GenesisState) bool {
 	k.SetParams(ctx, data.GetParams())
 	k.SetState(ctx, data.GetState())
+	for _, sender := range data.GetHighPrioritySenders() {
+		k.SetHighPrioritySender(ctx, sender)
+	}
+	for _, bundle := range data.GetSubmittedBundles() {
+		k.SetSubmittedBundle(ctx, bundle)
+	}
+	for _, record := range data.GetCoreEvals() {
+		k.SetCoreEvalRecord(ctx, record)
+	}
 
 	swingStoreExportData := data.GetSwingStoreExportData()
 	if len(swingStoreExportData) == 0 {
@@ -58,6 +91,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 	}
 
 	err = swingStoreExportsHandler.RestoreExport(
+		ctx.Context(),
 		keeper.SwingStoreExportProvider{
 			BlockHeight:         snapshotHeight,
 			GetExportDataReader: getExportDataReader,
@@ -80,6 +114,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingSto
 		Params:               k.GetParams(ctx),
 		State:                k.GetState(ctx),
 		SwingStoreExportData: []*types.SwingStoreExportDataEntry{},
+		HighPrioritySenders:  k.GetAllHighPrioritySenders(ctx),
+		SubmittedBundles:     k.GetAllSubmittedBundles(ctx),
+		CoreEvals:            k.GetAllCoreEvalRecords(ctx),
 	}
 
 	exportDataIterator := k.GetSwingStore(ctx).Iterator(nil, nil)
@@ -95,6 +132,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingSto
 	snapshotHeight := uint64(ctx.BlockHeight())
 
 	err := swingStoreExportsHandler.InitiateExport(
+		ctx.Context(),
 		// The export will fail if the export of a historical height was requested
 		snapshotHeight,
 		swingStoreGenesisEventHandler{exportDir: swingStoreExportDir, snapshotHeight: snapshotHeight},
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+
+	sdkioerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// A chunked bundle upload is identified by its submitter and payload hash.
+// The upload record, each received chunk, and an entry in an index ordered by
+// expiry height are kept in the module store until the upload either
+// completes or expires.
+const (
+	bundleUploadKeyPrefix       = "bundleUpload."
+	bundleUploadChunkKeyPrefix  = "bundleUploadChunk."
+	bundleUploadExpiryKeyPrefix = "bundleUploadExpiry."
+)
+
+func bundleUploadKey(submitter sdk.AccAddress, payloadHash string) []byte {
+	return append(address.MustLengthPrefix(submitter), []byte(payloadHash)...)
+}
+
+func bundleUploadChunkKey(uploadKey []byte, index uint32) []byte {
+	key := make([]byte, len(uploadKey), len(uploadKey)+4)
+	copy(key, uploadKey)
+	return binary.BigEndian.AppendUint32(key, index)
+}
+
+func bundleUploadExpiryKey(expiryHeight int64, uploadKey []byte) []byte {
+	key := make([]byte, 8, 8+len(uploadKey))
+	binary.BigEndian.PutUint64(key, uint64(expiryHeight))
+	return append(key, uploadKey...)
+}
+
+func (k Keeper) bundleUploadStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(bundleUploadKeyPrefix))
+}
+
+func (k Keeper) bundleUploadChunkStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(bundleUploadChunkKeyPrefix))
+}
+
+func (k Keeper) bundleUploadExpiryStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(bundleUploadExpiryKeyPrefix))
+}
+
+// GetBundleUpload returns the record of an upload in progress, if any.
+func (k Keeper) GetBundleUpload(ctx sdk.Context, submitter sdk.AccAddress, payloadHash string) (types.BundleUpload, bool) {
+	bz := k.bundleUploadStore(ctx).Get(bundleUploadKey(submitter, payloadHash))
+	if bz == nil {
+		return types.BundleUpload{}, false
+	}
+	upload := types.BundleUpload{}
+	k.cdc.MustUnmarshal(bz, &upload)
+	return upload, true
+}
+
+func (k Keeper) setBundleUpload(ctx sdk.Context, uploadKey []byte, upload types.BundleUpload) {
+	k.bundleUploadStore(ctx).Set(uploadKey, k.cdc.MustMarshal(&upload))
+}
+
+// deleteBundleUpload removes the record, chunks and expiry entry of an upload.
+func (k Keeper) deleteBundleUpload(ctx sdk.Context, uploadKey []byte, upload types.BundleUpload) {
+	chunkStore := k.bundleUploadChunkStore(ctx)
+	for i := uint32(0); i < upload.ChunkCount; i++ {
+		chunkStore.Delete(bundleUploadChunkKey(uploadKey, i))
+	}
+	k.bundleUploadExpiryStore(ctx).Delete(bundleUploadExpiryKey(upload.ExpiryHeight, uploadKey))
+	k.bundleUploadStore(ctx).Delete(uploadKey)
+}
+
+// countOpenBundleUploads returns the number of uploads in progress by
+// submitter, counting no further than types.MaxOpenBundleUploads.
+func (k Keeper) countOpenBundleUploads(ctx sdk.Context, submitter sdk.AccAddress) int {
+	store := prefix.NewStore(k.bundleUploadStore(ctx), address.MustLengthPrefix(submitter))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	count := 0
+	for ; iterator.Valid() && count < types.MaxOpenBundleUploads; iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// BeginBundleUpload records a new chunked bundle upload.  It fails if chunked
+// uploads are disabled, if the submitter already has an upload in progress
+// with the same payload hash, or if the submitter already has
+// types.MaxOpenBundleUploads uploads in progress.
+func (k Keeper) BeginBundleUpload(ctx sdk.Context, msg *types.MsgBeginBundleUpload) error {
+	expiryBlocks := k.GetParams(ctx).BundleUploadExpiryBlocks
+	if expiryBlocks == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "chunked bundle uploads are disabled")
+	}
+
+	uploadKey := bundleUploadKey(msg.Submitter, msg.PayloadHash)
+	if k.bundleUploadStore(ctx).Has(uploadKey) {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bundle upload %s is already in progress", msg.PayloadHash)
+	}
+	if k.countOpenBundleUploads(ctx, msg.Submitter) >= types.MaxOpenBundleUploads {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "submitter already has %d bundle uploads in progress", types.MaxOpenBundleUploads)
+	}
+
+	upload := types.BundleUpload{
+		Submitter:        msg.Submitter.String(),
+		PayloadHash:      msg.PayloadHash,
+		TotalSize:        msg.TotalSize,
+		ChunkCount:       msg.ChunkCount,
+		UncompressedSize: msg.UncompressedSize,
+		StartHeight:      ctx.BlockHeight(),
+		ExpiryHeight:     ctx.BlockHeight() + int64(expiryBlocks),
+	}
+	k.setBundleUpload(ctx, uploadKey, upload)
+	k.bundleUploadExpiryStore(ctx).Set(bundleUploadExpiryKey(upload.ExpiryHeight, uploadKey), []byte{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBundleUploadBegun,
+			sdk.NewAttribute(types.AttributeKeySubmitter, upload.Submitter),
+			sdk.NewAttribute(types.AttributeKeyPayloadHash, upload.PayloadHash),
+		),
+	)
+	return nil
+}
+
+// AddBundleUploadChunk stores a chunk of an upload in progress, replacing any
+// earlier chunk with the same index.  Once all chunks have arrived, the payload
+// is checked against the declared size and hash, the upload is removed, and
+// the resulting bundle installation message is returned.  Otherwise the
+// returned message is nil.
+func (k Keeper) AddBundleUploadChunk(ctx sdk.Context, msg *types.MsgUploadBundleChunk) (*types.MsgInstallBundle, error) {
+	uploadKey := bundleUploadKey(msg.Submitter, msg.PayloadHash)
+	upload, found := k.GetBundleUpload(ctx, msg.Submitter, msg.PayloadHash)
+	if !found {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrNotFound, "no bundle upload %s in progress", msg.PayloadHash)
+	}
+	if msg.Index >= upload.ChunkCount {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chunk index %d out of range for %d chunks", msg.Index, upload.ChunkCount)
+	}
+
+	chunkStore := k.bundleUploadChunkStore(ctx)
+	chunkKey := bundleUploadChunkKey(uploadKey, msg.Index)
+	previous := chunkStore.Get(chunkKey)
+	receivedSize := upload.ReceivedSize - int64(len(previous)) + int64(len(msg.Data))
+	if receivedSize > upload.TotalSize {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chunks exceed the declared total size of %d bytes", upload.TotalSize)
+	}
+	chunkStore.Set(chunkKey, msg.Data)
+	if previous == nil {
+		upload.ReceivedChunks++
+	}
+	upload.ReceivedSize = receivedSize
+
+	if upload.ReceivedChunks < upload.ChunkCount {
+		k.setBundleUpload(ctx, uploadKey, upload)
+		return nil, nil
+	}
+
+	if upload.ReceivedSize != upload.TotalSize {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "received %d bytes, expected %d", upload.ReceivedSize, upload.TotalSize)
+	}
+	var payload bytes.Buffer
+	payload.Grow(int(upload.TotalSize))
+	for i := uint32(0); i < upload.ChunkCount; i++ {
+		payload.Write(chunkStore.Get(bundleUploadChunkKey(uploadKey, i)))
+	}
+	if hash := types.BundleUploadPayloadHash(payload.Bytes()); hash != upload.PayloadHash {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "payload hash %s does not match declared %s", hash, upload.PayloadHash)
+	}
+
+	installMsg := &types.MsgInstallBundle{
+		Submitter: msg.Submitter,
+	}
+	if upload.UncompressedSize > 0 {
+		installMsg.CompressedBundle = payload.Bytes()
+		installMsg.UncompressedSize = upload.UncompressedSize
+	} else {
+		installMsg.Bundle = payload.String()
+	}
+	if err := installMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	k.deleteBundleUpload(ctx, uploadKey, upload)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBundleUploadCompleted,
+			sdk.NewAttribute(types.AttributeKeySubmitter, upload.Submitter),
+			sdk.NewAttribute(types.AttributeKeyPayloadHash, upload.PayloadHash),
+		),
+	)
+	return installMsg, nil
+}
+
+// GetMissingBundleUploadChunks returns the indices of the chunks of an upload
+// that have not yet been received, in increasing order.
+func (k Keeper) GetMissingBundleUploadChunks(ctx sdk.Context, upload types.BundleUpload) []uint32 {
+	uploadKey := bundleUploadKey(sdk.MustAccAddressFromBech32(upload.Submitter), upload.PayloadHash)
+	chunkStore := k.bundleUploadChunkStore(ctx)
+	missing := []uint32{}
+	for i := uint32(0); i < upload.ChunkCount; i++ {
+		if !chunkStore.Has(bundleUploadChunkKey(uploadKey, i)) {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+// GetRemainingBundleUploadChunks returns the number of chunks of an upload in
+// progress that would still be missing once the chunk at index is received.
+func (k Keeper) GetRemainingBundleUploadChunks(ctx sdk.Context, submitter sdk.AccAddress, payloadHash string, index uint32) (uint32, bool) {
+	upload, found := k.GetBundleUpload(ctx, submitter, payloadHash)
+	if !found {
+		return 0, false
+	}
+	remaining := upload.ChunkCount - upload.ReceivedChunks
+	uploadKey := bundleUploadKey(submitter, payloadHash)
+	if index < upload.ChunkCount && !k.bundleUploadChunkStore(ctx).Has(bundleUploadChunkKey(uploadKey, index)) {
+		remaining--
+	}
+	return remaining, true
+}
+
+// PurgeExpiredBundleUploads discards every upload whose expiry height has been
+// reached.
+func (k Keeper) PurgeExpiredBundleUploads(ctx sdk.Context) {
+	expiryStore := k.bundleUploadExpiryStore(ctx)
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, uint64(ctx.BlockHeight()+1))
+
+	// Collect the keys first, since the store must not be modified while
+	// iterating over it.
+	uploadKeys := [][]byte{}
+	iterator := expiryStore.Iterator(nil, end)
+	for ; iterator.Valid(); iterator.Next() {
+		uploadKeys = append(uploadKeys, append([]byte{}, iterator.Key()[8:]...))
+	}
+	iterator.Close()
+
+	for _, uploadKey := range uploadKeys {
+		bz := k.bundleUploadStore(ctx).Get(uploadKey)
+		if bz == nil {
+			continue
+		}
+		upload := types.BundleUpload{}
+		k.cdc.MustUnmarshal(bz, &upload)
+		k.deleteBundleUpload(ctx, uploadKey, upload)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBundleUploadExpired,
+				sdk.NewAttribute(types.AttributeKeySubmitter, upload.Submitter),
+				sdk.NewAttribute(types.AttributeKeyPayloadHash, upload.PayloadHash),
+				sdk.NewAttribute(types.AttributeKeyReceivedChunks, strconv.FormatUint(uint64(upload.ReceivedChunks), 10)),
+			),
+		)
+	}
+}
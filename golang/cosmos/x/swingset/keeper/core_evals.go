@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// Core-evals passed by governance are recorded by content hash, along with
+// the outcome reported by the controller, as an audit trail of the code that
+// ran in the core.
+const coreEvalKeyPrefix = "coreEval."
+
+func (k Keeper) coreEvalStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(coreEvalKeyPrefix))
+}
+
+// GetCoreEvalRecord returns the record of a core-eval, if any.
+func (k Keeper) GetCoreEvalRecord(ctx sdk.Context, contentHash string) (types.CoreEvalRecord, bool) {
+	bz := k.coreEvalStore(ctx).Get([]byte(contentHash))
+	if bz == nil {
+		return types.CoreEvalRecord{}, false
+	}
+	record := types.CoreEvalRecord{}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetCoreEvalRecord stores the record of a core-eval.
+func (k Keeper) SetCoreEvalRecord(ctx sdk.Context, record types.CoreEvalRecord) {
+	k.coreEvalStore(ctx).Set([]byte(record.ContentHash), k.cdc.MustMarshal(&record))
+}
+
+// GetAllCoreEvalRecords returns every core-eval record, ordered by content
+// hash.
+func (k Keeper) GetAllCoreEvalRecords(ctx sdk.Context) []types.CoreEvalRecord {
+	records := []types.CoreEvalRecord{}
+	iterator := k.coreEvalStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.CoreEvalRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// PaginateCoreEvalRecords returns a page of core-eval records.
+func (k Keeper) PaginateCoreEvalRecords(ctx sdk.Context, pageReq *query.PageRequest) ([]types.CoreEvalRecord, *query.PageResponse, error) {
+	records := []types.CoreEvalRecord{}
+	pageRes, err := query.Paginate(k.coreEvalStore(ctx), pageReq, func(key []byte, value []byte) error {
+		record := types.CoreEvalRecord{}
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	return records, pageRes, err
+}
+
+// recordCoreEval records a core-eval as pending evaluation.  A core-eval that
+// passes again replaces the record of its earlier evaluation.
+func (k Keeper) recordCoreEval(ctx sdk.Context, eval types.CoreEval) types.CoreEvalRecord {
+	record := types.CoreEvalRecord{
+		ContentHash: eval.ContentHash(),
+		PermitHash:  eval.PermitHash(),
+		CodeHash:    eval.CodeHash(),
+		Height:      ctx.BlockHeight(),
+		Status:      types.CoreEvalStatusPending,
+	}
+	k.SetCoreEvalRecord(ctx, record)
+	return record
+}
+
+// RecordCoreEvalOutcome records the outcome of a pending core-eval, as
+// reported by the controller.  An empty errorMessage means that the
+// evaluation succeeded.
+func (k Keeper) RecordCoreEvalOutcome(ctx sdk.Context, contentHash, errorMessage string) error {
+	record, found := k.GetCoreEvalRecord(ctx, contentHash)
+	if !found {
+		return fmt.Errorf("unknown core-eval %s", contentHash)
+	}
+	if record.Status != types.CoreEvalStatusPending {
+		return fmt.Errorf("core-eval %s is not pending", contentHash)
+	}
+
+	if errorMessage == "" {
+		record.Status = types.CoreEvalStatusSucceeded
+	} else {
+		record.Status = types.CoreEvalStatusFailed
+		record.Error = errorMessage
+	}
+	record.OutcomeHeight = ctx.BlockHeight()
+	k.SetCoreEvalRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCoreEvalOutcome,
+			sdk.NewAttribute(types.AttributeKeyContentHash, record.ContentHash),
+			sdk.NewAttribute(types.AttributeKeyStatus, record.Status),
+		),
+	)
+	return nil
+}
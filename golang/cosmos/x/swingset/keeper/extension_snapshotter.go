@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"time"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
@@ -25,6 +27,11 @@ var _ SwingStoreExportEventHandler = &ExtensionSnapshotter{}
 // SnapshotFormat 1 defines all extension payloads to be SwingStoreArtifact proto messages
 const SnapshotFormat = 1
 
+// DefaultSnapshotExportTimeout is the default time after which a state-sync
+// snapshot's swing-store export is aborted, so that a stuck export does not
+// prevent future snapshots.
+const DefaultSnapshotExportTimeout = 2 * time.Hour
+
 // snapshotDetails describes an in-progress state-sync snapshot
 type snapshotDetails struct {
 	// blockHeight is the block height of this in-progress snapshot.
@@ -69,6 +76,11 @@ type ExtensionSnapshotter struct {
 	getSwingStoreExportDataShadowCopyReader func(height int64) agoric.KVEntryReader
 	logger                                  log.Logger
 	activeSnapshot                          *snapshotDetails
+	// exportTimeout bounds the swing-store export of a snapshot. No timeout
+	// applies if 0.
+	exportTimeout time.Duration
+	// cancelExport releases the context of the last initiated swing-store export.
+	cancelExport context.CancelFunc
 }
 
 // NewExtensionSnapshotter creates a new swingset ExtensionSnapshotter
@@ -84,9 +96,16 @@ func NewExtensionSnapshotter(
 		swingStoreExportsHandler:                swingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader: getSwingStoreExportDataShadowCopyReader,
 		activeSnapshot:                          nil,
+		exportTimeout:                           DefaultSnapshotExportTimeout,
 	}
 }
 
+// SetExportTimeout sets the time after which the swing-store export of a
+// snapshot is aborted. No timeout applies if 0.
+func (snapshotter *ExtensionSnapshotter) SetExportTimeout(timeout time.Duration) {
+	snapshotter.exportTimeout = timeout
+}
+
 // SnapshotName returns the name of the snapshotter, it should be unique in the manager.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) SnapshotName() string {
@@ -112,7 +131,8 @@ func (snapshotter *ExtensionSnapshotter) SupportedFormats() []uint32 {
 // If a snapshot is already in progress, or if no snapshot manager is
 // configured, this will fail.
 //
-// The snapshot operation is performed in a goroutine.
+// The snapshot operation is performed in a goroutine, and its swing-store
+// export is aborted if it takes longer than the snapshotter's export timeout.
 // Use WaitUntilSwingStoreExportStarted to synchronize commit boundaries.
 func (snapshotter *ExtensionSnapshotter) InitiateSnapshot(height int64) error {
 	if !snapshotter.isConfigured() {
@@ -124,10 +144,29 @@ func (snapshotter *ExtensionSnapshotter) InitiateSnapshot(height int64) error {
 
 	blockHeight := uint64(height)
 
-	return snapshotter.swingStoreExportsHandler.InitiateExport(blockHeight, snapshotter, SwingStoreExportOptions{
+	ctx := context.Background()
+	cancel := func() {}
+	if snapshotter.exportTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, snapshotter.exportTimeout)
+	}
+
+	err := snapshotter.swingStoreExportsHandler.InitiateExport(ctx, blockHeight, snapshotter, SwingStoreExportOptions{
 		ArtifactMode:   SwingStoreArtifactModeReplay,
 		ExportDataMode: SwingStoreExportDataModeSkip,
 	})
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	// The export was initiated, so any previous one is done and no longer
+	// watching its context.
+	if snapshotter.cancelExport != nil {
+		snapshotter.cancelExport()
+	}
+	snapshotter.cancelExport = cancel
+
+	return nil
 }
 
 // OnExportStarted performs the actual cosmos state-sync app snapshot.
@@ -303,6 +342,7 @@ func (snapshotter *ExtensionSnapshotter) RestoreExtension(blockHeight uint64, fo
 	}
 
 	return snapshotter.swingStoreExportsHandler.RestoreExport(
+		context.Background(),
 		SwingStoreExportProvider{BlockHeight: blockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact},
 		SwingStoreRestoreOptions{ArtifactMode: SwingStoreArtifactModeReplay, ExportDataMode: SwingStoreExportDataModeAll},
 	)
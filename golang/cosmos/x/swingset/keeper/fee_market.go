@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// queueOccupancy returns the fraction of the inbound queue in use, in [0, 1].
+func queueOccupancy(size, max int32) sdk.Dec {
+	if max <= 0 || size >= max {
+		return sdk.OneDec()
+	}
+	if size <= 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(size)).QuoInt64(int64(max))
+}
+
+// currentFeeMultiplier returns the multiplier recorded in the state, treating
+// an absent value as 1.
+func currentFeeMultiplier(state types.State) sdk.Dec {
+	if state.FeeMultiplier.IsNil() || !state.FeeMultiplier.IsPositive() {
+		return sdk.OneDec()
+	}
+	return state.FeeMultiplier
+}
+
+// nextFeeMultiplier computes the fee multiplier for the next block from the
+// previous multiplier and the recent queue occupancies, as described on the
+// FeeMarket message.
+func nextFeeMultiplier(fm types.FeeMarket, prev sdk.Dec, occupancies []sdk.Dec) sdk.Dec {
+	next := prev
+	if len(occupancies) > 0 {
+		sum := sdk.ZeroDec()
+		for _, o := range occupancies {
+			sum = sum.Add(o)
+		}
+		average := sum.QuoInt64(int64(len(occupancies)))
+		pressure := average.Sub(fm.TargetOccupancy).Quo(fm.TargetOccupancy)
+		if pressure.GT(sdk.OneDec()) {
+			pressure = sdk.OneDec()
+		}
+		next = prev.Mul(sdk.OneDec().Add(fm.AdjustmentRate.Mul(pressure)))
+	}
+
+	if next.LT(fm.MinMultiplier) {
+		return fm.MinMultiplier
+	}
+	if next.GT(fm.MaxMultiplier) {
+		return fm.MaxMultiplier
+	}
+	return next
+}
+
+// updateFeeMarket records the current inbound queue occupancy in the state and
+// recomputes its fee multiplier.  If the fee market is disabled, the
+// multiplier is reset to 1 and the occupancy history is discarded.
+func updateFeeMarket(state *types.State, fm types.FeeMarket, inboundQueueSize, inboundQueueMax int32) {
+	if !fm.Enabled {
+		state.FeeMultiplier = sdk.OneDec()
+		state.InboundQueueOccupancy = nil
+		return
+	}
+
+	occupancies := append(state.InboundQueueOccupancy, queueOccupancy(inboundQueueSize, inboundQueueMax))
+	if excess := len(occupancies) - int(fm.WindowBlocks); excess > 0 {
+		occupancies = occupancies[excess:]
+	}
+	state.InboundQueueOccupancy = occupancies
+	state.FeeMultiplier = nextFeeMultiplier(fm, currentFeeMultiplier(*state), occupancies)
+}
+
+// GetFeeMultiplier returns the multiplier currently applied by ChargeBeans.
+// It is 1 unless the fee market is enabled.
+func (k Keeper) GetFeeMultiplier(ctx sdk.Context) sdk.Dec {
+	if !k.GetParams(ctx).FeeMarket.Enabled {
+		return sdk.OneDec()
+	}
+	return currentFeeMultiplier(k.GetState(ctx))
+}
+
+// applyFeeMultiplier scales a bean charge by the current fee multiplier,
+// rounding down.
+func (k Keeper) applyFeeMultiplier(ctx sdk.Context, beans sdkmath.Uint) sdkmath.Uint {
+	multiplier := k.GetFeeMultiplier(ctx)
+	if multiplier.Equal(sdk.OneDec()) {
+		return beans
+	}
+	scaled := sdk.NewDecFromBigInt(beans.BigInt()).Mul(multiplier).TruncateInt()
+	return sdkmath.NewUintFromBigInt(scaled.BigInt())
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// NodeQuerier serves the queries of the state of this node, such as its
+// swing-store exports, which are not part of the chain state.
+type NodeQuerier struct{}
+
+var _ types.NodeServer = NodeQuerier{}
+
+// RegisterNodeService registers the swingset node gRPC service on the provided
+// gRPC router.
+func RegisterNodeService(server gogogrpc.Server) {
+	types.RegisterNodeServer(server, NodeQuerier{})
+}
+
+// RegisterNodeGRPCGatewayRoutes mounts the swingset node gRPC service's
+// GRPC-gateway routes on the given mux object.
+func RegisterNodeGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = types.RegisterNodeHandlerClient(context.Background(), mux, types.NewNodeClient(clientConn))
+}
+
+func (NodeQuerier) SwingStoreExportStatus(c context.Context, req *types.SwingStoreExportStatusRequest) (*types.SwingStoreExportStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operationStatus := GetSwingStoreOperationStatus()
+	if operationStatus == nil {
+		return &types.SwingStoreExportStatusResponse{}, nil
+	}
+
+	return &types.SwingStoreExportStatusResponse{
+		Active:      true,
+		IsRestore:   operationStatus.IsRestore,
+		BlockHeight: operationStatus.BlockHeight,
+		Phase:       operationStatus.Phase,
+		StartTime:   operationStatus.StartTime,
+		Elapsed:     operationStatus.Elapsed,
+	}, nil
+}
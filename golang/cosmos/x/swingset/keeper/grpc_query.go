@@ -30,6 +30,124 @@ func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*type
 	}, nil
 }
 
+func (k Querier) State(c context.Context, req *types.QueryStateRequest) (*types.QueryStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state := k.GetState(ctx)
+	state.FeeMultiplier = k.GetFeeMultiplier(ctx)
+
+	return &types.QueryStateResponse{
+		State: state,
+	}, nil
+}
+
+func (k Querier) HighPrioritySenders(c context.Context, req *types.QueryHighPrioritySendersRequest) (*types.QueryHighPrioritySendersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senders, pageRes, err := k.PaginateHighPrioritySenders(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryHighPrioritySendersResponse{
+		Senders:    senders,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) BundleUpload(c context.Context, req *types.QueryBundleUploadRequest) (*types.QueryBundleUploadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	submitter, err := sdk.AccAddressFromBech32(req.Submitter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	upload, found := k.GetBundleUpload(ctx, submitter, req.PayloadHash)
+	if !found {
+		return nil, status.Error(codes.NotFound, "bundle upload not found")
+	}
+
+	return &types.QueryBundleUploadResponse{
+		Upload:        upload,
+		MissingChunks: k.GetMissingBundleUploadChunks(ctx, upload),
+	}, nil
+}
+
+func (k Querier) Bundles(c context.Context, req *types.QueryBundlesRequest) (*types.QueryBundlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bundles, pageRes, err := k.PaginateSubmittedBundles(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBundlesResponse{
+		Bundles:    bundles,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) Bundle(c context.Context, req *types.QueryBundleRequest) (*types.QueryBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bundle, found := k.GetSubmittedBundle(ctx, req.BundleId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "bundle not found")
+	}
+
+	return &types.QueryBundleResponse{
+		Bundle: bundle,
+	}, nil
+}
+
+func (k Querier) CoreEvals(c context.Context, req *types.QueryCoreEvalsRequest) (*types.QueryCoreEvalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records, pageRes, err := k.PaginateCoreEvalRecords(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCoreEvalsResponse{
+		CoreEvals:  records,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) CoreEval(c context.Context, req *types.QueryCoreEvalRequest) (*types.QueryCoreEvalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetCoreEvalRecord(ctx, req.ContentHash)
+	if !found {
+		return nil, status.Error(codes.NotFound, "core-eval not found")
+	}
+
+	return &types.QueryCoreEvalResponse{
+		CoreEval: record,
+	}, nil
+}
+
 func (k Querier) Egress(c context.Context, req *types.QueryEgressRequest) (*types.QueryEgressResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// The governance-managed high priority senders are kept in the module store,
+// separately from the highPrioritySenders vstorage entries which are managed
+// by the JS prioritySenders manager.  A sender is high priority if it is
+// registered in either place.
+const highPrioritySenderKeyPrefix = "highPrioritySender."
+
+func highPrioritySenderKey(addr sdk.AccAddress, namespace string) []byte {
+	return append(address.MustLengthPrefix(addr), []byte(namespace)...)
+}
+
+func (k Keeper) highPrioritySenderStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(highPrioritySenderKeyPrefix))
+}
+
+// GetHighPrioritySender returns the registry entry of addr under namespace, if
+// any.
+func (k Keeper) GetHighPrioritySender(ctx sdk.Context, addr sdk.AccAddress, namespace string) (types.HighPrioritySender, bool) {
+	bz := k.highPrioritySenderStore(ctx).Get(highPrioritySenderKey(addr, namespace))
+	if bz == nil {
+		return types.HighPrioritySender{}, false
+	}
+	sender := types.HighPrioritySender{}
+	k.cdc.MustUnmarshal(bz, &sender)
+	return sender, true
+}
+
+// SetHighPrioritySender stores a registry entry.
+func (k Keeper) SetHighPrioritySender(ctx sdk.Context, sender types.HighPrioritySender) {
+	addr := sdk.MustAccAddressFromBech32(sender.Address)
+	k.highPrioritySenderStore(ctx).Set(highPrioritySenderKey(addr, sender.Namespace), k.cdc.MustMarshal(&sender))
+}
+
+// DeleteHighPrioritySender removes the registry entry of addr under namespace.
+func (k Keeper) DeleteHighPrioritySender(ctx sdk.Context, addr sdk.AccAddress, namespace string) {
+	k.highPrioritySenderStore(ctx).Delete(highPrioritySenderKey(addr, namespace))
+}
+
+// hasRegisteredHighPrioritySender returns whether addr is registered under any
+// namespace.
+func (k Keeper) hasRegisteredHighPrioritySender(ctx sdk.Context, addr sdk.AccAddress) bool {
+	iterator := sdk.KVStorePrefixIterator(k.highPrioritySenderStore(ctx), address.MustLengthPrefix(addr))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// GetAllHighPrioritySenders returns every registry entry, ordered by address
+// and then namespace.
+func (k Keeper) GetAllHighPrioritySenders(ctx sdk.Context) []types.HighPrioritySender {
+	senders := []types.HighPrioritySender{}
+	iterator := k.highPrioritySenderStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		sender := types.HighPrioritySender{}
+		k.cdc.MustUnmarshal(iterator.Value(), &sender)
+		senders = append(senders, sender)
+	}
+	return senders
+}
+
+// PaginateHighPrioritySenders returns a page of registry entries.
+func (k Keeper) PaginateHighPrioritySenders(ctx sdk.Context, pageReq *query.PageRequest) ([]types.HighPrioritySender, *query.PageResponse, error) {
+	senders := []types.HighPrioritySender{}
+	pageRes, err := query.Paginate(k.highPrioritySenderStore(ctx), pageReq, func(key []byte, value []byte) error {
+		sender := types.HighPrioritySender{}
+		if err := k.cdc.Unmarshal(value, &sender); err != nil {
+			return err
+		}
+		senders = append(senders, sender)
+		return nil
+	})
+	return senders, pageRes, err
+}
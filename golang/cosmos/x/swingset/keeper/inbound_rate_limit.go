@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// Inbound messages admitted from each sender are counted in the transient
+// store for the current block, then folded into a persistent per-sender
+// history by FlushInboundSenderCounts at the end of the block.  This keeps
+// the ante handler to a couple of reads and a transient write per message.
+//
+// Each history is also indexed under inboundSenderLastHeightKeyPrefix by the
+// height of its latest count, so that the histories of senders who have not
+// sent within the window are found and pruned without scanning them all.
+const (
+	inboundSenderCountKeyPrefix      = "inboundSenderCount."
+	inboundSenderHistoryKeyPrefix    = "inboundSenderHistory."
+	inboundSenderLastHeightKeyPrefix = "inboundSenderLastHeight."
+)
+
+var inboundSenderLastHeightValue = []byte{1}
+
+func inboundSenderLastHeightKey(height int64, addr sdk.AccAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), addr...)
+}
+
+func (k Keeper) getCurrentInboundSenderCount(ctx sdk.Context, addr sdk.AccAddress) uint32 {
+	store := prefix.NewStore(ctx.TransientStore(k.tStoreKey), []byte(inboundSenderCountKeyPrefix))
+	bz := store.Get(addr)
+	if len(bz) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(bz)
+}
+
+func (k Keeper) getInboundSenderHistory(ctx sdk.Context, addr sdk.AccAddress) types.InboundSenderHistory {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(inboundSenderHistoryKeyPrefix))
+	history := types.InboundSenderHistory{}
+	bz := store.Get(addr)
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &history)
+	}
+	return history
+}
+
+// setInboundSenderHistory replaces the history of addr, and its index entry.
+// The counts of a history are in increasing height order.
+func (k Keeper) setInboundSenderHistory(ctx sdk.Context, addr sdk.AccAddress, previous, history types.InboundSenderHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(inboundSenderHistoryKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(inboundSenderLastHeightKeyPrefix))
+	if n := len(previous.Counts); n > 0 {
+		indexStore.Delete(inboundSenderLastHeightKey(previous.Counts[n-1].BlockHeight, addr))
+	}
+	n := len(history.Counts)
+	if n == 0 {
+		store.Delete(addr)
+		return
+	}
+	store.Set(addr, k.cdc.MustMarshal(&history))
+	indexStore.Set(inboundSenderLastHeightKey(history.Counts[n-1].BlockHeight, addr), inboundSenderLastHeightValue)
+}
+
+// GetInboundSenderCount returns the number of inbound messages admitted from
+// addr within the last windowBlocks blocks, including the current one.
+func (k Keeper) GetInboundSenderCount(ctx sdk.Context, addr sdk.AccAddress, windowBlocks uint32) uint32 {
+	count := k.getCurrentInboundSenderCount(ctx, addr)
+	oldest := ctx.BlockHeight() - int64(windowBlocks) + 1
+	for _, bc := range k.getInboundSenderHistory(ctx, addr).Counts {
+		if bc.BlockHeight >= oldest && bc.BlockHeight < ctx.BlockHeight() {
+			count += bc.Count
+		}
+	}
+	return count
+}
+
+// AddInboundSenderCount records n more inbound messages admitted from addr in
+// the current block.
+func (k Keeper) AddInboundSenderCount(ctx sdk.Context, addr sdk.AccAddress, n uint32) {
+	store := prefix.NewStore(ctx.TransientStore(k.tStoreKey), []byte(inboundSenderCountKeyPrefix))
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, k.getCurrentInboundSenderCount(ctx, addr)+n)
+	store.Set(addr, bz)
+}
+
+// FlushInboundSenderCounts moves the inbound message counts of the current
+// block into each sender's persistent history, dropping any entries that have
+// fallen out of the rate limit window, then prunes the histories of the
+// senders whose latest count has fallen out of it.
+func (k Keeper) FlushInboundSenderCounts(ctx sdk.Context) {
+	rateLimit := k.GetParams(ctx).InboundRateLimit
+	oldest := ctx.BlockHeight() - int64(rateLimit.WindowBlocks) + 1
+
+	tStore := prefix.NewStore(ctx.TransientStore(k.tStoreKey), []byte(inboundSenderCountKeyPrefix))
+	iterator := tStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key())
+		previous := k.getInboundSenderHistory(ctx, addr)
+		counts := make([]types.InboundBlockCount, 0, len(previous.Counts)+1)
+		for _, bc := range previous.Counts {
+			if bc.BlockHeight >= oldest && bc.BlockHeight < ctx.BlockHeight() {
+				counts = append(counts, bc)
+			}
+		}
+		if rateLimit.IsEnabled() {
+			counts = append(counts, types.InboundBlockCount{
+				BlockHeight: ctx.BlockHeight(),
+				Count:       binary.BigEndian.Uint32(iterator.Value()),
+			})
+		}
+		k.setInboundSenderHistory(ctx, addr, previous, types.InboundSenderHistory{Counts: counts})
+	}
+
+	k.pruneInboundSenderHistories(ctx, oldest)
+}
+
+// pruneInboundSenderHistories deletes the histories whose latest count is
+// below the oldest height of the window.
+func (k Keeper) pruneInboundSenderHistories(ctx sdk.Context, oldest int64) {
+	if oldest <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(inboundSenderHistoryKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(inboundSenderLastHeightKeyPrefix))
+
+	expired := [][]byte{}
+	iterator := indexStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(oldest)))
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		indexStore.Delete(key)
+		store.Delete(key[8:])
+	}
+}
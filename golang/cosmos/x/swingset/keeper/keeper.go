@@ -48,6 +48,7 @@ const (
 
 const (
 	stateKey            = "state"
+	paramsKey           = "params"
 	swingStoreKeyPrefix = "swingStore."
 )
 
@@ -72,9 +73,14 @@ type inboundQueueRecord struct {
 
 // Keeper maintains the link to data vstorage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	cdc        codec.Codec
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+	cdc       codec.Codec
+	// paramSpace is the legacy x/params subspace, only consulted to migrate the
+	// params into the module store.
 	paramSpace paramtypes.Subspace
+	// authority is the address allowed to update the params, usually x/gov.
+	authority string
 
 	accountKeeper    types.AccountKeeper
 	bankKeeper       bankkeeper.Keeper
@@ -90,10 +96,11 @@ var _ ante.SwingsetKeeper = &Keeper{}
 
 // NewKeeper creates a new IBC transfer Keeper instance
 func NewKeeper(
-	cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.Codec, key, tkey storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper bankkeeper.Keeper,
 	vstorageKeeper vstoragekeeper.Keeper, feeCollectorName string,
 	callToController func(ctx sdk.Context, str string) (string, error),
+	authority string,
 ) Keeper {
 
 	// set KeyTable if it has not already been set
@@ -103,8 +110,10 @@ func NewKeeper(
 
 	return Keeper{
 		storeKey:         key,
+		tStoreKey:        tkey,
 		cdc:              cdc,
 		paramSpace:       paramSpace,
+		authority:        authority,
 		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
 		vstorageKeeper:   vstorageKeeper,
@@ -163,9 +172,14 @@ func (k Keeper) PushHighPriorityAction(ctx sdk.Context, action vm.Action) error
 	return k.pushAction(ctx, StoragePathHighPriorityQueue, action)
 }
 
+// IsHighPriorityAddress returns whether addr is a high priority sender, either
+// by a highPrioritySenders vstorage entry, or by governance registration.
 func (k Keeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
 	path := StoragePathHighPrioritySenders + "." + addr.String()
-	return k.vstorageKeeper.HasEntry(ctx, path), nil
+	if k.vstorageKeeper.HasEntry(ctx, path) {
+		return true, nil
+	}
+	return k.hasRegisteredHighPrioritySender(ctx, addr), nil
 }
 
 // GetSmartWalletState returns the provision state of the smart wallet for the account address
@@ -236,6 +250,7 @@ func (k Keeper) UpdateQueueAllowed(ctx sdk.Context) error {
 		{Key: types.QueueInbound, Size_: inboundQueueAllowed},
 		{Key: types.QueueInboundMempool, Size_: inboundMempoolQueueAllowed},
 	}
+	updateFeeMarket(&state, params.FeeMarket, inboundQueueSize, inboundQueueMax)
 	k.SetState(ctx, state)
 
 	return nil
@@ -257,12 +272,32 @@ func (k Keeper) BlockingSend(ctx sdk.Context, action vm.Action) (string, error)
 	return k.callToController(ctx, string(bz))
 }
 
+// GetAuthority returns the address allowed to update the params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(paramsKey))
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(paramsKey), bz)
+}
+
+// getLegacyParams reads the params from the x/params subspace where they
+// were kept before consensus version 3.
+func (k Keeper) getLegacyParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setLegacyParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
@@ -313,11 +348,12 @@ func (k Keeper) SetBeansOwing(ctx sdk.Context, addr sdk.AccAddress, beans sdkmat
 	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(path, beans.String()))
 }
 
-// ChargeBeans charges the given address the given number of beans.  It divides
-// the beans into the number to debit immediately vs. the number to store in the
-// beansOwing.
+// ChargeBeans charges the given address the given number of beans, scaled by
+// the current fee multiplier.  It divides the beans into the number to debit
+// immediately vs. the number to store in the beansOwing.
 func (k Keeper) ChargeBeans(ctx sdk.Context, addr sdk.AccAddress, beans sdkmath.Uint) error {
 	beansPerUnit := k.GetBeansPerUnit(ctx)
+	beans = k.applyFeeMultiplier(ctx, beans)
 
 	wasOwing := k.GetBeansOwing(ctx, addr)
 	nowOwing := wasOwing.Add(beans)
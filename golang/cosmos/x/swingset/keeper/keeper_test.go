@@ -3,14 +3,27 @@ package keeper
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle/bundletest"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	prefixstore "github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -190,7 +203,8 @@ func Test_calculateFees(t *testing.T) {
 }
 
 var (
-	swingsetStoreKey = storetypes.NewKVStoreKey(types.StoreKey)
+	swingsetStoreKey  = storetypes.NewKVStoreKey(types.StoreKey)
+	swingsetTStoreKey = storetypes.NewTransientStoreKey(types.TStoreKey)
 )
 
 func makeTestStore() sdk.KVStore {
@@ -258,3 +272,745 @@ func TestSwingStore(t *testing.T) {
 		t.Errorf("got export %q, want %q", gotEntries, expectedEntries)
 	}
 }
+
+var govAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+// makeTestKit creates a minimal Keeper, its legacy params subspace, and a
+// Context for use in testing.
+func makeTestKit() (Keeper, paramstypes.Subspace, sdk.Context) {
+	encodingConfig := params.MakeEncodingConfig()
+	cdc := encodingConfig.Marshaler
+
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	pk := paramskeeper.NewKeeper(cdc, encodingConfig.Amino, paramsStoreKey, paramsTStoreKey)
+
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	vstorageKeeper := vstoragekeeper.NewKeeper(vstorageStoreKey)
+
+	subspace := pk.Subspace(types.ModuleName)
+	keeper := NewKeeper(cdc, swingsetStoreKey, swingsetTStoreKey, subspace, nil, bankkeeper.BaseKeeper{}, vstorageKeeper, "feeCollectorName", nil, govAuthority)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(swingsetTStoreKey, storetypes.StoreTypeTransient, db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	return keeper, keeper.paramSpace, ctx
+}
+
+func TestUpdateParams(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	keeper.SetParams(ctx, types.DefaultParams())
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	newParams := types.DefaultParams()
+	newParams.BootstrapVatConfig = "@agoric/vm-config/decentral-test-vaults-config.json"
+
+	_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(submitAddr.String(), newParams))
+	if err == nil {
+		t.Fatalf("expected error for non-authority signer")
+	}
+	if got := keeper.GetParams(ctx); !reflect.DeepEqual(got, types.DefaultParams()) {
+		t.Errorf("params changed by non-authority: got %v", got)
+	}
+
+	badParams := types.DefaultParams()
+	badParams.BootstrapVatConfig = ""
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(govAuthority, badParams))
+	if err == nil {
+		t.Fatalf("expected error for invalid params")
+	}
+
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(govAuthority, newParams))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if got := keeper.GetParams(ctx); !reflect.DeepEqual(got, newParams) {
+		t.Errorf("got params %v, want %v", got, newParams)
+	}
+}
+
+func TestMigrateParamsToStore(t *testing.T) {
+	keeper, subspace, ctx := makeTestKit()
+
+	legacyParams := types.DefaultParams()
+	legacyParams.BootstrapVatConfig = "@agoric/vm-config/decentral-main-vaults-config.json"
+	subspace.SetParamSet(ctx, &legacyParams)
+
+	if ctx.KVStore(swingsetStoreKey).Has([]byte(paramsKey)) {
+		t.Fatalf("params unexpectedly in module store before migration")
+	}
+
+	err := NewMigrator(keeper).Migrate2to3(ctx)
+	if err != nil {
+		t.Fatalf("unexpected migration error %s", err)
+	}
+	got := keeper.GetParams(ctx)
+	// The fee market postdates the legacy subspace, so it is left for
+	// MigrateParams to populate.
+	if !got.FeeMarket.IsUnset() {
+		t.Errorf("got fee market %v, want unset", got.FeeMarket)
+	}
+	got.FeeMarket = legacyParams.FeeMarket
+	// Likewise, chunked bundle uploads stay disabled until governance enables
+	// them.
+	if got.BundleUploadExpiryBlocks != 0 {
+		t.Errorf("got bundle upload expiry blocks %d, want 0", got.BundleUploadExpiryBlocks)
+	}
+	got.BundleUploadExpiryBlocks = legacyParams.BundleUploadExpiryBlocks
+	if !reflect.DeepEqual(got, legacyParams) {
+		t.Errorf("got params %v, want %v", got, legacyParams)
+	}
+
+	err = NewMigrator(keeper).MigrateParams(ctx)
+	if err != nil {
+		t.Fatalf("unexpected migration error %s", err)
+	}
+	if got := keeper.GetParams(ctx).FeeMarket; !got.Equal(types.DefaultFeeMarket()) {
+		t.Errorf("got fee market %v, want %v", got, types.DefaultFeeMarket())
+	}
+}
+
+func TestNextFeeMultiplier(t *testing.T) {
+	fm := types.DefaultFeeMarket()
+	fm.Enabled = true
+	dec := sdk.MustNewDecFromStr
+
+	for _, tt := range []struct {
+		name        string
+		prev        sdk.Dec
+		occupancies []sdk.Dec
+		want        sdk.Dec
+	}{
+		{
+			name:        "at_target",
+			prev:        dec("2"),
+			occupancies: []sdk.Dec{dec("0.5"), dec("0.5")},
+			want:        dec("2"),
+		},
+		{
+			name:        "saturated",
+			prev:        dec("2"),
+			occupancies: []sdk.Dec{dec("1"), dec("1")},
+			want:        dec("2.25"),
+		},
+		{
+			name:        "empty_queue",
+			prev:        dec("2"),
+			occupancies: []sdk.Dec{dec("0"), dec("0")},
+			want:        dec("1.75"),
+		},
+		{
+			name:        "averaged",
+			prev:        dec("2"),
+			occupancies: []sdk.Dec{dec("1"), dec("0.5"), dec("0.75")},
+			want:        dec("2.125"),
+		},
+		{
+			name:        "clamped_min",
+			prev:        dec("1"),
+			occupancies: []sdk.Dec{dec("0")},
+			want:        fm.MinMultiplier,
+		},
+		{
+			name:        "clamped_max",
+			prev:        dec("9.9"),
+			occupancies: []sdk.Dec{dec("1")},
+			want:        fm.MaxMultiplier,
+		},
+		{
+			name: "no_history",
+			prev: dec("3"),
+			want: dec("3"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nextFeeMultiplier(fm, tt.prev, tt.occupancies)
+			if !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateFeeMarket(t *testing.T) {
+	fm := types.DefaultFeeMarket()
+	fm.Enabled = true
+	fm.WindowBlocks = 3
+
+	state := types.State{}
+	for i := 0; i < 5; i++ {
+		updateFeeMarket(&state, fm, 1000, 1000)
+	}
+	if got := len(state.InboundQueueOccupancy); got != 3 {
+		t.Errorf("got %d occupancy samples, want 3", got)
+	}
+	// 1.125^5, to the 18-digit precision of Dec.
+	want := sdk.MustNewDecFromStr("1.802032470703125000")
+	if !state.FeeMultiplier.Equal(want) {
+		t.Errorf("got multiplier %s, want %s", state.FeeMultiplier, want)
+	}
+
+	fm.Enabled = false
+	updateFeeMarket(&state, fm, 1000, 1000)
+	if !state.FeeMultiplier.Equal(sdk.OneDec()) {
+		t.Errorf("got multiplier %s after disabling, want 1", state.FeeMultiplier)
+	}
+	if len(state.InboundQueueOccupancy) != 0 {
+		t.Errorf("got occupancy %v after disabling, want none", state.InboundQueueOccupancy)
+	}
+}
+
+func TestChargeBeansFeeMultiplier(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	params := types.DefaultParams()
+	params.FeeMarket.Enabled = true
+	keeper.SetParams(ctx, params)
+
+	beans := sdk.NewUint(1000)
+	if got := keeper.applyFeeMultiplier(ctx, beans); !got.Equal(beans) {
+		t.Errorf("got %s beans without a recorded multiplier, want %s", got, beans)
+	}
+
+	keeper.SetState(ctx, types.State{FeeMultiplier: sdk.MustNewDecFromStr("2.5")})
+	if got := keeper.applyFeeMultiplier(ctx, beans); !got.Equal(sdk.NewUint(2500)) {
+		t.Errorf("got %s beans, want 2500", got)
+	}
+
+	params.FeeMarket.Enabled = false
+	keeper.SetParams(ctx, params)
+	if got := keeper.applyFeeMultiplier(ctx, beans); !got.Equal(beans) {
+		t.Errorf("got %s beans with fee market disabled, want %s", got, beans)
+	}
+}
+
+// clearTransientStore emulates the reset of the transient store on Commit.
+func clearTransientStore(ctx sdk.Context) {
+	store := ctx.TransientStore(swingsetTStoreKey)
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestInboundSenderCount(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	params := types.DefaultParams()
+	params.InboundRateLimit = types.InboundRateLimit{WindowBlocks: 3, MaxMessages: 10}
+	keeper.SetParams(ctx, params)
+	other := sdk.AccAddress([]byte("other"))
+
+	for _, tt := range []struct {
+		height int64
+		add    uint32
+		want   uint32
+	}{
+		{height: 1, add: 3, want: 3},
+		{height: 2, add: 4, want: 7},
+		{height: 3, add: 0, want: 7},
+		// Block 1 has left the window.
+		{height: 4, add: 1, want: 5},
+		// Block 2 has left the window.
+		{height: 5, add: 0, want: 1},
+	} {
+		blockCtx := ctx.WithBlockHeight(tt.height)
+		if tt.add > 0 {
+			keeper.AddInboundSenderCount(blockCtx, submitAddr, tt.add)
+		}
+		if tt.height == 1 {
+			keeper.AddInboundSenderCount(blockCtx, other, 1)
+		}
+		if got := keeper.GetInboundSenderCount(blockCtx, submitAddr, 3); got != tt.want {
+			t.Errorf("block %d: got count %d, want %d", tt.height, got, tt.want)
+		}
+		keeper.FlushInboundSenderCounts(blockCtx)
+		clearTransientStore(ctx)
+	}
+
+	if got := keeper.GetInboundSenderCount(ctx.WithBlockHeight(5), other, 3); got != 0 {
+		t.Errorf("got count %d for other sender, want 0", got)
+	}
+	// The other sender has not sent since block 1, so their history is gone.
+	historyStore := prefixstore.NewStore(ctx.KVStore(keeper.storeKey), []byte(inboundSenderHistoryKeyPrefix))
+	if historyStore.Has(other) {
+		t.Errorf("got history for other sender, want it pruned")
+	}
+	if !historyStore.Has(submitAddr) {
+		t.Errorf("got no history for sender within the window")
+	}
+
+	// Once the sender stops sending, their history is pruned too.
+	keeper.FlushInboundSenderCounts(ctx.WithBlockHeight(8))
+	if historyStore.Has(submitAddr) {
+		t.Errorf("got history for idle sender, want it pruned")
+	}
+	indexStore := prefixstore.NewStore(ctx.KVStore(keeper.storeKey), []byte(inboundSenderLastHeightKeyPrefix))
+	iterator := indexStore.Iterator(nil, nil)
+	defer iterator.Close()
+	if iterator.Valid() {
+		t.Errorf("got index entry %x, want none", iterator.Key())
+	}
+}
+
+func TestHighPrioritySenders(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	ctx = ctx.WithBlockHeight(17).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	isHighPriority := func(addr sdk.AccAddress) bool {
+		ok, err := keeper.IsHighPriorityAddress(ctx, addr)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		return ok
+	}
+	if isHighPriority(utilAddr) {
+		t.Fatalf("address unexpectedly high priority before registration")
+	}
+
+	_, err := msgServer.AddHighPrioritySender(goCtx, types.NewMsgAddHighPrioritySender(submitAddr.String(), utilAddr, "oracle"))
+	if err == nil {
+		t.Fatalf("expected error for non-authority signer")
+	}
+
+	_, err = msgServer.AddHighPrioritySender(goCtx, types.NewMsgAddHighPrioritySender(govAuthority, utilAddr, "oracle"))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	_, err = msgServer.AddHighPrioritySender(goCtx, types.NewMsgAddHighPrioritySender(govAuthority, utilAddr, "keeper"))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	_, err = msgServer.AddHighPrioritySender(goCtx, types.NewMsgAddHighPrioritySender(govAuthority, utilAddr, "oracle"))
+	if err == nil {
+		t.Fatalf("expected error for duplicate registration")
+	}
+	if !isHighPriority(utilAddr) {
+		t.Errorf("address not high priority after registration")
+	}
+	if isHighPriority(submitAddr) {
+		t.Errorf("unregistered address unexpectedly high priority")
+	}
+
+	res, err := Querier{keeper}.HighPrioritySenders(goCtx, &types.QueryHighPrioritySendersRequest{})
+	if err != nil {
+		t.Fatalf("unexpected query error %s", err)
+	}
+	want := []types.HighPrioritySender{
+		{Address: utilAddr.String(), Namespace: "keeper", AddedHeight: 17, AddedTime: ctx.BlockTime()},
+		{Address: utilAddr.String(), Namespace: "oracle", AddedHeight: 17, AddedTime: ctx.BlockTime()},
+	}
+	if !reflect.DeepEqual(res.Senders, want) {
+		t.Errorf("got senders %v, want %v", res.Senders, want)
+	}
+
+	_, err = msgServer.RemoveHighPrioritySender(goCtx, types.NewMsgRemoveHighPrioritySender(govAuthority, utilAddr, "oracle"))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !isHighPriority(utilAddr) {
+		t.Errorf("address not high priority while still registered under keeper")
+	}
+	_, err = msgServer.RemoveHighPrioritySender(goCtx, types.NewMsgRemoveHighPrioritySender(govAuthority, utilAddr, "oracle"))
+	if err == nil {
+		t.Fatalf("expected error removing an absent registration")
+	}
+	_, err = msgServer.RemoveHighPrioritySender(goCtx, types.NewMsgRemoveHighPrioritySender(govAuthority, utilAddr, "keeper"))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if isHighPriority(utilAddr) {
+		t.Errorf("address still high priority after removal")
+	}
+}
+
+func TestBundleUpload(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	ctx = ctx.WithBlockHeight(10)
+	params := types.DefaultParams()
+	params.BundleUploadExpiryBlocks = 5
+	keeper.SetParams(ctx, params)
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	bundle := &types.MsgInstallBundle{Bundle: `{"moduleFormat":"endoZipBase64"}`}
+	if err := bundle.Compress(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	begin, chunks, err := types.NewBundleUploadMsgs(bundle.CompressedBundle, bundle.UncompressedSize, 8, submitAddr)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if len(chunks) < 3 {
+		t.Fatalf("want at least 3 chunks, got %d", len(chunks))
+	}
+
+	_, err = msgServer.UploadBundleChunk(goCtx, chunks[0])
+	if err == nil {
+		t.Fatalf("expected error for chunk before upload began")
+	}
+	_, err = msgServer.BeginBundleUpload(goCtx, begin)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	_, err = msgServer.BeginBundleUpload(goCtx, begin)
+	if err == nil {
+		t.Fatalf("expected error for duplicate upload")
+	}
+
+	// Send the chunks out of order, with a corrupted first chunk that is later
+	// replaced.
+	last := len(chunks) - 1
+	corrupted := *chunks[0]
+	corrupted.Data = append([]byte{}, chunks[0].Data...)
+	corrupted.Data[0] ^= 0xff
+	for _, chunk := range append([]*types.MsgUploadBundleChunk{&corrupted}, chunks[1:last]...) {
+		res, err := msgServer.UploadBundleChunk(goCtx, chunk)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if res.Completed {
+			t.Fatalf("upload completed early at chunk %d", chunk.Index)
+		}
+	}
+
+	progress, err := Querier{keeper}.BundleUpload(goCtx, &types.QueryBundleUploadRequest{
+		Submitter:   submitAddr.String(),
+		PayloadHash: begin.PayloadHash,
+	})
+	if err != nil {
+		t.Fatalf("unexpected query error %s", err)
+	}
+	if !reflect.DeepEqual(progress.MissingChunks, []uint32{uint32(last)}) {
+		t.Errorf("got missing chunks %v, want [%d]", progress.MissingChunks, last)
+	}
+	if progress.Upload.ExpiryHeight != 15 {
+		t.Errorf("got expiry height %d, want 15", progress.Upload.ExpiryHeight)
+	}
+
+	// The cache context discards the effects of the failed message, as the
+	// transaction would.
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.UploadBundleChunk(sdk.WrapSDKContext(cacheCtx), chunks[last])
+	if err == nil {
+		t.Fatalf("expected hash mismatch error")
+	}
+
+	_, err = msgServer.UploadBundleChunk(goCtx, chunks[0])
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	res, err := msgServer.UploadBundleChunk(goCtx, chunks[last])
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !res.Completed {
+		t.Fatalf("upload not completed after the last chunk")
+	}
+	if _, found := keeper.GetBundleUpload(ctx, submitAddr, begin.PayloadHash); found {
+		t.Errorf("upload still present after completion")
+	}
+	if length, err := keeper.InboundQueueLength(ctx); err != nil || length != 1 {
+		t.Errorf("got inbound queue length %d (error %v), want 1", length, err)
+	}
+}
+
+func TestBundleUploadExpiry(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	ctx = ctx.WithBlockHeight(10)
+	params := types.DefaultParams()
+	params.BundleUploadExpiryBlocks = 5
+	keeper.SetParams(ctx, params)
+
+	begin, chunks, err := types.NewBundleUploadMsgs([]byte(`{"moduleFormat":"endoZipBase64"}`), 0, 8, submitAddr)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := keeper.BeginBundleUpload(ctx, begin); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if _, err := keeper.AddBundleUploadChunk(ctx, chunks[0]); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	keeper.PurgeExpiredBundleUploads(ctx.WithBlockHeight(14))
+	if _, found := keeper.GetBundleUpload(ctx, submitAddr, begin.PayloadHash); !found {
+		t.Fatalf("upload purged before its expiry height")
+	}
+	keeper.PurgeExpiredBundleUploads(ctx.WithBlockHeight(15))
+	if _, found := keeper.GetBundleUpload(ctx, submitAddr, begin.PayloadHash); found {
+		t.Fatalf("upload not purged at its expiry height")
+	}
+	if _, err := keeper.AddBundleUploadChunk(ctx, chunks[1]); err == nil {
+		t.Errorf("expected error for chunk of an expired upload")
+	}
+
+	params.BundleUploadExpiryBlocks = 0
+	keeper.SetParams(ctx, params)
+	if err := keeper.BeginBundleUpload(ctx, begin); err == nil {
+		t.Errorf("expected error when chunked uploads are disabled")
+	}
+}
+
+func TestBundleUploadLimits(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	ctx = ctx.WithBlockHeight(10)
+	params := types.DefaultParams()
+	params.BundleUploadExpiryBlocks = 5
+	keeper.SetParams(ctx, params)
+
+	var begin *types.MsgBeginBundleUpload
+	var chunks []*types.MsgUploadBundleChunk
+	for i := 0; i <= types.MaxOpenBundleUploads; i++ {
+		payload := []byte(fmt.Sprintf(`{"moduleFormat":"endoZipBase64","n":%d}`, i))
+		var err error
+		begin, chunks, err = types.NewBundleUploadMsgs(payload, 0, 16, submitAddr)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		err = keeper.BeginBundleUpload(ctx, begin)
+		if i < types.MaxOpenBundleUploads && err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if i == types.MaxOpenBundleUploads && err == nil {
+			t.Fatalf("expected error beyond %d open uploads", types.MaxOpenBundleUploads)
+		}
+	}
+	other, _, err := types.NewBundleUploadMsgs(chunks[0].Data, 0, 16, sdk.AccAddress([]byte("other")))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := keeper.BeginBundleUpload(ctx, other); err != nil {
+		t.Errorf("unexpected error for another submitter %s", err)
+	}
+
+	// Only the chunk completing an upload counts as an inbound message.
+	_, chunks, err = types.NewBundleUploadMsgs([]byte(`{"moduleFormat":"endoZipBase64","n":0}`), 0, 16, submitAddr)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if len(chunks) != 3 {
+		t.Fatalf("want 3 chunks, got %d", len(chunks))
+	}
+	for i, chunk := range chunks {
+		want := int32(0)
+		if i == len(chunks)-1 {
+			want = 1
+		}
+		got, err := chunk.GetStatefulInboundMsgCount(ctx, keeper, nil)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if got != want {
+			t.Errorf("chunk %d: got inbound count %d, want %d", i, got, want)
+		}
+		if want == 0 {
+			if _, err := keeper.AddBundleUploadChunk(ctx, chunk); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+		}
+	}
+	// A transaction carrying the last two chunks counts the second.
+	_, chunks, err = types.NewBundleUploadMsgs([]byte(`{"moduleFormat":"endoZipBase64","n":1}`), 0, 16, submitAddr)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if _, err := keeper.AddBundleUploadChunk(ctx, chunks[0]); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if got, _ := chunks[1].GetStatefulInboundMsgCount(ctx, keeper, nil); got != 0 {
+		t.Errorf("got inbound count %d for the second chunk, want 0", got)
+	}
+	if got, _ := chunks[2].GetStatefulInboundMsgCount(ctx, keeper, []sdk.Msg{chunks[1]}); got != 1 {
+		t.Errorf("got inbound count %d for the last chunk, want 1", got)
+	}
+}
+
+func TestSubmittedBundles(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	ctx = ctx.WithBlockHeight(20)
+	keeper.SetParams(ctx, types.DefaultParams())
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	bundle, bundleID := bundletest.NewBundleJSON("42")
+
+	msg := types.NewMsgInstallBundle(bundle, submitAddr)
+	if err := msg.Compress(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if _, err := msgServer.InstallBundle(goCtx, msg); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	res, err := Querier{keeper}.Bundle(goCtx, &types.QueryBundleRequest{BundleId: bundleID})
+	if err != nil {
+		t.Fatalf("unexpected query error %s", err)
+	}
+	want := types.SubmittedBundle{
+		BundleID:  bundleID,
+		Submitter: submitAddr.String(),
+		Height:    20,
+		Size_:     int64(len(bundle)),
+		Status:    types.SubmittedBundleStatusPending,
+	}
+	if !res.Bundle.Equal(want) {
+		t.Errorf("got bundle %v, want %v", res.Bundle, want)
+	}
+
+	// Bundles without a verifiable ID are installed but not recorded.
+	if _, err := msgServer.InstallBundle(goCtx, types.NewMsgInstallBundle("true", submitAddr)); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	all, err := Querier{keeper}.Bundles(goCtx, &types.QueryBundlesRequest{})
+	if err != nil {
+		t.Fatalf("unexpected query error %s", err)
+	}
+	if !reflect.DeepEqual(all.Bundles, []types.SubmittedBundle{want}) {
+		t.Errorf("got bundles %v, want [%v]", all.Bundles, want)
+	}
+
+	// The msg server consumes the gas for the bundle ID once, before
+	// examining the bundle.
+	idGas := types.BundleIDGasPerByte * uint64(len(bundle))
+	duplicate := types.NewMsgInstallBundle(bundle, utilAddr)
+	func() {
+		defer func() {
+			if _, ok := recover().(sdk.ErrorOutOfGas); !ok {
+				t.Errorf("expected out of gas before examining the bundle")
+			}
+		}()
+		_, _ = msgServer.InstallBundle(sdk.WrapSDKContext(ctx.WithGasMeter(sdk.NewGasMeter(10))), duplicate)
+	}()
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if _, err := msgServer.InstallBundle(sdk.WrapSDKContext(gasCtx), duplicate); err == nil {
+		t.Errorf("expected error for pending duplicate bundle")
+	}
+	if consumed := gasCtx.GasMeter().GasConsumed(); consumed < idGas || consumed >= 2*idGas {
+		t.Errorf("got %d gas consumed, want the %d for the bundle ID once", consumed, idGas)
+	}
+	if length, err := keeper.InboundQueueLength(ctx); err != nil || length != 2 {
+		t.Errorf("got inbound queue length %d (error %v), want 2", length, err)
+	}
+
+	// A failed installation removes the record, so the bundle can be submitted
+	// again.
+	if err := keeper.RecordBundleInstallOutcome(ctx, bundleID, "Error: nope"); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if _, found := keeper.GetSubmittedBundle(ctx, bundleID); found {
+		t.Errorf("got a record of a bundle that failed to install")
+	}
+	if _, err := msgServer.InstallBundle(goCtx, duplicate); err != nil {
+		t.Fatalf("unexpected error resubmitting a failed bundle %s", err)
+	}
+	if got, _ := keeper.GetSubmittedBundle(ctx, bundleID); got.Submitter != utilAddr.String() || got.Status != types.SubmittedBundleStatusPending {
+		t.Errorf("got bundle %v, want pending from %s", got, utilAddr)
+	}
+
+	// An installed bundle is refused, and reports only a pending bundle's
+	// outcome.
+	if err := keeper.RecordBundleInstallOutcome(ctx, bundleID, ""); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if got, _ := keeper.GetSubmittedBundle(ctx, bundleID); got.Status != types.SubmittedBundleStatusInstalled {
+		t.Errorf("got bundle %v, want installed", got)
+	}
+	if _, err := msgServer.InstallBundle(goCtx, types.NewMsgInstallBundle(bundle, submitAddr)); err == nil {
+		t.Errorf("expected error for installed duplicate bundle")
+	}
+	if err := keeper.RecordBundleInstallOutcome(ctx, bundleID, ""); err == nil {
+		t.Errorf("expected error for a repeated outcome")
+	}
+	if err := keeper.RecordBundleInstallOutcome(ctx, "b1-unrecorded", ""); err != nil {
+		t.Errorf("unexpected error for an unrecorded bundle %s", err)
+	}
+}
+
+func TestCoreEvalRecords(t *testing.T) {
+	keeper, _, ctx := makeTestKit()
+	ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	eval := types.CoreEval{JsonPermits: `{"consume":{"zoe":true}}`, JsCode: "() => {}"}
+	contentHash := eval.ContentHash()
+	proposal := types.NewCoreEvalProposal("title", "description", []types.CoreEval{eval}).(*types.CoreEvalProposal)
+	if err := keeper.CoreEvalProposal(ctx, proposal); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	queued := keeper.vstorageKeeper.GetEntry(ctx, StoragePathHighPriorityQueue+".0").StringValue()
+	if !strings.Contains(queued, `"content_hash":"`+contentHash+`"`) {
+		t.Errorf("queued action %s lacks content hash %s", queued, contentHash)
+	}
+	events := ctx.EventManager().Events()
+	if len(events) != 1 || events[0].Type != types.EventTypeCoreEval {
+		t.Fatalf("got events %v, want one %s event", events, types.EventTypeCoreEval)
+	}
+	if got := string(events[0].Attributes[1].Value); got != contentHash {
+		t.Errorf("got event content hash %s, want %s", got, contentHash)
+	}
+
+	res, err := Querier{keeper}.CoreEval(goCtx, &types.QueryCoreEvalRequest{ContentHash: contentHash})
+	if err != nil {
+		t.Fatalf("unexpected query error %s", err)
+	}
+	want := types.CoreEvalRecord{
+		ContentHash: contentHash,
+		PermitHash:  eval.PermitHash(),
+		CodeHash:    eval.CodeHash(),
+		Height:      30,
+		Status:      types.CoreEvalStatusPending,
+	}
+	if !res.CoreEval.Equal(want) {
+		t.Errorf("got record %v, want %v", res.CoreEval, want)
+	}
+
+	ctx = ctx.WithBlockHeight(31)
+	if err := keeper.RecordCoreEvalOutcome(ctx, contentHash, "boom"); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	want.Status = types.CoreEvalStatusFailed
+	want.Error = "boom"
+	want.OutcomeHeight = 31
+	if got, _ := keeper.GetCoreEvalRecord(ctx, contentHash); !got.Equal(want) {
+		t.Errorf("got record %v, want %v", got, want)
+	}
+	if err := keeper.RecordCoreEvalOutcome(ctx, contentHash, ""); err == nil {
+		t.Errorf("expected error for an outcome of a settled core-eval")
+	}
+	if err := keeper.RecordCoreEvalOutcome(ctx, types.CoreEval{JsonPermits: "true", JsCode: "1"}.ContentHash(), ""); err == nil {
+		t.Errorf("expected error for an outcome of an unknown core-eval")
+	}
+
+	// A core-eval that passes again is evaluated again.
+	if err := keeper.CoreEvalProposal(ctx, proposal); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := keeper.RecordCoreEvalOutcome(ctx, contentHash, ""); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	all, err := Querier{keeper}.CoreEvals(goCtx, &types.QueryCoreEvalsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected query error %s", err)
+	}
+	if len(all.CoreEvals) != 1 || all.CoreEvals[0].Status != types.CoreEvalStatusSucceeded || all.CoreEvals[0].Error != "" {
+		t.Errorf("got records %v, want one succeeded record", all.CoreEvals)
+	}
+}
@@ -17,7 +17,30 @@ func NewMigrator(keeper Keeper) Migrator {
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
-	return m.MigrateParams(ctx)
+	// The params still live in the x/params subspace at version 2.
+	params := m.keeper.getLegacyParams(ctx)
+	newParams, err := types.UpdateParams(params)
+	if err != nil {
+		return err
+	}
+	m.keeper.setLegacyParams(ctx, newParams)
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.MigrateParamsToStore(ctx)
+}
+
+// MigrateParamsToStore moves the params out of the legacy x/params subspace
+// and into the module store, where only MsgUpdateParams can change them.
+func (m Migrator) MigrateParamsToStore(ctx sdk.Context) error {
+	params := m.keeper.getLegacyParams(ctx)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
 }
 
 // MigrateParams migrates params by setting new params to their default value
@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 
+	sdkioerrors "cosmossdk.io/errors"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -191,19 +194,148 @@ type installBundleAction struct {
 func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInstallBundle) (*types.MsgInstallBundleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := msg.Uncompress()
+	err := keeper.installBundle(ctx, msg, msg)
+	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
 	if err != nil {
 		return nil, err
 	}
+
+	return &types.MsgInstallBundleResponse{}, nil
+}
+
+// installBundle uncompresses the bundle of installMsg, records it by bundle ID
+// as pending if it has one, and routes its installation action as for msg.  A
+// bundle that is pending or installed is refused.  This is the only place the
+// bundle ID is computed, consuming gas in proportion to the uncompressed size
+// before the bundle is examined.
+func (keeper msgServer) installBundle(ctx sdk.Context, msg vm.ControllerAdmissionMsg, installMsg *types.MsgInstallBundle) error {
+	types.ConsumeBundleIDGas(ctx, installMsg.ExpectedUncompressedSize())
+	err := installMsg.Uncompress()
+	if err != nil {
+		return err
+	}
+
+	if bundleID, ok := types.BundleID(installMsg.Bundle); ok {
+		if _, found := keeper.GetSubmittedBundle(ctx, bundleID); found {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bundle %s has already been submitted", bundleID)
+		}
+		keeper.SetSubmittedBundle(ctx, types.SubmittedBundle{
+			BundleID:  bundleID,
+			Submitter: installMsg.Submitter.String(),
+			Height:    ctx.BlockHeight(),
+			Size_:     int64(len(installMsg.Bundle)),
+			Status:    types.SubmittedBundleStatusPending,
+		})
+	}
+
 	action := installBundleAction{
-		MsgInstallBundle: msg,
+		MsgInstallBundle: installMsg,
 	}
+	return keeper.routeAction(ctx, msg, action)
+}
 
-	err = keeper.routeAction(ctx, msg, action)
-	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
+// BeginBundleUpload starts a chunked bundle upload.
+func (keeper msgServer) BeginBundleUpload(goCtx context.Context, msg *types.MsgBeginBundleUpload) (*types.MsgBeginBundleUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.Keeper.BeginBundleUpload(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgInstallBundleResponse{}, nil
+	return &types.MsgBeginBundleUploadResponse{}, nil
+}
+
+// UploadBundleChunk stores a chunk of a bundle upload, and installs the bundle
+// once the upload is complete.
+func (keeper msgServer) UploadBundleChunk(goCtx context.Context, msg *types.MsgUploadBundleChunk) (*types.MsgUploadBundleChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	installMsg, err := keeper.AddBundleUploadChunk(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if installMsg == nil {
+		return &types.MsgUploadBundleChunkResponse{Completed: false}, nil
+	}
+
+	err = keeper.installBundle(ctx, msg, installMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUploadBundleChunkResponse{Completed: true}, nil
+}
+
+// UpdateParams replaces the module params on behalf of the governance authority.
+func (keeper msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != keeper.GetAuthority() {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	keeper.SetParams(ctx, msg.Params)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddHighPrioritySender registers a high priority sender on behalf of the
+// governance authority.
+func (keeper msgServer) AddHighPrioritySender(goCtx context.Context, msg *types.MsgAddHighPrioritySender) (*types.MsgAddHighPrioritySenderResponse, error) {
+	if msg.Authority != keeper.GetAuthority() {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := keeper.GetHighPrioritySender(ctx, addr, msg.Namespace); found {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "namespace %q already has address %s", msg.Namespace, msg.Address)
+	}
+	keeper.SetHighPrioritySender(ctx, types.HighPrioritySender{
+		Address:     addr.String(),
+		Namespace:   msg.Namespace,
+		AddedHeight: ctx.BlockHeight(),
+		AddedTime:   ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHighPrioritySenderAdded,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyNamespace, msg.Namespace),
+		),
+	)
+	return &types.MsgAddHighPrioritySenderResponse{}, nil
+}
+
+// RemoveHighPrioritySender unregisters a high priority sender on behalf of the
+// governance authority.
+func (keeper msgServer) RemoveHighPrioritySender(goCtx context.Context, msg *types.MsgRemoveHighPrioritySender) (*types.MsgRemoveHighPrioritySenderResponse, error) {
+	if msg.Authority != keeper.GetAuthority() {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := keeper.GetHighPrioritySender(ctx, addr, msg.Namespace); !found {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrNotFound, "namespace %q does not have address %s", msg.Namespace, msg.Address)
+	}
+	keeper.DeleteHighPrioritySender(ctx, addr, msg.Namespace)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHighPrioritySenderRemoved,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyNamespace, msg.Namespace),
+		),
+	)
+	return &types.MsgRemoveHighPrioritySenderResponse{}, nil
 }
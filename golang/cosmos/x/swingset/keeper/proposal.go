@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,13 +13,33 @@ import (
 
 type coreEvalAction struct {
 	*vm.ActionHeader `actionType:"CORE_EVAL"`
-	Evals            []types.CoreEval `json:"evals"`
+	Evals            []coreEvalActionEval `json:"evals"`
+}
+
+// coreEvalActionEval carries the content hash of a core-eval, with which the
+// controller reports its outcome.
+type coreEvalActionEval struct {
+	types.CoreEval
+	ContentHash string `json:"content_hash"`
 }
 
 // CoreEvalProposal tells SwingSet to evaluate the given JS code.
 func (k Keeper) CoreEvalProposal(ctx sdk.Context, p *types.CoreEvalProposal) error {
 	action := coreEvalAction{
-		Evals: p.Evals,
+		Evals: make([]coreEvalActionEval, len(p.Evals)),
+	}
+	for i, eval := range p.Evals {
+		record := k.recordCoreEval(ctx, eval)
+		action.Evals[i] = coreEvalActionEval{CoreEval: eval, ContentHash: record.ContentHash}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCoreEval,
+				sdk.NewAttribute(types.AttributeKeyIndex, strconv.Itoa(i)),
+				sdk.NewAttribute(types.AttributeKeyContentHash, record.ContentHash),
+				sdk.NewAttribute(types.AttributeKeyPermitHash, record.PermitHash),
+				sdk.NewAttribute(types.AttributeKeyCodeHash, record.CodeHash),
+			),
+		)
 	}
 
 	// While the CoreEvalProposal was originally created by a transaction, by the time it
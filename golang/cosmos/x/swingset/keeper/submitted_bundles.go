@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// Bundles submitted to the controller are recorded by bundle ID, so that
+// identical resubmissions can be refused.  A record is written as pending when
+// the installation action is queued, marked installed when the controller
+// reports that it installed the bundle, and removed if the controller reports
+// that it failed, so that the bundle may be submitted again.  Bundles
+// installed before the records were introduced are not backfilled.
+const submittedBundleKeyPrefix = "submittedBundle."
+
+func (k Keeper) submittedBundleStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(submittedBundleKeyPrefix))
+}
+
+// GetSubmittedBundle returns the record of a submitted bundle, if any.
+func (k Keeper) GetSubmittedBundle(ctx sdk.Context, bundleID string) (types.SubmittedBundle, bool) {
+	bz := k.submittedBundleStore(ctx).Get([]byte(bundleID))
+	if bz == nil {
+		return types.SubmittedBundle{}, false
+	}
+	bundle := types.SubmittedBundle{}
+	k.cdc.MustUnmarshal(bz, &bundle)
+	return bundle, true
+}
+
+// SetSubmittedBundle stores the record of a submitted bundle.
+func (k Keeper) SetSubmittedBundle(ctx sdk.Context, bundle types.SubmittedBundle) {
+	k.submittedBundleStore(ctx).Set([]byte(bundle.BundleID), k.cdc.MustMarshal(&bundle))
+}
+
+// GetAllSubmittedBundles returns every submitted bundle record, ordered by
+// bundle ID.
+func (k Keeper) GetAllSubmittedBundles(ctx sdk.Context) []types.SubmittedBundle {
+	bundles := []types.SubmittedBundle{}
+	iterator := k.submittedBundleStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		bundle := types.SubmittedBundle{}
+		k.cdc.MustUnmarshal(iterator.Value(), &bundle)
+		bundles = append(bundles, bundle)
+	}
+	return bundles
+}
+
+// PaginateSubmittedBundles returns a page of submitted bundle records.
+func (k Keeper) PaginateSubmittedBundles(ctx sdk.Context, pageReq *query.PageRequest) ([]types.SubmittedBundle, *query.PageResponse, error) {
+	bundles := []types.SubmittedBundle{}
+	pageRes, err := query.Paginate(k.submittedBundleStore(ctx), pageReq, func(key []byte, value []byte) error {
+		bundle := types.SubmittedBundle{}
+		if err := k.cdc.Unmarshal(value, &bundle); err != nil {
+			return err
+		}
+		bundles = append(bundles, bundle)
+		return nil
+	})
+	return bundles, pageRes, err
+}
+
+// RecordBundleInstallOutcome records the outcome of installing a pending
+// bundle, as reported by the controller.  An empty errorMessage means that the
+// bundle was installed; otherwise its record is removed.  Outcomes of bundles
+// without a record, such as those without a verifiable ID, are ignored.
+func (k Keeper) RecordBundleInstallOutcome(ctx sdk.Context, bundleID, errorMessage string) error {
+	bundle, found := k.GetSubmittedBundle(ctx, bundleID)
+	if !found {
+		return nil
+	}
+	if bundle.Status != types.SubmittedBundleStatusPending {
+		return fmt.Errorf("bundle %s is not pending", bundleID)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyBundleID, bundleID),
+	}
+	if errorMessage == "" {
+		bundle.Status = types.SubmittedBundleStatusInstalled
+		k.SetSubmittedBundle(ctx, bundle)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyStatus, bundle.Status))
+	} else {
+		k.submittedBundleStore(ctx).Delete([]byte(bundleID))
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyStatus, "failed"),
+			sdk.NewAttribute(types.AttributeKeyError, errorMessage),
+		)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBundleInstallOutcome, attrs...))
+	return nil
+}
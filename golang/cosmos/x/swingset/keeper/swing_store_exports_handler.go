@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sync"
+	"time"
 
 	sdkioerrors "cosmossdk.io/errors"
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -48,6 +51,8 @@ import (
 //
 // There should be a single SwingStoreExportsHandler instance, and all its method
 // calls should be performed from the same goroutine (no mutex enforcement).
+// The only exception is GetSwingStoreOperationStatus, which may be called from
+// any goroutine.
 //
 // The process of generating a SwingStore export proceeds as follow:
 // - The component invokes swingStoreExportsHandler.InitiateExport with an
@@ -76,6 +81,15 @@ import (
 // SwingStoreExportProvider representing the swing-store export to
 // be restored, and RestoreExport will consume it and block until the JS side
 // has completed the restore before returning.
+//
+// InitiateExport takes a context.Context bounding the operation. If the
+// context is done before the export completes, pending requests to the JS side
+// are abandoned, an abort request is sent to the JS side so that it stops any
+// work in progress and cleans up partial exports, and the operation completes
+// with the context's error. This ensures a hung JS export cannot prevent all
+// future swing-store operations. RestoreExport also takes a context, but it
+// only bounds the preparation of the restore, since the JS side cannot
+// interrupt an import.
 
 // exportManifest represents the content of the JS swing-store export manifest.
 // The export is exchanged between Cosmos and JS using the file system, and only
@@ -147,6 +161,19 @@ type swingStoreDiscardExportAction struct {
 	Request string `json:"request"` // "discard"
 }
 
+// abortRequest is the request type for aborting an export operation in
+// progress, and cleaning up any partial export on the JS side
+const abortRequest = "abort"
+
+type swingStoreAbortExportAction struct {
+	Type    string `json:"type"`    // "SWING_STORE_EXPORT"
+	Request string `json:"request"` // "abort"
+}
+
+// swingStoreAbortTimeout bounds how long to wait for the JS side to
+// acknowledge an abort request, in case it is unresponsive.
+const swingStoreAbortTimeout = 1 * time.Minute
+
 // restoreRequest is the request type for restoring an export
 const restoreRequest = "restore"
 
@@ -234,6 +261,60 @@ type swingStoreImportOptions struct {
 	ExportDataMode string `json:"exportDataMode,omitempty"`
 }
 
+const (
+	// SwingStoreOperationPhaseInitiating means the JS side was requested to
+	// start an export, but has not yet confirmed it started.
+	SwingStoreOperationPhaseInitiating = "initiating"
+
+	// SwingStoreOperationPhaseStarted means the JS side has started generating
+	// the export, and the component performing the export has yet to retrieve it.
+	SwingStoreOperationPhaseStarted = "started"
+
+	// SwingStoreOperationPhaseRetrieving means the export was requested from the
+	// JS side, which has not yet completed generating it.
+	SwingStoreOperationPhaseRetrieving = "retrieving"
+
+	// SwingStoreOperationPhaseProcessing means the export was retrieved and is
+	// being consumed by the component performing the export.
+	SwingStoreOperationPhaseProcessing = "processing"
+
+	// SwingStoreOperationPhaseDiscarding means the JS side was requested to
+	// discard an export which was not retrieved.
+	SwingStoreOperationPhaseDiscarding = "discarding"
+
+	// SwingStoreOperationPhasePreparing means the export to restore is being
+	// written to disk before being handed to the JS side.
+	SwingStoreOperationPhasePreparing = "preparing"
+
+	// SwingStoreOperationPhaseRestoring means the JS side is importing the
+	// export being restored.
+	SwingStoreOperationPhaseRestoring = "restoring"
+
+	// SwingStoreOperationPhaseAborting means the operation's context is done,
+	// and the JS side was requested to abort the operation.
+	SwingStoreOperationPhaseAborting = "aborting"
+
+	// SwingStoreOperationPhaseDone means the operation has completed.
+	SwingStoreOperationPhaseDone = "done"
+)
+
+// SwingStoreOperationStatus describes a swing-store export or restore
+// operation in progress, as reported by GetSwingStoreOperationStatus.
+type SwingStoreOperationStatus struct {
+	// IsRestore indicates whether the operation is a restore instead of an export.
+	IsRestore bool
+	// BlockHeight is the block height of the operation, or 0 for an export of
+	// the latest height.
+	BlockHeight uint64
+	// Phase is the current phase of the operation. Any SwingStoreOperationPhase*
+	// const value except SwingStoreOperationPhaseDone can be reported.
+	Phase string
+	// StartTime is the time at which the operation was initiated.
+	StartTime time.Time
+	// Elapsed is the time elapsed since the operation was initiated.
+	Elapsed time.Duration
+}
+
 var disallowedArtifactNameChar = regexp.MustCompile(`[^-_.a-zA-Z0-9]`)
 
 // sanitizeArtifactName searches a string for all characters
@@ -274,6 +355,30 @@ type operationDetails struct {
 	// writes into the channel and closes it. The main goroutine reads from the
 	// channel.
 	exportDone chan error
+	// startTime is the time at which the operation was initiated.
+	// It is assigned at creation and never mutated.
+	startTime time.Time
+	// phaseMutex guards phase, which may be read from any goroutine through
+	// GetSwingStoreOperationStatus.
+	phaseMutex sync.Mutex
+	// phase is the current phase of the operation, one of the
+	// SwingStoreOperationPhase* const values.
+	// It is written by the goroutine performing the operation.
+	phase string
+}
+
+// setPhase records the current phase of the operation.
+func (operationDetails *operationDetails) setPhase(phase string) {
+	operationDetails.phaseMutex.Lock()
+	defer operationDetails.phaseMutex.Unlock()
+	operationDetails.phase = phase
+}
+
+// getPhase returns the current phase of the operation.
+func (operationDetails *operationDetails) getPhase() string {
+	operationDetails.phaseMutex.Lock()
+	defer operationDetails.phaseMutex.Unlock()
+	return operationDetails.phase
 }
 
 // activeOperation is a global variable reflecting a swing-store import or
@@ -290,6 +395,46 @@ type operationDetails struct {
 // accessed.
 var activeOperation *operationDetails
 
+// activeOperationMutex guards the assignments of activeOperation, and the
+// reads of it not performed by the main goroutine.
+var activeOperationMutex sync.Mutex
+
+// setActiveOperation assigns activeOperation.
+//
+// Must be called by the main goroutine
+func setActiveOperation(operationDetails *operationDetails) {
+	activeOperationMutex.Lock()
+	defer activeOperationMutex.Unlock()
+	activeOperation = operationDetails
+}
+
+// GetSwingStoreOperationStatus reports the swing-store export or restore
+// operation in progress, including its current phase and elapsed time, or nil
+// if no operation is in progress.
+//
+// May be called from any goroutine
+func GetSwingStoreOperationStatus() *SwingStoreOperationStatus {
+	activeOperationMutex.Lock()
+	operationDetails := activeOperation
+	activeOperationMutex.Unlock()
+
+	if operationDetails == nil {
+		return nil
+	}
+	phase := operationDetails.getPhase()
+	if phase == SwingStoreOperationPhaseDone {
+		return nil
+	}
+
+	return &SwingStoreOperationStatus{
+		IsRestore:   operationDetails.isRestore,
+		BlockHeight: operationDetails.blockHeight,
+		Phase:       phase,
+		StartTime:   operationDetails.startTime,
+		Elapsed:     time.Since(operationDetails.startTime),
+	}
+}
+
 // WaitUntilSwingStoreExportStarted synchronizes with an export operation in
 // progress, if any.
 // The JS swing-store export must have started before a new block is committed
@@ -318,7 +463,7 @@ func WaitUntilSwingStoreExportStarted() error {
 	select {
 	case <-operationDetails.exportDone:
 		// If there was a start error, the channel is already closed at this point.
-		activeOperation = nil
+		setActiveOperation(nil)
 	default:
 		// don't wait for it to finish
 		// If there is no start error, the operation may take an arbitrary amount
@@ -356,7 +501,7 @@ func WaitUntilSwingStoreExportDone() error {
 	// and closes the channel once the export has completed or failed.
 	// Only the first call after an export was initiated will report an error.
 	exportErr := <-operationDetails.exportDone
-	activeOperation = nil
+	setActiveOperation(nil)
 
 	return exportErr
 }
@@ -370,7 +515,7 @@ func checkNotActive() error {
 		select {
 		case <-operationDetails.exportDone:
 			// nil-out any stale operation
-			activeOperation = nil
+			setActiveOperation(nil)
 		default:
 			if operationDetails.isRestore {
 				return fmt.Errorf("restore operation already in progress for height %d", operationDetails.blockHeight)
@@ -458,6 +603,62 @@ func NewSwingStoreExportsHandler(logger log.Logger, blockingSend func(action vm.
 	}
 }
 
+// sendWithContext performs a blockingSend which is abandoned if ctx is done
+// before the JS side replies. In that case ctx's error is returned, and the JS
+// side may still be processing the action, so onAbandonedResult (if not nil) is
+// eventually invoked from another goroutine with the result of the abandoned
+// blockingSend, to clean up after it.
+func (exportsHandler SwingStoreExportsHandler) sendWithContext(ctx context.Context, action vm.Jsonable, mustNotBeInited bool, onAbandonedResult func(out string, err error)) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if ctx.Done() == nil {
+		// The context can never be done, avoid spawning a goroutine.
+		return exportsHandler.blockingSend(action, mustNotBeInited)
+	}
+
+	type sendResult struct {
+		out string
+		err error
+	}
+	resultCh := make(chan sendResult, 1)
+	go func() {
+		out, err := exportsHandler.blockingSend(action, mustNotBeInited)
+		resultCh <- sendResult{out: out, err: err}
+	}()
+
+	select {
+	case result := <-resultCh:
+		return result.out, result.err
+	case <-ctx.Done():
+		if onAbandonedResult != nil {
+			go func() {
+				result := <-resultCh
+				onAbandonedResult(result.out, result.err)
+			}()
+		}
+		return "", ctx.Err()
+	}
+}
+
+// abortOperation requests the JS side to abort the swing-store operation in
+// progress and to clean up any partial export it generated. The request is
+// itself bounded by swingStoreAbortTimeout in case the JS side is unresponsive.
+func (exportsHandler SwingStoreExportsHandler) abortOperation(operationDetails *operationDetails, cause error) error {
+	operationDetails.setPhase(SwingStoreOperationPhaseAborting)
+	operationDetails.logger.Info("aborting swing-store operation", "cause", cause, "elapsed", time.Since(operationDetails.startTime))
+
+	abortCtx, cancel := context.WithTimeout(context.Background(), swingStoreAbortTimeout)
+	defer cancel()
+
+	abortAction := &swingStoreAbortExportAction{
+		Type:    swingStoreExportActionType,
+		Request: abortRequest,
+	}
+	_, err := exportsHandler.sendWithContext(abortCtx, abortAction, false, nil)
+	return err
+}
+
 // InitiateExport synchronously verifies that there is not already an export or
 // import operation in progress and initiates a new export in a goroutine,
 // via a dedicated SWING_STORE_EXPORT blockingSend action independent of other
@@ -471,8 +672,12 @@ func NewSwingStoreExportsHandler(logger log.Logger, blockingSend func(action vm.
 // WaitUntilSwingStoreExportStarted and WaitUntilSwingStoreExportDone methods
 // from the goroutine that initiated the export.
 //
+// If ctx is done before the export operation completes, the export is aborted
+// and the operation completes with ctx's error. The operation stops watching
+// ctx once it is done, so the caller may safely cancel ctx after that.
+//
 // Must be called by the main goroutine
-func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64, eventHandler SwingStoreExportEventHandler, exportOptions SwingStoreExportOptions) error {
+func (exportsHandler SwingStoreExportsHandler) InitiateExport(ctx context.Context, blockHeight uint64, eventHandler SwingStoreExportEventHandler, exportOptions SwingStoreExportOptions) error {
 	err := checkNotActive()
 	if err != nil {
 		return err
@@ -494,19 +699,54 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		exportStartedResult: make(chan error, 1),
 		exportRetrieved:     false,
 		exportDone:          make(chan error, 1),
+		startTime:           time.Now(),
+		phase:               SwingStoreOperationPhaseInitiating,
 	}
-	activeOperation = operationDetails
+	setActiveOperation(operationDetails)
 
 	go func() {
 		var err error
 		var startedErr error
+
+		// Watch for ctx being done before the export operation completes, in which
+		// case the JS side must be told to abort the export.
+		watchCtx, stopWatching := context.WithCancel(ctx)
+		watcherDone := make(chan struct{})
+		var abortErr error
+		go func() {
+			defer close(watcherDone)
+			<-watchCtx.Done()
+			if ctx.Err() == nil {
+				// The export operation completed
+				return
+			}
+			abortErr = exportsHandler.abortOperation(operationDetails, ctx.Err())
+		}()
+
 		defer func() {
+			// Wait for any abort request to complete before indicating the export
+			// is no longer in progress, so that it cannot affect a later operation.
+			stopWatching()
+			<-watcherDone
+
 			if err == nil {
 				err = startedErr
 			}
+			if abortErr != nil {
+				logger.Error("failed to abort swing-store export", "err", abortErr)
+				if err == nil {
+					err = abortErr
+				} else {
+					// Safe to wrap error and use detailed error info since this error
+					// will not go back into swingset layers
+					err = sdkioerrors.Wrapf(err, "failed to abort swing-store export: %+v", abortErr)
+				}
+			}
 			if err != nil {
 				operationDetails.exportDone <- err
 			}
+			operationDetails.setPhase(SwingStoreOperationPhaseDone)
+			logger.Debug("swing-store export operation done", "elapsed", time.Since(operationDetails.startTime))
 			// First, indicate an export is no longer in progress. This ensures that
 			// for an operation with a start error, a call to WaitUntilSwingStoreExportStarted
 			// waiting on exportStartedResult will always find the operation has
@@ -530,7 +770,7 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		}
 
 		// blockingSend for SWING_STORE_EXPORT action is safe to call from a goroutine
-		_, startedErr = exportsHandler.blockingSend(initiateAction, false)
+		_, startedErr = exportsHandler.sendWithContext(ctx, initiateAction, false, nil)
 
 		if startedErr != nil {
 			logger.Error("failed to initiate swing-store export", "err", startedErr)
@@ -541,6 +781,7 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 
 		// Signal that the export operation has started successfully in the goroutine.
 		// Calls to WaitUntilSwingStoreExportStarted will no longer block.
+		operationDetails.setPhase(SwingStoreOperationPhaseStarted)
 		close(operationDetails.exportStartedResult)
 
 		// The user provided OnExportStarted function should call retrieveExport()
@@ -552,7 +793,7 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 				return errors.New("export operation no longer active")
 			}
 
-			retrieveErr = exportsHandler.retrieveExport(eventHandler.OnExportRetrieved)
+			retrieveErr = exportsHandler.retrieveExport(ctx, eventHandler.OnExportRetrieved)
 
 			return retrieveErr
 		})
@@ -573,11 +814,20 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 		// Discarding the export so invalidate retrieveExport
 		operationDetails.exportRetrieved = true
 
+		if ctx.Err() != nil {
+			// The abort request sent by the watcher also discards the export.
+			if err == nil {
+				err = ctx.Err()
+			}
+			return
+		}
+
+		operationDetails.setPhase(SwingStoreOperationPhaseDiscarding)
 		discardAction := &swingStoreDiscardExportAction{
 			Type:    swingStoreExportActionType,
 			Request: discardRequest,
 		}
-		_, discardErr := exportsHandler.blockingSend(discardAction, false)
+		_, discardErr := exportsHandler.sendWithContext(ctx, discardAction, false, nil)
 
 		if discardErr != nil {
 			logger.Error("failed to discard swing-store export", "err", err)
@@ -606,11 +856,12 @@ func (exportsHandler SwingStoreExportsHandler) InitiateExport(blockHeight uint64
 // The export manifest format is described by the exportManifest struct.
 //
 // After calling onExportRetrieved, the export directory and its contents are
-// deleted.
+// deleted. If ctx is done before the export is ready, the export directory
+// eventually produced by the JS side is deleted when received.
 //
 // This will block until the export is ready. Internally invoked by the
 // InitiateExport logic in the export operation's goroutine.
-func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved func(provider SwingStoreExportProvider) error) (err error) {
+func (exportsHandler SwingStoreExportsHandler) retrieveExport(ctx context.Context, onExportRetrieved func(provider SwingStoreExportProvider) error) (err error) {
 	operationDetails := activeOperation
 	if operationDetails == nil {
 		// shouldn't happen, but return an error if it does
@@ -623,12 +874,19 @@ func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved
 		Type:    swingStoreExportActionType,
 		Request: retrieveRequest,
 	}
-	out, err := exportsHandler.blockingSend(action, false)
+	operationDetails.setPhase(SwingStoreOperationPhaseRetrieving)
+	out, err := exportsHandler.sendWithContext(ctx, action, false, func(out string, err error) {
+		var exportDir swingStoreRetrieveResult
+		if err == nil && json.Unmarshal([]byte(out), &exportDir) == nil && exportDir != "" {
+			os.RemoveAll(exportDir)
+		}
+	})
 
 	if err != nil {
 		return err
 	}
 	operationDetails.exportRetrieved = true
+	operationDetails.setPhase(SwingStoreOperationPhaseProcessing)
 
 	var exportDir swingStoreRetrieveResult
 	err = json.Unmarshal([]byte(out), &exportDir)
@@ -647,6 +905,9 @@ func (exportsHandler SwingStoreExportsHandler) retrieveExport(onExportRetrieved
 		return fmt.Errorf("export manifest blockHeight (%d) doesn't match (%d)", provider.BlockHeight, blockHeight)
 	}
 
+	// Stop the consumption of the export if ctx is done.
+	provider.ReadNextArtifact = readNextArtifactWithContext(ctx, provider.ReadNextArtifact)
+
 	err = onExportRetrieved(provider)
 	if err != nil {
 		return err
@@ -715,10 +976,26 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 	return SwingStoreExportProvider{BlockHeight: manifest.BlockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact}, nil
 }
 
+// readNextArtifactWithContext wraps a SwingStoreExportProvider's
+// ReadNextArtifact function to fail with ctx's error once ctx is done.
+func readNextArtifactWithContext(ctx context.Context, readNextArtifact func() (types.SwingStoreArtifact, error)) func() (types.SwingStoreArtifact, error) {
+	return func() (types.SwingStoreArtifact, error) {
+		if err := ctx.Err(); err != nil {
+			return types.SwingStoreArtifact{}, err
+		}
+		return readNextArtifact()
+	}
+}
+
 // RestoreExport restores the JS swing-store using previously exported data and artifacts.
 //
+// ctx only bounds the reading of the provider's artifacts into the export
+// directory for the restore. Once the JS side is requested to restore from
+// that directory, it cannot be interrupted, so the restore is awaited even if
+// ctx is done, and only then are the directory and the operation released.
+//
 // Must be called by the main goroutine
-func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStoreExportProvider, restoreOptions SwingStoreRestoreOptions) error {
+func (exportsHandler SwingStoreExportsHandler) RestoreExport(ctx context.Context, provider SwingStoreExportProvider, restoreOptions SwingStoreRestoreOptions) error {
 	err := checkNotActive()
 	if err != nil {
 		return err
@@ -739,10 +1016,12 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 		// exportsHandler.InitiateExport will error when calling checkNotActive.
 		exportStartedResult: nil,
 		exportDone:          nil,
+		startTime:           time.Now(),
+		phase:               SwingStoreOperationPhasePreparing,
 	}
-	activeOperation = operationDetails
+	setActiveOperation(operationDetails)
 	defer func() {
-		activeOperation = nil
+		setActiveOperation(nil)
 	}()
 
 	exportDir, err := os.MkdirTemp("", fmt.Sprintf("agd-swing-store-restore-%d-*", blockHeight))
@@ -753,6 +1032,7 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 
 	exportsHandler.logger.Info("creating swing-store restore", "exportDir", exportDir, "height", blockHeight)
 
+	provider.ReadNextArtifact = readNextArtifactWithContext(ctx, provider.ReadNextArtifact)
 	err = WriteSwingStoreExportToDirectory(provider, exportDir)
 	if err != nil {
 		return err
@@ -771,12 +1051,13 @@ func (exportsHandler SwingStoreExportsHandler) RestoreExport(provider SwingStore
 		}},
 	}
 
+	operationDetails.setPhase(SwingStoreOperationPhaseRestoring)
 	_, err = exportsHandler.blockingSend(action, true)
 	if err != nil {
 		return err
 	}
 
-	exportsHandler.logger.Info("restored swing-store", "exportDir", exportDir, "height", blockHeight)
+	exportsHandler.logger.Info("restored swing-store", "exportDir", exportDir, "height", blockHeight, "elapsed", time.Since(operationDetails.startTime))
 
 	return nil
 }
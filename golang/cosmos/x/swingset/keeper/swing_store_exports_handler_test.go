@@ -1,11 +1,15 @@
 package keeper
 
 import (
+	"context"
 	"errors"
 	"io"
+	"os"
 	"testing"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -58,7 +62,7 @@ func TestSwingStoreSnapshotterInProgress(t *testing.T) {
 		<-ch
 		return nil
 	}
-	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
+	err := exportsHandler.InitiateExport(context.Background(), 123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -67,12 +71,12 @@ func TestSwingStoreSnapshotterInProgress(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = exportsHandler.InitiateExport(456, newTestSwingStoreEventHandler(), SwingStoreExportOptions{})
+	err = exportsHandler.InitiateExport(context.Background(), 456, newTestSwingStoreEventHandler(), SwingStoreExportOptions{})
 	if err == nil {
 		t.Error("wanted error for export operation in progress")
 	}
 
-	err = exportsHandler.RestoreExport(SwingStoreExportProvider{BlockHeight: 456}, SwingStoreRestoreOptions{})
+	err = exportsHandler.RestoreExport(context.Background(), SwingStoreExportProvider{BlockHeight: 456}, SwingStoreRestoreOptions{})
 	if err == nil {
 		t.Error("wanted error for export operation in progress")
 	}
@@ -82,7 +86,7 @@ func TestSwingStoreSnapshotterInProgress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = exportsHandler.InitiateExport(456, exportEventHandler, SwingStoreExportOptions{})
+	err = exportsHandler.InitiateExport(context.Background(), 456, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -108,7 +112,7 @@ func TestSwingStoreSnapshotterSecondCommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
+	err = exportsHandler.InitiateExport(context.Background(), 123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -138,7 +142,7 @@ func TestSwingStoreSnapshotterInitiateFails(t *testing.T) {
 		return "", nil
 	}
 
-	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
+	err := exportsHandler.InitiateExport(context.Background(), 123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -180,7 +184,7 @@ func TestSwingStoreSnapshotterRetrievalFails(t *testing.T) {
 		return savedErr
 	}
 
-	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
+	err := exportsHandler.InitiateExport(context.Background(), 123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -216,7 +220,7 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 		activeOperation.exportRetrieved = true
 		return nil
 	}
-	err := exportsHandler.InitiateExport(123, exportEventHandler, SwingStoreExportOptions{})
+	err := exportsHandler.InitiateExport(context.Background(), 123, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -233,7 +237,7 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 	exportEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
 		return nil
 	}
-	err = exportsHandler.InitiateExport(456, exportEventHandler, SwingStoreExportOptions{})
+	err = exportsHandler.InitiateExport(context.Background(), 456, exportEventHandler, SwingStoreExportOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -245,3 +249,194 @@ func TestSwingStoreSnapshotterDiscard(t *testing.T) {
 		t.Error("wanted discard called")
 	}
 }
+
+func TestSwingStoreSnapshotterCancel(t *testing.T) {
+	exportsHandler := newTestSwingStoreExportsHandler()
+	aborted := make(chan struct{})
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		switch action := action.(type) {
+		case *swingStoreRetrieveExportAction:
+			// Simulate a hung JS export which only completes once aborted.
+			<-aborted
+			return "", errors.New("export aborted")
+		case *swingStoreAbortExportAction:
+			if action.Request != "abort" {
+				t.Errorf(`wanted "abort" request, got "%s"`, action.Request)
+			}
+			close(aborted)
+		case *swingStoreDiscardExportAction:
+			t.Error("didn't want discard called")
+		}
+		return "", nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := exportsHandler.InitiateExport(ctx, 123, newTestSwingStoreEventHandler(), SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WaitUntilSwingStoreExportStarted()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := GetSwingStoreOperationStatus()
+	if status == nil {
+		t.Fatal("wanted status for export operation in progress")
+	}
+	if status.IsRestore || status.BlockHeight != 123 {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	cancel()
+	err = WaitUntilSwingStoreExportDone()
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf(`wanted context canceled error, got "%v"`, err)
+	}
+	select {
+	case <-aborted:
+	default:
+		t.Error("wanted abort called")
+	}
+	if status := GetSwingStoreOperationStatus(); status != nil {
+		t.Errorf("wanted no status after export done, got %+v", status)
+	}
+
+	// A new export can be initiated
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) { return "", nil }
+	err = exportsHandler.InitiateExport(context.Background(), 456, newTestSwingStoreEventHandler(), SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WaitUntilSwingStoreExportDone()
+	if err == nil {
+		// The test blockingSend doesn't return an export directory
+		t.Error("wanted retrieval error")
+	}
+}
+
+func TestSwingStoreSnapshotterStatus(t *testing.T) {
+	exportsHandler := newTestSwingStoreExportsHandler()
+	if status := GetSwingStoreOperationStatus(); status != nil {
+		t.Errorf("wanted no status, got %+v", status)
+	}
+
+	ch := make(chan struct{})
+	phases := make(chan string, 1)
+	exportEventHandler := newTestSwingStoreEventHandler()
+	exportEventHandler.onExportStarted = func(height uint64, retrieveExport func() error) error {
+		phases <- GetSwingStoreOperationStatus().Phase
+		<-ch
+		return nil
+	}
+	err := exportsHandler.InitiateExport(context.Background(), 123, exportEventHandler, SwingStoreExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phase := <-phases; phase != SwingStoreOperationPhaseStarted {
+		t.Errorf(`wanted phase "%s", got "%s"`, SwingStoreOperationPhaseStarted, phase)
+	}
+	status := GetSwingStoreOperationStatus()
+	if status == nil || status.Elapsed < 0 || status.StartTime.IsZero() {
+		t.Errorf("unexpected status %+v", status)
+	}
+	res, err := NodeQuerier{}.SwingStoreExportStatus(context.Background(), &types.SwingStoreExportStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Active || res.IsRestore || res.BlockHeight != 123 || res.Phase != SwingStoreOperationPhaseStarted {
+		t.Errorf("unexpected status query response %+v", res)
+	}
+
+	close(ch)
+	err = WaitUntilSwingStoreExportDone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := GetSwingStoreOperationStatus(); status != nil {
+		t.Errorf("wanted no status after export done, got %+v", status)
+	}
+	res, err = NodeQuerier{}.SwingStoreExportStatus(context.Background(), &types.SwingStoreExportStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Active {
+		t.Errorf("wanted inactive status query response, got %+v", res)
+	}
+}
+
+func TestSwingStoreRestoreCancel(t *testing.T) {
+	exportsHandler := newTestSwingStoreExportsHandler()
+	restoreCalled := false
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		if _, ok := action.(*swingStoreRestoreExportAction); ok {
+			restoreCalled = true
+		}
+		return "", nil
+	}
+
+	// Canceling while the artifacts are read stops the restore before the JS
+	// side is involved.
+	ctx, cancel := context.WithCancel(context.Background())
+	err := exportsHandler.RestoreExport(
+		ctx,
+		SwingStoreExportProvider{
+			BlockHeight:         123,
+			GetExportDataReader: func() (agoric.KVEntryReader, error) { return nil, nil },
+			ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+				cancel()
+				return types.SwingStoreArtifact{Name: "foo", Data: []byte("bar")}, nil
+			},
+		},
+		SwingStoreRestoreOptions{},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf(`wanted context canceled error, got "%v"`, err)
+	}
+	if restoreCalled {
+		t.Error("didn't want restore called")
+	}
+	if status := GetSwingStoreOperationStatus(); status != nil {
+		t.Errorf("wanted no status after restore done, got %+v", status)
+	}
+}
+
+func TestSwingStoreRestoreNotInterrupted(t *testing.T) {
+	exportsHandler := newTestSwingStoreExportsHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	var exportDir string
+	exportsHandler.blockingSend = func(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+		switch action := action.(type) {
+		case *swingStoreRestoreExportAction:
+			exportDir = action.Args[0].ExportDir
+			cancel()
+			// The JS import still reads the export directory, and no other
+			// operation may start.
+			if _, err := os.Stat(exportDir); err != nil {
+				t.Errorf("wanted export directory %s during restore, got %v", exportDir, err)
+			}
+			if err := exportsHandler.InitiateExport(context.Background(), 456, newTestSwingStoreEventHandler(), SwingStoreExportOptions{}); err == nil {
+				t.Error("wanted error initiating an export during restore")
+			}
+		case *swingStoreAbortExportAction:
+			t.Error("didn't want abort called")
+		}
+		return "", nil
+	}
+
+	err := exportsHandler.RestoreExport(
+		ctx,
+		SwingStoreExportProvider{
+			BlockHeight:         123,
+			GetExportDataReader: func() (agoric.KVEntryReader, error) { return nil, nil },
+			ReadNextArtifact:    func() (types.SwingStoreArtifact, error) { return types.SwingStoreArtifact{}, io.EOF },
+		},
+		SwingStoreRestoreOptions{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(exportDir); !os.IsNotExist(err) {
+		t.Errorf("wanted export directory %s removed, got %v", exportDir, err)
+	}
+}
@@ -24,8 +24,25 @@ type swingsetMessage struct {
 
 const (
 	SwingStoreUpdateExportData = "swingStoreUpdateExportData"
+	CoreEvalOutcome            = "coreEvalOutcome"
+	BundleInstallOutcome       = "bundleInstallOutcome"
 )
 
+// coreEvalOutcome is the outcome of a core-eval reported by the controller.
+type coreEvalOutcome struct {
+	ContentHash string `json:"content_hash"`
+	// Error is empty if the evaluation succeeded.
+	Error string `json:"error"`
+}
+
+// bundleInstallOutcome is the outcome of installing a bundle reported by the
+// controller.
+type bundleInstallOutcome struct {
+	BundleID string `json:"bundle_id"`
+	// Error is empty if the bundle was installed.
+	Error string `json:"error"`
+}
+
 // NewPortHandler returns a port handler for a swingset Keeper.
 func NewPortHandler(k Keeper) vm.PortHandler {
 	return portHandler{keeper: k}
@@ -46,6 +63,12 @@ func (ph portHandler) Receive(cctx context.Context, str string) (string, error)
 	case SwingStoreUpdateExportData:
 		return ph.handleSwingStoreUpdateExportData(ctx, msg.Args)
 
+	case CoreEvalOutcome:
+		return ph.handleCoreEvalOutcome(ctx, msg.Args)
+
+	case BundleInstallOutcome:
+		return ph.handleBundleInstallOutcome(ctx, msg.Args)
+
 	default:
 		return "", fmt.Errorf("unrecognized swingset method %s", msg.Method)
 	}
@@ -71,3 +94,31 @@ func (ph portHandler) handleSwingStoreUpdateExportData(ctx sdk.Context, entries
 		}
 	}
 }
+
+func (ph portHandler) handleCoreEvalOutcome(ctx sdk.Context, args []json.RawMessage) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%s requires 1 argument, got %d", CoreEvalOutcome, len(args))
+	}
+	var outcome coreEvalOutcome
+	if err := json.Unmarshal(args[0], &outcome); err != nil {
+		return "", err
+	}
+	if err := ph.keeper.RecordCoreEvalOutcome(ctx, outcome.ContentHash, outcome.Error); err != nil {
+		return "", err
+	}
+	return "true", nil
+}
+
+func (ph portHandler) handleBundleInstallOutcome(ctx sdk.Context, args []json.RawMessage) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%s requires 1 argument, got %d", BundleInstallOutcome, len(args))
+	}
+	var outcome bundleInstallOutcome
+	if err := json.Unmarshal(args[0], &outcome); err != nil {
+		return "", err
+	}
+	if err := ph.keeper.RecordBundleInstallOutcome(ctx, outcome.BundleID, outcome.Error); err != nil {
+		return "", err
+	}
+	return "true", nil
+}
@@ -0,0 +1,126 @@
+package swingset
+
+import (
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func makeTestKit() (Keeper, sdk.Context) {
+	encodingConfig := params.MakeEncodingConfig()
+	cdc := encodingConfig.Marshaler
+
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	pk := paramskeeper.NewKeeper(cdc, encodingConfig.Amino, paramsStoreKey, paramsTStoreKey)
+
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	vstorageKeeper := vstoragekeeper.NewKeeper(vstorageStoreKey)
+
+	swingsetStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	swingsetTStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	keeper := NewKeeper(cdc, swingsetStoreKey, swingsetTStoreKey, pk.Subspace(types.ModuleName), nil, bankkeeper.BaseKeeper{}, vstorageKeeper, "feeCollectorName", nil, govAuthority)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(swingsetTStoreKey, storetypes.StoreTypeTransient, db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+	return keeper, ctx
+}
+
+// TestCoreEvalOutcome delivers outcomes in the form sent by bridgeCoreEval in
+// packages/vats/src/core/chain-behaviors.js.
+func TestCoreEvalOutcome(t *testing.T) {
+	keeper, ctx := makeTestKit()
+	ctx = ctx.WithBlockHeight(10)
+	handler := NewPortHandler(keeper)
+
+	ok := types.CoreEval{JsonPermits: "true", JsCode: "() => {}"}
+	boom := types.CoreEval{JsonPermits: "true", JsCode: "() => { throw Error('boom'); }"}
+	proposal := types.NewCoreEvalProposal("title", "description", []types.CoreEval{ok, boom}).(*types.CoreEvalProposal)
+	if err := keeper.CoreEvalProposal(ctx, proposal); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	ctx = ctx.WithBlockHeight(11)
+	for _, msg := range []string{
+		`{"method":"coreEvalOutcome","args":[{"content_hash":"` + ok.ContentHash() + `","error":""}]}`,
+		`{"method":"coreEvalOutcome","args":[{"content_hash":"` + boom.ContentHash() + `","error":"Error: boom"}]}`,
+	} {
+		ret, err := handler.Receive(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if ret != "true" {
+			t.Errorf("got %s, want true", ret)
+		}
+	}
+
+	if got, _ := keeper.GetCoreEvalRecord(ctx, ok.ContentHash()); got.Status != types.CoreEvalStatusSucceeded || got.OutcomeHeight != 11 {
+		t.Errorf("got record %v, want succeeded at height 11", got)
+	}
+	if got, _ := keeper.GetCoreEvalRecord(ctx, boom.ContentHash()); got.Status != types.CoreEvalStatusFailed || got.Error != "Error: boom" {
+		t.Errorf("got record %v, want failed with Error: boom", got)
+	}
+
+	// A repeated outcome is refused, and bridgeCoreEval logs the error.
+	msg := `{"method":"coreEvalOutcome","args":[{"content_hash":"` + ok.ContentHash() + `","error":""}]}`
+	if _, err := handler.Receive(sdk.WrapSDKContext(ctx), msg); err == nil {
+		t.Errorf("expected error for a repeated outcome")
+	}
+}
+
+// TestBundleInstallOutcome delivers outcomes in the form sent by installBundle
+// in packages/cosmic-swingset/src/launch-chain.js.
+func TestBundleInstallOutcome(t *testing.T) {
+	keeper, ctx := makeTestKit()
+	handler := NewPortHandler(keeper)
+	keeper.SetSubmittedBundle(ctx, types.SubmittedBundle{BundleID: "b1-ok", Status: types.SubmittedBundleStatusPending})
+	keeper.SetSubmittedBundle(ctx, types.SubmittedBundle{BundleID: "b1-boom", Status: types.SubmittedBundleStatusPending})
+
+	for _, msg := range []string{
+		`{"method":"bundleInstallOutcome","args":[{"bundle_id":"b1-ok","error":""}]}`,
+		`{"method":"bundleInstallOutcome","args":[{"bundle_id":"b1-boom","error":"Error: boom"}]}`,
+		`{"method":"bundleInstallOutcome","args":[{"bundle_id":"b1-unrecorded","error":""}]}`,
+	} {
+		ret, err := handler.Receive(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if ret != "true" {
+			t.Errorf("got %s, want true", ret)
+		}
+	}
+
+	if got, _ := keeper.GetSubmittedBundle(ctx, "b1-ok"); got.Status != types.SubmittedBundleStatusInstalled {
+		t.Errorf("got bundle %v, want installed", got)
+	}
+	if got, found := keeper.GetSubmittedBundle(ctx, "b1-boom"); found {
+		t.Errorf("got bundle %v, want none after a failed installation", got)
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle"
+)
+
+const (
+	// BundleIDPrefix prefixes the hash of an endoZipBase64 bundle to form its
+	// bundle ID.
+	BundleIDPrefix = bundle.IDPrefix
+
+	// BundleIDGasPerByte is the gas consumed per byte of uncompressed bundle
+	// JSON to uncompress and validate a bundle for its ID, priced as a store
+	// read of the same size.
+	BundleIDGasPerByte uint64 = 3
+
+	// The statuses of a SubmittedBundle.
+	SubmittedBundleStatusPending   = "pending"
+	SubmittedBundleStatusInstalled = "installed"
+)
+
+// ConsumeBundleIDGas consumes the gas for computing the ID of a bundle of
+// uncompressedSize bytes, before the work is done.
+func ConsumeBundleIDGas(ctx sdk.Context, uncompressedSize uint64) {
+	ctx.GasMeter().ConsumeGas(BundleIDGasPerByte*uncompressedSize, "bundle ID")
+}
+
+// BundleID returns the ID of an endoZipBase64 bundle.  It returns false if
+// the bundle is not in that format or fails validation, in which case the
+// controller will reject it.
+func BundleID(bundleJSON string) (string, bool) {
+	id, err := bundle.ValidateJSON([]byte(bundleJSON))
+	if err != nil {
+		return "", false
+	}
+	return id, true
+}
@@ -33,6 +33,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgProvision{}, ModuleName+"/Provision", nil)
 	cdc.RegisterConcrete(&MsgWalletAction{}, ModuleName+"/WalletAction", nil)
 	cdc.RegisterConcrete(&MsgWalletSpendAction{}, ModuleName+"/WalletSpendAction", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddHighPrioritySender{}, ModuleName+"/AddHighPrioritySender", nil)
+	cdc.RegisterConcrete(&MsgRemoveHighPrioritySender{}, ModuleName+"/RemoveHighPrioritySender", nil)
+	cdc.RegisterConcrete(&MsgBeginBundleUpload{}, ModuleName+"/BeginBundleUpload", nil)
+	cdc.RegisterConcrete(&MsgUploadBundleChunk{}, ModuleName+"/UploadBundleChunk", nil)
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
@@ -42,6 +47,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgProvision{},
 		&MsgWalletAction{},
 		&MsgWalletSpendAction{},
+		&MsgUpdateParams{},
+		&MsgAddHighPrioritySender{},
+		&MsgRemoveHighPrioritySender{},
+		&MsgBeginBundleUpload{},
+		&MsgUploadBundleChunk{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
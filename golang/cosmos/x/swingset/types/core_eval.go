@@ -0,0 +1,159 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// Statuses of a CoreEvalRecord.
+const (
+	CoreEvalStatusPending   = "pending"
+	CoreEvalStatusSucceeded = "succeeded"
+	CoreEvalStatusFailed    = "failed"
+)
+
+var coreEvalHashRE = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// coreEvalPowerNames are the powers that a core-eval permit may name at its
+// top level.  They are the properties of allPowers in
+// packages/vats/src/core/lib-boot.js, including the well-known name spaces of
+// agoricNamesReserved in packages/vats/src/core/utils.js, together with the
+// evaluateBundleCap added by bridgeCoreEval and the home that extractPowers
+// ignores.  The names within each power, such as those of consume, are
+// promise spaces that accept any name, so they are not checked.
+var coreEvalPowerNames = map[string]bool{
+	"brand":             true,
+	"consume":           true,
+	"devices":           true,
+	"evaluateBundleCap": true,
+	"home":              true,
+	"installation":      true,
+	"instance":          true,
+	"issuer":            true,
+	"modules":           true,
+	"namedVat":          true,
+	"oracleBrand":       true,
+	"produce":           true,
+	"runBehaviors":      true,
+	"uiConfig":          true,
+	"vatParameters":     true,
+	"vatPowers":         true,
+	"vats":              true,
+	"vbankAsset":        true,
+	"zone":              true,
+}
+
+// ValidateCoreEvalPermit checks that a core-eval permit is a single JSON value
+// accepted as a template by extract in packages/vats/src/core/utils.js: true,
+// a string, or an object (or array) whose properties are themselves templates.
+// An object permit may only name the powers of coreEvalPowerNames.
+func ValidateCoreEvalPermit(jsonPermits string) error {
+	var permit interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(jsonPermits)))
+	if err := decoder.Decode(&permit); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("unexpected data after permit")
+	}
+	switch t := permit.(type) {
+	case map[string]interface{}:
+		for _, name := range sortedPermitKeys(t) {
+			if !coreEvalPowerNames[name] {
+				return fmt.Errorf("unknown power %q", name)
+			}
+		}
+	case []interface{}:
+		if len(t) > 0 {
+			return fmt.Errorf("unknown power %q", "0")
+		}
+	}
+	return validatePermitTemplate(permit, "permit")
+}
+
+func validatePermitTemplate(template interface{}, path string) error {
+	switch t := template.(type) {
+	case string:
+		return nil
+	case bool:
+		if t {
+			return nil
+		}
+	case map[string]interface{}:
+		for _, name := range sortedPermitKeys(t) {
+			if err := validatePermitTemplate(t[name], path+"."+name); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for i, sub := range t {
+			if err := validatePermitTemplate(sub, fmt.Sprintf("%s.%d", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("%s must be true, a string or an object, not %s", path, describeJSON(template))
+}
+
+// sortedPermitKeys returns the keys of a permit object in order, so that the
+// same error is reported for the same permit.
+func sortedPermitKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func describeJSON(value interface{}) string {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(bz)
+}
+
+// PermitHash returns the lowercase hex SHA-256 of the permit.
+func (ce CoreEval) PermitHash() string {
+	sum := sha256.Sum256([]byte(ce.JsonPermits))
+	return hex.EncodeToString(sum[:])
+}
+
+// CodeHash returns the lowercase hex SHA-256 of the code.
+func (ce CoreEval) CodeHash() string {
+	sum := sha256.Sum256([]byte(ce.JsCode))
+	return hex.EncodeToString(sum[:])
+}
+
+// ContentHash identifies a (permit, code) pair.  It is the lowercase hex
+// SHA-256 of the concatenated SHA-256 digests of the permit and the code, so
+// it can be recomputed from the permit.json and code.js files.
+func (ce CoreEval) ContentHash() string {
+	permitSum := sha256.Sum256([]byte(ce.JsonPermits))
+	codeSum := sha256.Sum256([]byte(ce.JsCode))
+	sum := sha256.Sum256(append(permitSum[:], codeSum[:]...))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateBasic checks the form of a core-eval record.
+func (r CoreEvalRecord) ValidateBasic() error {
+	for _, hash := range []string{r.ContentHash, r.PermitHash, r.CodeHash} {
+		if !coreEvalHashRE.MatchString(hash) {
+			return fmt.Errorf("invalid core-eval hash %q", hash)
+		}
+	}
+	switch r.Status {
+	case CoreEvalStatusPending, CoreEvalStatusSucceeded, CoreEvalStatusFailed:
+	default:
+		return fmt.Errorf("invalid core-eval status %q", r.Status)
+	}
+	return nil
+}
@@ -66,8 +66,44 @@ var (
 	DefaultQueueMax = []QueueSize{
 		NewQueueSize(QueueInbound, DefaultInboundQueueMax),
 	}
+
+	// The fee market is disabled by default.  When enabled, these values grow
+	// the multiplier by up to 12.5% per block while the inbound queue is full.
+	DefaultFeeMarketWindowBlocks    = uint32(10)
+	DefaultFeeMarketTargetOccupancy = sdk.NewDecWithPrec(5, 1)   // 0.5
+	DefaultFeeMarketAdjustmentRate  = sdk.NewDecWithPrec(125, 3) // 0.125
+	DefaultFeeMarketMinMultiplier   = sdk.OneDec()
+	DefaultFeeMarketMaxMultiplier   = sdk.NewDec(10)
+
+	// MaxInboundRateLimitWindowBlocks bounds the size of each sender's history.
+	MaxInboundRateLimitWindowBlocks = uint32(10_000)
+
+	// Chunked bundle uploads are abandoned after about an hour of 6 second
+	// blocks.
+	DefaultBundleUploadExpiryBlocks = uint32(600)
+	MaxBundleUploadExpiryBlocks     = uint32(100_000)
 )
 
+// DefaultInboundRateLimit returns a disabled per-sender inbound rate limit.
+func DefaultInboundRateLimit() InboundRateLimit {
+	return InboundRateLimit{
+		WindowBlocks: 0,
+		MaxMessages:  0,
+	}
+}
+
+// DefaultFeeMarket returns a disabled fee market with reasonable bounds.
+func DefaultFeeMarket() FeeMarket {
+	return FeeMarket{
+		Enabled:         false,
+		WindowBlocks:    DefaultFeeMarketWindowBlocks,
+		TargetOccupancy: DefaultFeeMarketTargetOccupancy,
+		AdjustmentRate:  DefaultFeeMarketAdjustmentRate,
+		MinMultiplier:   DefaultFeeMarketMinMultiplier,
+		MaxMultiplier:   DefaultFeeMarketMaxMultiplier,
+	}
+}
+
 // move DefaultBeansPerUnit to a function to allow for boot overriding of the Default params
 func DefaultBeansPerUnit() []StringBeans {
 	return []StringBeans{
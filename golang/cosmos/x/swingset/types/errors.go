@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+)
+
+// Errors registered by the swingset module.
+var (
+	ErrInboundRateLimited = sdkioerrors.Register(ModuleName, 2, "inbound rate limit exceeded")
+)
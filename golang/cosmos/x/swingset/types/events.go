@@ -0,0 +1,28 @@
+package types
+
+// Event types and attribute keys emitted by the swingset module.
+const (
+	EventTypeHighPrioritySenderAdded   = "high_priority_sender_added"
+	EventTypeHighPrioritySenderRemoved = "high_priority_sender_removed"
+	EventTypeBundleUploadBegun         = "bundle_upload_begun"
+	EventTypeBundleUploadCompleted     = "bundle_upload_completed"
+	EventTypeBundleUploadExpired       = "bundle_upload_expired"
+	EventTypeCoreEval                  = "core_eval"
+	EventTypeCoreEvalOutcome           = "core_eval_outcome"
+	EventTypeBundleInstallOutcome      = "bundle_install_outcome"
+
+	AttributeKeyAddress     = "address"
+	AttributeKeyNamespace   = "namespace"
+	AttributeKeySubmitter   = "submitter"
+	AttributeKeyPayloadHash = "payload_hash"
+
+	AttributeKeyReceivedChunks = "received_chunks"
+
+	AttributeKeyIndex       = "index"
+	AttributeKeyContentHash = "content_hash"
+	AttributeKeyPermitHash  = "permit_hash"
+	AttributeKeyCodeHash    = "code_hash"
+	AttributeKeyStatus      = "status"
+	AttributeKeyBundleID    = "bundle_id"
+	AttributeKeyError       = "error"
+)
@@ -27,4 +27,6 @@ type SwingSetKeeper interface {
 	IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error)
 	GetSmartWalletState(ctx sdk.Context, addr sdk.AccAddress) SmartWalletState
 	ChargeForSmartWallet(ctx sdk.Context, addr sdk.AccAddress) error
+	GetSubmittedBundle(ctx sdk.Context, bundleID string) (SubmittedBundle, bool)
+	GetRemainingBundleUploadChunks(ctx sdk.Context, submitter sdk.AccAddress, payloadHash string, index uint32) (uint32, bool)
 }
@@ -28,6 +28,9 @@ type GenesisState struct {
 	Params               Params                       `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	State                State                        `protobuf:"bytes,3,opt,name=state,proto3" json:"state"`
 	SwingStoreExportData []*SwingStoreExportDataEntry `protobuf:"bytes,4,rep,name=swing_store_export_data,json=swingStoreExportData,proto3" json:"swingStoreExportData"`
+	HighPrioritySenders  []HighPrioritySender         `protobuf:"bytes,5,rep,name=high_priority_senders,json=highPrioritySenders,proto3" json:"highPrioritySenders"`
+	SubmittedBundles     []SubmittedBundle            `protobuf:"bytes,6,rep,name=submitted_bundles,json=submittedBundles,proto3" json:"submittedBundles"`
+	CoreEvals            []CoreEvalRecord             `protobuf:"bytes,7,rep,name=core_evals,json=coreEvals,proto3" json:"coreEvals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +87,27 @@ func (m *GenesisState) GetSwingStoreExportData() []*SwingStoreExportDataEntry {
 	return nil
 }
 
+func (m *GenesisState) GetHighPrioritySenders() []HighPrioritySender {
+	if m != nil {
+		return m.HighPrioritySenders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubmittedBundles() []SubmittedBundle {
+	if m != nil {
+		return m.SubmittedBundles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCoreEvals() []CoreEvalRecord {
+	if m != nil {
+		return m.CoreEvals
+	}
+	return nil
+}
+
 // A SwingStore "export data" entry.
 type SwingStoreExportDataEntry struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -145,28 +169,36 @@ func init() {
 func init() { proto.RegisterFile("agoric/swingset/genesis.proto", fileDescriptor_49b057311de9d296) }
 
 var fileDescriptor_49b057311de9d296 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4b, 0x02, 0x41,
-	0x1c, 0xc5, 0x77, 0xf2, 0x07, 0x38, 0x06, 0xc5, 0x22, 0xb9, 0x09, 0x8d, 0xe2, 0x49, 0x82, 0x76,
-	0xc0, 0xe8, 0x52, 0xa7, 0x2c, 0xe9, 0x1a, 0x2b, 0x5d, 0xba, 0xc8, 0xa8, 0xc3, 0xb4, 0xa8, 0x3b,
-	0xcb, 0x7c, 0xc7, 0x52, 0xfa, 0x27, 0xfa, 0x13, 0xfa, 0x73, 0x3c, 0x7a, 0xec, 0x24, 0xa1, 0x97,
-	0xe8, 0x6f, 0xe8, 0x10, 0x3b, 0xa3, 0x04, 0x6a, 0xb7, 0xb7, 0xfb, 0x79, 0xef, 0x0d, 0x33, 0x0f,
-	0x9f, 0x30, 0x21, 0x55, 0xd8, 0xa5, 0xf0, 0x12, 0x46, 0x02, 0xb8, 0xa6, 0x82, 0x47, 0x1c, 0x42,
-	0xf0, 0x63, 0x25, 0xb5, 0x74, 0x0f, 0x2c, 0xf6, 0xd7, 0xb8, 0x54, 0x10, 0x52, 0x48, 0xc3, 0x68,
-	0xa2, 0xac, 0xad, 0x44, 0x36, 0x5b, 0xd6, 0xc2, 0xf2, 0xea, 0x0f, 0xc2, 0xfb, 0x77, 0xb6, 0xb8,
-	0xa5, 0x99, 0xe6, 0xee, 0x05, 0xce, 0xc6, 0x4c, 0xb1, 0x21, 0x78, 0x7b, 0x15, 0x54, 0xcb, 0xd7,
-	0x8b, 0xfe, 0xc6, 0x41, 0xfe, 0xbd, 0xc1, 0x8d, 0xf4, 0x74, 0x5e, 0x76, 0x82, 0x95, 0xd9, 0xad,
-	0xe3, 0x0c, 0x24, 0x79, 0x2f, 0x65, 0x52, 0x47, 0x5b, 0x29, 0xd3, 0xbe, 0x0a, 0x59, 0xab, 0xfb,
-	0x8a, 0x8b, 0x06, 0xb7, 0x41, 0x4b, 0xc5, 0xdb, 0x7c, 0x1c, 0x4b, 0xa5, 0xdb, 0x3d, 0xa6, 0x99,
-	0x97, 0xae, 0xa4, 0x6a, 0xf9, 0xfa, 0xe9, 0x76, 0x4b, 0x22, 0x5a, 0x89, 0xbd, 0x69, 0xdc, 0xb7,
-	0x4c, 0xb3, 0x66, 0xa4, 0xd5, 0xa4, 0xe1, 0x7d, 0xcf, 0xcb, 0x05, 0xd8, 0x81, 0x83, 0x9d, 0x7f,
-	0x2f, 0xd3, 0x5f, 0xef, 0x65, 0xa7, 0x7a, 0x83, 0x8f, 0xff, 0xad, 0x74, 0x0f, 0x71, 0xaa, 0xcf,
-	0x27, 0x1e, 0xaa, 0xa0, 0x5a, 0x2e, 0x48, 0xa4, 0x5b, 0xc0, 0x99, 0x67, 0x36, 0x18, 0x71, 0xf3,
-	0x36, 0xb9, 0xc0, 0x7e, 0x34, 0x1e, 0xa6, 0x0b, 0x82, 0x66, 0x0b, 0x82, 0x3e, 0x17, 0x04, 0xbd,
-	0x2d, 0x89, 0x33, 0x5b, 0x12, 0xe7, 0x63, 0x49, 0x9c, 0xc7, 0x2b, 0x11, 0xea, 0xa7, 0x51, 0xc7,
-	0xef, 0xca, 0x21, 0xbd, 0xb6, 0x43, 0xd8, 0x1b, 0x9d, 0x41, 0xaf, 0x4f, 0x85, 0x1c, 0xb0, 0x48,
-	0xd0, 0xae, 0x84, 0xa1, 0x04, 0x3a, 0xfe, 0xdb, 0x48, 0x4f, 0x62, 0x0e, 0x9d, 0xac, 0x59, 0xe8,
-	0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x94, 0xe9, 0x22, 0x36, 0x09, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0x6d, 0xf2, 0x07, 0x65, 0x8a, 0x44, 0x3b, 0x04, 0x3a, 0x14, 0x61, 0x47, 0x65, 0x13,
+	0x21, 0x61, 0x4b, 0x41, 0x6c, 0x60, 0x85, 0x4b, 0x05, 0xcb, 0xe2, 0x88, 0x0d, 0x1b, 0x6b, 0x62,
+	0x8f, 0xc6, 0xa3, 0x3a, 0x1e, 0x6b, 0xde, 0x24, 0xd4, 0xe2, 0x12, 0x1c, 0x81, 0x13, 0x70, 0x8e,
+	0x2e, 0xbb, 0x64, 0x15, 0xa1, 0x64, 0x83, 0x7a, 0x0a, 0xe4, 0xb1, 0xa3, 0x8a, 0x3a, 0xdd, 0x7d,
+	0x7e, 0xdf, 0xef, 0xbd, 0xcf, 0x33, 0xf3, 0xd0, 0x73, 0xca, 0xa5, 0x12, 0xb1, 0x0f, 0xdf, 0x44,
+	0xce, 0x81, 0x69, 0x9f, 0xb3, 0x9c, 0x81, 0x00, 0xaf, 0x50, 0x52, 0x4b, 0xfc, 0xb0, 0xb6, 0xbd,
+	0xad, 0x7d, 0x34, 0xe4, 0x92, 0x4b, 0xe3, 0xf9, 0x95, 0xaa, 0xb1, 0x23, 0xe7, 0xf6, 0x94, 0xad,
+	0xa8, 0xfd, 0xe3, 0x5f, 0x5d, 0xf4, 0xe0, 0x63, 0x3d, 0x78, 0xaa, 0xa9, 0x66, 0xf8, 0x0d, 0xea,
+	0x17, 0x54, 0xd1, 0x39, 0x90, 0x7b, 0x23, 0x7b, 0xbc, 0x37, 0x39, 0xf4, 0x6e, 0x05, 0x79, 0x67,
+	0xc6, 0x0e, 0xba, 0x97, 0x2b, 0xd7, 0x0a, 0x1b, 0x18, 0x4f, 0x50, 0x0f, 0xaa, 0x7e, 0xd2, 0x31,
+	0x5d, 0x4f, 0x5a, 0x5d, 0x66, 0x7a, 0xd3, 0x54, 0xa3, 0xf8, 0x3b, 0x3a, 0x34, 0x76, 0x04, 0x5a,
+	0x2a, 0x16, 0xb1, 0x8b, 0x42, 0x2a, 0x1d, 0x25, 0x54, 0x53, 0xd2, 0x1d, 0x75, 0xc6, 0x7b, 0x93,
+	0x97, 0xed, 0x29, 0x95, 0x98, 0x56, 0xf8, 0xa9, 0xa1, 0x3f, 0x50, 0x4d, 0x4f, 0x73, 0xad, 0xca,
+	0x80, 0x5c, 0xaf, 0xdc, 0x21, 0xec, 0xb0, 0xc3, 0x9d, 0x55, 0xac, 0xd1, 0xe3, 0x54, 0xf0, 0x34,
+	0x2a, 0x94, 0x90, 0x4a, 0xe8, 0x32, 0x02, 0x96, 0x27, 0x4c, 0x01, 0xe9, 0x99, 0xe8, 0x17, 0xad,
+	0xe8, 0x4f, 0x82, 0xa7, 0x67, 0x0d, 0x3c, 0x35, 0x6c, 0xf0, 0xac, 0x3a, 0xcd, 0xf5, 0xca, 0x7d,
+	0x94, 0xb6, 0x3c, 0x08, 0x77, 0x15, 0x31, 0x47, 0x07, 0xb0, 0x98, 0xcd, 0x85, 0xd6, 0x2c, 0x89,
+	0x66, 0x8b, 0x3c, 0xc9, 0x18, 0x90, 0xbe, 0x49, 0x1c, 0xb5, 0x0f, 0xbb, 0x25, 0x03, 0x03, 0x06,
+	0xa4, 0x89, 0xdb, 0x87, 0xff, 0x0d, 0x08, 0x5b, 0x15, 0xfc, 0x19, 0xa1, 0xd8, 0x5c, 0xea, 0x92,
+	0x66, 0x40, 0xee, 0x9b, 0x04, 0xb7, 0x95, 0x70, 0x52, 0xdd, 0xc9, 0x92, 0x66, 0x21, 0x8b, 0xa5,
+	0x4a, 0x82, 0x83, 0x26, 0x60, 0x10, 0x37, 0x75, 0x08, 0x6f, 0xe4, 0xdb, 0xee, 0xdf, 0x9f, 0xae,
+	0x75, 0x7c, 0x82, 0x9e, 0xde, 0xf9, 0x08, 0x78, 0x1f, 0x75, 0xce, 0x59, 0x49, 0xec, 0x91, 0x3d,
+	0x1e, 0x84, 0x95, 0xc4, 0x43, 0xd4, 0x5b, 0xd2, 0x6c, 0xc1, 0xcc, 0x36, 0x0d, 0xc2, 0xfa, 0x23,
+	0xf8, 0x72, 0xb9, 0x76, 0xec, 0xab, 0xb5, 0x63, 0xff, 0x59, 0x3b, 0xf6, 0x8f, 0x8d, 0x63, 0x5d,
+	0x6d, 0x1c, 0xeb, 0xf7, 0xc6, 0xb1, 0xbe, 0xbe, 0xe3, 0x42, 0xa7, 0x8b, 0x99, 0x17, 0xcb, 0xb9,
+	0xff, 0xbe, 0x5e, 0xdd, 0xfa, 0xa7, 0x5f, 0x41, 0x72, 0xee, 0x73, 0x99, 0xd1, 0x9c, 0xfb, 0xb1,
+	0x84, 0xb9, 0x04, 0xff, 0xe2, 0x66, 0xab, 0x75, 0x59, 0x30, 0x98, 0xf5, 0xcd, 0x4e, 0xbf, 0xfe,
+	0x37, 0x00, 0x00, 0xec, 0x66, 0x86, 0x3b, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -189,6 +221,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CoreEvals) > 0 {
+		for iNdEx := len(m.CoreEvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoreEvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SubmittedBundles) > 0 {
+		for iNdEx := len(m.SubmittedBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmittedBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.HighPrioritySenders) > 0 {
+		for iNdEx := len(m.HighPrioritySenders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HighPrioritySenders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.SwingStoreExportData) > 0 {
 		for iNdEx := len(m.SwingStoreExportData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -290,6 +364,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HighPrioritySenders) > 0 {
+		for _, e := range m.HighPrioritySenders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubmittedBundles) > 0 {
+		for _, e := range m.SubmittedBundles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CoreEvals) > 0 {
+		for _, e := range m.CoreEvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -445,6 +537,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighPrioritySenders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighPrioritySenders = append(m.HighPrioritySenders, HighPrioritySender{})
+			if err := m.HighPrioritySenders[len(m.HighPrioritySenders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmittedBundles = append(m.SubmittedBundles, SubmittedBundle{})
+			if err := m.SubmittedBundles[len(m.SubmittedBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreEvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreEvals = append(m.CoreEvals, CoreEvalRecord{})
+			if err := m.CoreEvals[len(m.CoreEvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,4 +6,7 @@ const (
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
+
+	// TStoreKey to be used when creating the transient store
+	TStoreKey = "transient_" + ModuleName
 )
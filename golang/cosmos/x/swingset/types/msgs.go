@@ -3,12 +3,17 @@ package types
 import (
 	"bytes"
 	"compress/gzip"
+	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
+	"regexp"
 	"strings"
 
 	sdkioerrors "cosmossdk.io/errors"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -21,18 +26,40 @@ var (
 	_ sdk.Msg = &MsgInstallBundle{}
 	_ sdk.Msg = &MsgWalletAction{}
 	_ sdk.Msg = &MsgWalletSpendAction{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAddHighPrioritySender{}
+	_ sdk.Msg = &MsgRemoveHighPrioritySender{}
+	_ sdk.Msg = &MsgBeginBundleUpload{}
+	_ sdk.Msg = &MsgUploadBundleChunk{}
 
 	_ vm.ControllerAdmissionMsg = &MsgDeliverInbound{}
 	_ vm.ControllerAdmissionMsg = &MsgInstallBundle{}
 	_ vm.ControllerAdmissionMsg = &MsgProvision{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletAction{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletSpendAction{}
+	_ vm.ControllerAdmissionMsg = &MsgBeginBundleUpload{}
+	_ vm.ControllerAdmissionMsg = &MsgUploadBundleChunk{}
 )
 
+// highPrioritySenderNamespaceRE matches PRIORITY_SENDERS_NAMESPACE_RE in
+// packages/internal/src/priority-senders.js
+var highPrioritySenderNamespaceRE = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,50}$`)
+
+// bundleUploadPayloadHashRE matches a lowercase hex SHA-512.
+var bundleUploadPayloadHashRE = regexp.MustCompile(`^[0-9a-f]{128}$`)
+
 const (
 	// bundleUncompressedSizeLimit is the (exclusive) limit on uncompressed bundle size.
 	// We must ensure there is an exclusive int64 limit in order to detect an underflow.
 	bundleUncompressedSizeLimit int64 = 10 * 1024 * 1024 // 10MB
+
+	// MaxBundleUploadChunks is the (inclusive) limit on the number of chunks of
+	// a chunked bundle upload.
+	MaxBundleUploadChunks uint32 = 4096
+
+	// MaxOpenBundleUploads is the (inclusive) limit on the number of chunked
+	// bundle uploads a submitter may have in progress at once.
+	MaxOpenBundleUploads = 4
 )
 
 // Charge an account address for the beans associated with given messages and storage.
@@ -349,6 +376,7 @@ func NewMsgInstallBundle(bundleJson string, submitter sdk.AccAddress) *MsgInstal
 }
 
 // CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// The bundle ID is computed, and duplicates refused, only by the msg server.
 func (msg MsgInstallBundle) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
 	keeper, ok := data.(SwingSetKeeper)
 	if !ok {
@@ -394,6 +422,17 @@ func (msg MsgInstallBundle) ValidateBasic() error {
 		// must enforce a limit to avoid overflow when computing its successor in Uncompress()
 		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size out of range")
 	}
+	// Only the shape of an uncompressed endoZipBase64 bundle is checked here.
+	// Other formats are left for the controller to refuse, and the archive is
+	// not examined.
+	if len(msg.Bundle) > 0 {
+		var b bundle.Bundle
+		if err := json.Unmarshal([]byte(msg.Bundle), &b); err == nil && b.ModuleFormat == bundle.ModuleFormat {
+			if err := b.CheckShape(); err != nil {
+				return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+			}
+		}
+	}
 	// We don't check the accuracy of the uncompressed size here, since it could comsume significant CPU.
 	return nil
 }
@@ -411,6 +450,15 @@ func (msg MsgInstallBundle) ExpectedUncompressedSize() uint64 {
 	return uint64(len(msg.Bundle))
 }
 
+// BundleID returns the ID of the bundle, uncompressing it if necessary.  It
+// returns false if the bundle has no verifiable ID.
+func (msg MsgInstallBundle) BundleID() (string, bool) {
+	if err := msg.Uncompress(); err != nil {
+		return "", false
+	}
+	return BundleID(msg.Bundle)
+}
+
 // Compress ensures that a validated bundle has been gzip-compressed.
 func (msg *MsgInstallBundle) Compress() error {
 	if len(msg.Bundle) == 0 {
@@ -462,3 +510,316 @@ func (msg *MsgInstallBundle) Uncompress() error {
 	msg.UncompressedSize = 0
 	return nil
 }
+
+// NewBundleUploadMsgs splits an upload payload into chunks of at most chunkSize
+// bytes, returning the messages that begin the upload and carry the chunks.
+// If uncompressedSize is positive, payload must be the gzip compression of a
+// bundle of that size.
+func NewBundleUploadMsgs(payload []byte, uncompressedSize int64, chunkSize int, submitter sdk.AccAddress) (*MsgBeginBundleUpload, []*MsgUploadBundleChunk, error) {
+	if chunkSize <= 0 {
+		return nil, nil, fmt.Errorf("chunk size must be positive: %d", chunkSize)
+	}
+	hash := BundleUploadPayloadHash(payload)
+	chunks := make([]*MsgUploadBundleChunk, 0, (len(payload)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(payload); start += chunkSize {
+		end := start + chunkSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+		chunks = append(chunks, &MsgUploadBundleChunk{
+			Submitter:   submitter,
+			PayloadHash: hash,
+			Index:       uint32(len(chunks)),
+			Data:        payload[start:end],
+		})
+	}
+	begin := &MsgBeginBundleUpload{
+		Submitter:        submitter,
+		PayloadHash:      hash,
+		TotalSize:        int64(len(payload)),
+		ChunkCount:       uint32(len(chunks)),
+		UncompressedSize: uncompressedSize,
+	}
+	return begin, chunks, nil
+}
+
+// BundleUploadPayloadHash returns the lowercase hex SHA-512 of an upload
+// payload.
+func BundleUploadPayloadHash(payload []byte) string {
+	sum := sha512.Sum512(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+func validateBundleUploadPayloadHash(hash string) error {
+	if !bundleUploadPayloadHashRE.MatchString(hash) {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "payload hash must be a lowercase hex SHA-512: %q", hash)
+	}
+	return nil
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// The storage of the eventual bundle is charged up front.
+func (msg MsgBeginBundleUpload) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	return chargeAdmission(ctx, keeper, msg.Submitter, nil, msg.ExpectedUncompressedSize())
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgBeginBundleUpload) GetInboundMsgCount() int32 {
+	return 0
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgBeginBundleUpload) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// Route should return the name of the module
+func (msg MsgBeginBundleUpload) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBeginBundleUpload) Type() string { return "beginBundleUpload" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBeginBundleUpload) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if err := validateBundleUploadPayloadHash(msg.PayloadHash); err != nil {
+		return err
+	}
+	if msg.TotalSize <= 0 || msg.TotalSize >= bundleUncompressedSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Total size out of range")
+	}
+	if msg.ChunkCount == 0 || msg.ChunkCount > MaxBundleUploadChunks {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Chunk count must be between 1 and %d", MaxBundleUploadChunks)
+	}
+	if int64(msg.ChunkCount) > msg.TotalSize {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk count cannot exceed total size")
+	}
+	if msg.UncompressedSize < 0 || msg.UncompressedSize >= bundleUncompressedSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size out of range")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBeginBundleUpload) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBeginBundleUpload) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// ExpectedUncompressedSize returns the expected uncompressed size of the bundle.
+func (msg MsgBeginBundleUpload) ExpectedUncompressedSize() uint64 {
+	if msg.UncompressedSize > 0 {
+		return uint64(msg.UncompressedSize)
+	}
+	return uint64(msg.TotalSize)
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgUploadBundleChunk) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	return chargeAdmission(ctx, keeper, msg.Submitter, []string{string(msg.Data)}, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.  Any chunk may be the last
+// one, whose arrival queues the bundle installation.  See
+// GetStatefulInboundMsgCount for the count the inbound checks use.
+func (msg MsgUploadBundleChunk) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// GetStatefulInboundMsgCount implements vm.StatefulInboundMsgCounter.  Only
+// the chunk completing its upload queues a bundle installation, taking into
+// account the chunks of the same upload that precede it in the transaction.
+// A chunk of an upload not yet begun is counted, since a preceding
+// MsgBeginBundleUpload in the transaction may begin it.
+func (msg MsgUploadBundleChunk) GetStatefulInboundMsgCount(ctx sdk.Context, data interface{}, precedingMsgs []sdk.Msg) (int32, error) {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return 0, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	remaining, found := keeper.GetRemainingBundleUploadChunks(ctx, msg.Submitter, msg.PayloadHash, msg.Index)
+	if !found {
+		return 1, nil
+	}
+	preceding := uint32(0)
+	for _, m := range precedingMsgs {
+		if chunk, ok := m.(*MsgUploadBundleChunk); ok && chunk.Submitter.Equals(msg.Submitter) && chunk.PayloadHash == msg.PayloadHash {
+			preceding++
+		}
+	}
+	if remaining > preceding {
+		return 0, nil
+	}
+	return 1, nil
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgUploadBundleChunk) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// Route should return the name of the module
+func (msg MsgUploadBundleChunk) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUploadBundleChunk) Type() string { return "uploadBundleChunk" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUploadBundleChunk) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if err := validateBundleUploadPayloadHash(msg.PayloadHash); err != nil {
+		return err
+	}
+	if msg.Index >= MaxBundleUploadChunks {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk index out of range")
+	}
+	if len(msg.Data) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk data cannot be empty")
+	}
+	if int64(len(msg.Data)) >= bundleUncompressedSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk data too large")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUploadBundleChunk) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUploadBundleChunk) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateHighPrioritySenderNamespace checks that a high priority sender
+// namespace is acceptable.
+func ValidateHighPrioritySenderNamespace(namespace string) error {
+	if !highPrioritySenderNamespaceRE.MatchString(namespace) {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid namespace %q", namespace)
+	}
+	return nil
+}
+
+// validateHighPrioritySenderMsg runs the stateless checks common to adding
+// and removing a high priority sender.
+func validateHighPrioritySenderMsg(authority, address, namespace string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	return ValidateHighPrioritySenderNamespace(namespace)
+}
+
+func NewMsgAddHighPrioritySender(authority string, addr sdk.AccAddress, namespace string) *MsgAddHighPrioritySender {
+	return &MsgAddHighPrioritySender{
+		Authority: authority,
+		Address:   addr.String(),
+		Namespace: namespace,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAddHighPrioritySender) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAddHighPrioritySender) Type() string { return "add_high_priority_sender" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAddHighPrioritySender) ValidateBasic() error {
+	return validateHighPrioritySenderMsg(msg.Authority, msg.Address, msg.Namespace)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddHighPrioritySender) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAddHighPrioritySender) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+func NewMsgRemoveHighPrioritySender(authority string, addr sdk.AccAddress, namespace string) *MsgRemoveHighPrioritySender {
+	return &MsgRemoveHighPrioritySender{
+		Authority: authority,
+		Address:   addr.String(),
+		Namespace: namespace,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRemoveHighPrioritySender) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRemoveHighPrioritySender) Type() string { return "remove_high_priority_sender" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRemoveHighPrioritySender) ValidateBasic() error {
+	return validateHighPrioritySenderMsg(msg.Authority, msg.Address, msg.Namespace)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveHighPrioritySender) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRemoveHighPrioritySender) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
@@ -537,839 +537,3165 @@ func (m *MsgInstallBundleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInstallBundleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgDeliverInbound)(nil), "agoric.swingset.MsgDeliverInbound")
-	proto.RegisterType((*MsgDeliverInboundResponse)(nil), "agoric.swingset.MsgDeliverInboundResponse")
-	proto.RegisterType((*MsgWalletAction)(nil), "agoric.swingset.MsgWalletAction")
-	proto.RegisterType((*MsgWalletActionResponse)(nil), "agoric.swingset.MsgWalletActionResponse")
-	proto.RegisterType((*MsgWalletSpendAction)(nil), "agoric.swingset.MsgWalletSpendAction")
-	proto.RegisterType((*MsgWalletSpendActionResponse)(nil), "agoric.swingset.MsgWalletSpendActionResponse")
-	proto.RegisterType((*MsgProvision)(nil), "agoric.swingset.MsgProvision")
-	proto.RegisterType((*MsgProvisionResponse)(nil), "agoric.swingset.MsgProvisionResponse")
-	proto.RegisterType((*MsgInstallBundle)(nil), "agoric.swingset.MsgInstallBundle")
-	proto.RegisterType((*MsgInstallBundleResponse)(nil), "agoric.swingset.MsgInstallBundleResponse")
+// MsgBeginBundleUpload declares a bundle to be sent in chunks, for bundles too
+// large to fit in a single MsgInstallBundle transaction.  The upload payload is
+// the concatenation of the chunks in index order, and is either the bundle
+// JSON or, if uncompressed_size is set, its gzip compression.
+type MsgBeginBundleUpload struct {
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// The lowercase hex SHA-512 of the upload payload.  It identifies the
+	// upload among those of the submitter.
+	PayloadHash string `protobuf:"bytes,2,opt,name=payload_hash,json=payloadHash,proto3" json:"payloadHash" yaml:"payloadHash"`
+	// The size in bytes of the upload payload.
+	TotalSize int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"totalSize" yaml:"totalSize"`
+	// The number of chunks into which the payload is split.
+	ChunkCount uint32 `protobuf:"varint,4,opt,name=chunk_count,json=chunkCount,proto3" json:"chunkCount" yaml:"chunkCount"`
+	// Size in bytes of the uncompressed bundle if the payload is gzip
+	// compressed, otherwise zero.
+	UncompressedSize int64 `protobuf:"varint,5,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize" yaml:"uncompressedSize"`
 }
 
-func init() { proto.RegisterFile("agoric/swingset/msgs.proto", fileDescriptor_788baa062b181a57) }
-
-var fileDescriptor_788baa062b181a57 = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe3, 0x50, 0x36, 0xaf, 0xd9, 0x6d, 0x63, 0x95, 0xad, 0xd7, 0x0b, 0x99, 0xac, 0xa5,
-	0x15, 0x01, 0xd4, 0x44, 0xb0, 0xb7, 0xed, 0x29, 0x16, 0x42, 0x5a, 0xa4, 0xa0, 0xc5, 0x2b, 0x84,
-	0x54, 0x81, 0x5a, 0xc7, 0x19, 0x5c, 0x2b, 0xb6, 0xc7, 0xf2, 0x38, 0x2d, 0xed, 0x8d, 0xff, 0x00,
-	0xfe, 0x01, 0x04, 0xff, 0x0d, 0xc7, 0x1e, 0x11, 0x87, 0x51, 0x95, 0x5e, 0x90, 0x8f, 0x3e, 0x72,
-	0x42, 0x9e, 0xf1, 0x8f, 0xfc, 0x82, 0xa2, 0x1e, 0xca, 0x29, 0x79, 0xdf, 0xf7, 0xbd, 0xf7, 0xbe,
-	0x99, 0x79, 0x63, 0x1b, 0x34, 0xcb, 0x21, 0x91, 0x6b, 0x0f, 0xe8, 0xb9, 0x1b, 0x38, 0x14, 0xc7,
-	0x03, 0x9f, 0x3a, 0xb4, 0x1f, 0x46, 0x24, 0x26, 0xca, 0x8e, 0xe0, 0xfa, 0x05, 0xa7, 0xed, 0x39,
-	0xc4, 0x21, 0x9c, 0x1b, 0x64, 0xff, 0x84, 0x4c, 0xff, 0xb9, 0x0e, 0xed, 0x11, 0x75, 0x3e, 0xc5,
-	0x9e, 0x7b, 0x86, 0xa3, 0x57, 0xc1, 0x98, 0xcc, 0x82, 0x89, 0x72, 0x08, 0x0f, 0x7c, 0x4c, 0xa9,
-	0xe5, 0x60, 0xaa, 0x4a, 0x5d, 0xb9, 0xd7, 0x34, 0x50, 0xc2, 0x50, 0x89, 0xa5, 0x0c, 0xed, 0x5c,
-	0x58, 0xbe, 0xf7, 0x52, 0x2f, 0x10, 0xdd, 0x2c, 0x49, 0xe5, 0x23, 0x68, 0x04, 0x33, 0x9f, 0xaa,
-	0xf5, 0xae, 0xdc, 0x6b, 0x18, 0xfb, 0x09, 0x43, 0x3c, 0x4e, 0x19, 0xda, 0x16, 0x49, 0x59, 0xa4,
-	0x9b, 0x1c, 0x54, 0xde, 0x07, 0xd9, 0xb2, 0xa7, 0xaa, 0xdc, 0x95, 0x7a, 0x0d, 0xe3, 0x9d, 0x84,
-	0xa1, 0x2c, 0x4c, 0x19, 0x02, 0x21, 0xb5, 0xec, 0xa9, 0x6e, 0x66, 0x90, 0x12, 0x42, 0x93, 0xce,
-	0xc6, 0xbe, 0x1b, 0xc7, 0x38, 0x52, 0x1b, 0x5d, 0xa9, 0xd7, 0x32, 0xcc, 0x84, 0xa1, 0x0a, 0x4c,
-	0x19, 0xda, 0x15, 0x49, 0x25, 0xa4, 0xff, 0xc5, 0xd0, 0x81, 0xe3, 0xc6, 0xa7, 0xb3, 0x71, 0xdf,
-	0x26, 0xfe, 0xc0, 0x26, 0xd4, 0x27, 0x34, 0xff, 0x39, 0xa0, 0x93, 0xe9, 0x20, 0xbe, 0x08, 0x31,
-	0xed, 0x0f, 0x6d, 0x7b, 0x38, 0x99, 0x44, 0x98, 0x52, 0xb3, 0xaa, 0xf7, 0xb2, 0xf1, 0xe7, 0x2f,
-	0xa8, 0xa6, 0x3f, 0x85, 0x27, 0x6b, 0xfb, 0x63, 0x62, 0x1a, 0x92, 0x80, 0x62, 0xfd, 0x27, 0x09,
-	0x76, 0x46, 0xd4, 0xf9, 0xda, 0xf2, 0x3c, 0x1c, 0x0f, 0xed, 0xd8, 0x25, 0x81, 0x72, 0x02, 0x6f,
-	0x91, 0xf3, 0x00, 0x47, 0xaa, 0xc4, 0x4d, 0x7e, 0x9e, 0x30, 0x24, 0x80, 0x94, 0xa1, 0x96, 0x30,
-	0xc8, 0xc3, 0x3b, 0x98, 0x13, 0x75, 0x94, 0xc7, 0xb0, 0x65, 0xf1, 0x5e, 0x6a, 0xbd, 0x2b, 0xf5,
-	0x9a, 0x66, 0x1e, 0xe5, 0x86, 0x9f, 0xc0, 0xfe, 0x8a, 0xa5, 0xd2, 0xee, 0xaf, 0x12, 0xec, 0x95,
-	0xdc, 0x9b, 0x10, 0x07, 0x93, 0x7b, 0xf3, 0xfc, 0x0c, 0x5a, 0x34, 0x6b, 0x78, 0xbc, 0xe4, 0x7c,
-	0x9b, 0x56, 0x26, 0x72, 0xfb, 0x1d, 0x78, 0x77, 0x93, 0xc5, 0x72, 0x0d, 0x3f, 0xc8, 0xd0, 0x1a,
-	0x51, 0xe7, 0x75, 0x44, 0xce, 0x5c, 0x9a, 0x79, 0x3f, 0x84, 0x07, 0x81, 0x6b, 0x4f, 0x03, 0xcb,
-	0xc7, 0xdc, 0x7e, 0x3e, 0xab, 0x05, 0x56, 0xcd, 0x6a, 0x81, 0xe8, 0x66, 0x49, 0x2a, 0xa7, 0xf0,
-	0xb6, 0x25, 0x8c, 0x72, 0x47, 0x2d, 0xe3, 0x8b, 0x84, 0xa1, 0x02, 0x4a, 0x19, 0x7a, 0x94, 0x8f,
-	0xa1, 0x00, 0xee, 0xb0, 0xfc, 0xa2, 0x96, 0x62, 0xc2, 0x76, 0x48, 0xce, 0x71, 0x74, 0xfc, 0x9d,
-	0x67, 0x39, 0x54, 0x95, 0xf9, 0xad, 0xfa, 0x78, 0xce, 0x10, 0xbc, 0xce, 0xe0, 0xcf, 0x32, 0x34,
-	0x61, 0x08, 0xc2, 0x32, 0x4a, 0x19, 0x6a, 0x8b, 0xf6, 0x15, 0xa6, 0x9b, 0x0b, 0x82, 0xff, 0xed,
-	0x4e, 0x3c, 0xe6, 0x63, 0x54, 0x1e, 0x41, 0x79, 0x36, 0x7f, 0xd4, 0x61, 0x77, 0x44, 0x9d, 0x57,
-	0x01, 0x8d, 0x2d, 0xcf, 0x33, 0x66, 0xc1, 0xc4, 0xc3, 0xca, 0x0b, 0xd8, 0x1a, 0xf3, 0x7f, 0xf9,
-	0xe9, 0x3c, 0x4d, 0x18, 0xca, 0x91, 0x94, 0xa1, 0x87, 0xc2, 0x9e, 0x88, 0x75, 0x33, 0x27, 0x96,
-	0x57, 0x56, 0xbf, 0x87, 0x95, 0x29, 0xdf, 0x40, 0xdb, 0x26, 0x7e, 0x98, 0xc1, 0x78, 0x72, 0x9c,
-	0x3b, 0x96, 0x79, 0xe7, 0x41, 0xc2, 0xd0, 0x6e, 0x45, 0x1a, 0x85, 0xf7, 0x7d, 0x61, 0x60, 0x95,
-	0xd1, 0xcd, 0x35, 0xb1, 0x32, 0x84, 0xf6, 0x2c, 0x58, 0xa8, 0x4f, 0xdd, 0x4b, 0xcc, 0x4f, 0x4c,
-	0x36, 0xf6, 0xb2, 0xea, 0x8b, 0xe4, 0x1b, 0xf7, 0x12, 0x9b, 0x6b, 0x88, 0xae, 0x81, 0xba, 0xba,
-	0xb7, 0xc5, 0xc6, 0x7f, 0x72, 0x2d, 0x83, 0x3c, 0xa2, 0x8e, 0xf2, 0x2d, 0x3c, 0x5c, 0xde, 0xfc,
-	0x67, 0xfd, 0x95, 0xd7, 0x40, 0x7f, 0xb5, 0x86, 0xf6, 0xc1, 0xad, 0x92, 0xa2, 0x8d, 0x72, 0x02,
-	0x8f, 0x56, 0x5e, 0x14, 0xfa, 0xa6, 0xe4, 0x65, 0x8d, 0xf6, 0xe1, 0xed, 0x9a, 0xb2, 0xc3, 0x11,
-	0xb4, 0x96, 0x1e, 0xa6, 0xdd, 0x4d, 0xb9, 0x8b, 0x0a, 0xad, 0x77, 0x9b, 0xa2, 0xac, 0xed, 0x42,
-	0x7b, 0xfd, 0xc9, 0xf7, 0xfc, 0x9f, 0xd3, 0x17, 0x64, 0xda, 0xc1, 0x7f, 0x92, 0x95, 0xad, 0xbe,
-	0x84, 0x66, 0xf5, 0x80, 0x7a, 0x6f, 0x53, 0x6e, 0x49, 0x6b, 0xcf, 0xff, 0x95, 0x2e, 0x4a, 0x1a,
-	0x5f, 0xfd, 0x36, 0xef, 0x48, 0x57, 0xf3, 0x8e, 0x74, 0x3d, 0xef, 0x48, 0x3f, 0xde, 0x74, 0x6a,
-	0x57, 0x37, 0x9d, 0xda, 0xef, 0x37, 0x9d, 0xda, 0xd1, 0xe1, 0xc2, 0xcc, 0x0f, 0xc5, 0x07, 0x81,
-	0xa8, 0xc8, 0x67, 0xde, 0x21, 0x9e, 0x15, 0x38, 0xc5, 0x65, 0xf8, 0xbe, 0xfa, 0x56, 0xe0, 0x97,
-	0x61, 0xbc, 0xc5, 0x3f, 0x03, 0x5e, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x51, 0x66, 0x1b, 0xd5,
-	0x4b, 0x08, 0x00, 0x00,
+func (m *MsgBeginBundleUpload) Reset()         { *m = MsgBeginBundleUpload{} }
+func (m *MsgBeginBundleUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginBundleUpload) ProtoMessage()    {}
+func (*MsgBeginBundleUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Install a JavaScript sources bundle on the chain's SwingSet controller.
-	InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error)
-	// Send inbound messages.
-	DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error)
-	// Perform a low-privilege wallet action.
-	WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error)
-	// Perform a wallet action that spends assets.
-	WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error)
-	// Provision a new endpoint.
-	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
+func (m *MsgBeginBundleUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgBeginBundleUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginBundleUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgBeginBundleUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginBundleUpload.Merge(m, src)
+}
+func (m *MsgBeginBundleUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginBundleUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginBundleUpload.DiscardUnknown(m)
 }
 
-func (c *msgClient) InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error) {
-	out := new(MsgInstallBundleResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/InstallBundle", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBeginBundleUpload proto.InternalMessageInfo
+
+func (m *MsgBeginBundleUpload) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error) {
-	out := new(MsgDeliverInboundResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/DeliverInbound", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBeginBundleUpload) GetPayloadHash() string {
+	if m != nil {
+		return m.PayloadHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error) {
-	out := new(MsgWalletActionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBeginBundleUpload) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error) {
-	out := new(MsgWalletSpendActionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletSpendAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBeginBundleUpload) GetChunkCount() uint32 {
+	if m != nil {
+		return m.ChunkCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error) {
-	out := new(MsgProvisionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Provision", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBeginBundleUpload) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Install a JavaScript sources bundle on the chain's SwingSet controller.
-	InstallBundle(context.Context, *MsgInstallBundle) (*MsgInstallBundleResponse, error)
-	// Send inbound messages.
-	DeliverInbound(context.Context, *MsgDeliverInbound) (*MsgDeliverInboundResponse, error)
-	// Perform a low-privilege wallet action.
-	WalletAction(context.Context, *MsgWalletAction) (*MsgWalletActionResponse, error)
-	// Perform a wallet action that spends assets.
-	WalletSpendAction(context.Context, *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error)
-	// Provision a new endpoint.
-	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
+// MsgBeginBundleUploadResponse is an empty reply.
+type MsgBeginBundleUploadResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgBeginBundleUploadResponse) Reset()         { *m = MsgBeginBundleUploadResponse{} }
+func (m *MsgBeginBundleUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginBundleUploadResponse) ProtoMessage()    {}
+func (*MsgBeginBundleUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{11}
 }
-
-func (*UnimplementedMsgServer) InstallBundle(ctx context.Context, req *MsgInstallBundle) (*MsgInstallBundleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstallBundle not implemented")
+func (m *MsgBeginBundleUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeliverInbound(ctx context.Context, req *MsgDeliverInbound) (*MsgDeliverInboundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeliverInbound not implemented")
+func (m *MsgBeginBundleUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginBundleUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WalletAction(ctx context.Context, req *MsgWalletAction) (*MsgWalletActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletAction not implemented")
+func (m *MsgBeginBundleUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginBundleUploadResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) WalletSpendAction(ctx context.Context, req *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletSpendAction not implemented")
+func (m *MsgBeginBundleUploadResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Provision(ctx context.Context, req *MsgProvision) (*MsgProvisionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+func (m *MsgBeginBundleUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginBundleUploadResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgBeginBundleUploadResponse proto.InternalMessageInfo
+
+// MsgUploadBundleChunk carries one chunk of a bundle upload.  A chunk may be
+// sent again to replace its earlier contents.
+type MsgUploadBundleChunk struct {
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// The payload_hash of the MsgBeginBundleUpload.
+	PayloadHash string `protobuf:"bytes,2,opt,name=payload_hash,json=payloadHash,proto3" json:"payloadHash" yaml:"payloadHash"`
+	// The zero-based position of the chunk in the payload.
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index" yaml:"index"`
+	Data  []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data" yaml:"data"`
 }
 
-func _Msg_InstallBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstallBundle)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstallBundle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/InstallBundle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstallBundle(ctx, req.(*MsgInstallBundle))
+func (m *MsgUploadBundleChunk) Reset()         { *m = MsgUploadBundleChunk{} }
+func (m *MsgUploadBundleChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadBundleChunk) ProtoMessage()    {}
+func (*MsgUploadBundleChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{12}
+}
+func (m *MsgUploadBundleChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadBundleChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadBundleChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUploadBundleChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadBundleChunk.Merge(m, src)
+}
+func (m *MsgUploadBundleChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadBundleChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadBundleChunk.DiscardUnknown(m)
 }
 
-func _Msg_DeliverInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeliverInbound)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeliverInbound(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/DeliverInbound",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeliverInbound(ctx, req.(*MsgDeliverInbound))
+var xxx_messageInfo_MsgUploadBundleChunk proto.InternalMessageInfo
+
+func (m *MsgUploadBundleChunk) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WalletAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWalletAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WalletAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/WalletAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WalletAction(ctx, req.(*MsgWalletAction))
+func (m *MsgUploadBundleChunk) GetPayloadHash() string {
+	if m != nil {
+		return m.PayloadHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WalletSpendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWalletSpendAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WalletSpendAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/WalletSpendAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WalletSpendAction(ctx, req.(*MsgWalletSpendAction))
+func (m *MsgUploadBundleChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProvision)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Provision(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/Provision",
+func (m *MsgUploadBundleChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Provision(ctx, req.(*MsgProvision))
+	return nil
+}
+
+// MsgUploadBundleChunkResponse reports whether the upload is complete, in
+// which case the bundle has been queued for the SwingSet kernel's
+// consideration.
+type MsgUploadBundleChunkResponse struct {
+	Completed bool `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
+}
+
+func (m *MsgUploadBundleChunkResponse) Reset()         { *m = MsgUploadBundleChunkResponse{} }
+func (m *MsgUploadBundleChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadBundleChunkResponse) ProtoMessage()    {}
+func (*MsgUploadBundleChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{13}
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadBundleChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadBundleChunkResponse.Merge(m, src)
+}
+func (m *MsgUploadBundleChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadBundleChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadBundleChunkResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.swingset.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InstallBundle",
-			Handler:    _Msg_InstallBundle_Handler,
-		},
-		{
-			MethodName: "DeliverInbound",
-			Handler:    _Msg_DeliverInbound_Handler,
-		},
-		{
-			MethodName: "WalletAction",
-			Handler:    _Msg_WalletAction_Handler,
-		},
-		{
-			MethodName: "WalletSpendAction",
-			Handler:    _Msg_WalletSpendAction_Handler,
-		},
-		{
-			MethodName: "Provision",
-			Handler:    _Msg_Provision_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/swingset/msgs.proto",
-}
+var xxx_messageInfo_MsgUploadBundleChunkResponse proto.InternalMessageInfo
 
-func (m *MsgDeliverInbound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUploadBundleChunkResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgDeliverInbound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParams replaces all of the swingset module parameters.
+type MsgUpdateParams struct {
+	// The address of the governance authority, as a bech32 string.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// The complete set of parameters to install.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
 }
 
-func (m *MsgDeliverInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Ack != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Ack))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Nums) > 0 {
-		dAtA2 := make([]byte, len(m.Nums)*10)
-		var j1 int
-		for _, num := range m.Nums {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintMsgs(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDeliverInboundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-
-func (m *MsgDeliverInboundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDeliverInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgWalletAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWalletAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgWalletAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgUpdateParamsResponse is an empty reply.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgWalletActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWalletActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWalletActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgAddHighPrioritySender registers an address as a high priority sender
+// under a namespace.
+type MsgAddHighPrioritySender struct {
+	// The address of the governance authority, as a bech32 string.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// The bech32 address of the sender to register.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address" yaml:"address"`
+	// A label for the reason of the priority, such as "oracle" or "keeper".
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
 }
 
-func (m *MsgWalletSpendAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddHighPrioritySender) Reset()         { *m = MsgAddHighPrioritySender{} }
+func (m *MsgAddHighPrioritySender) String() string { return proto.CompactTextString(m) }
+func (*MsgAddHighPrioritySender) ProtoMessage()    {}
+func (*MsgAddHighPrioritySender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{16}
+}
+func (m *MsgAddHighPrioritySender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddHighPrioritySender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddHighPrioritySender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWalletSpendAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddHighPrioritySender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddHighPrioritySender.Merge(m, src)
+}
+func (m *MsgAddHighPrioritySender) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddHighPrioritySender) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddHighPrioritySender.DiscardUnknown(m)
 }
 
-func (m *MsgWalletSpendAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpendAction) > 0 {
-		i -= len(m.SpendAction)
-		copy(dAtA[i:], m.SpendAction)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SpendAction)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgAddHighPrioritySender proto.InternalMessageInfo
+
+func (m *MsgAddHighPrioritySender) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWalletSpendActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddHighPrioritySender) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWalletSpendActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddHighPrioritySender) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-func (m *MsgWalletSpendActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgAddHighPrioritySenderResponse is an empty reply.
+type MsgAddHighPrioritySenderResponse struct {
 }
 
-func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddHighPrioritySenderResponse) Reset()         { *m = MsgAddHighPrioritySenderResponse{} }
+func (m *MsgAddHighPrioritySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddHighPrioritySenderResponse) ProtoMessage()    {}
+func (*MsgAddHighPrioritySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{17}
+}
+func (m *MsgAddHighPrioritySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddHighPrioritySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddHighPrioritySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddHighPrioritySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddHighPrioritySenderResponse.Merge(m, src)
+}
+func (m *MsgAddHighPrioritySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddHighPrioritySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddHighPrioritySenderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAddHighPrioritySenderResponse proto.InternalMessageInfo
+
+// MsgRemoveHighPrioritySender unregisters an address as a high priority
+// sender under a namespace.
+type MsgRemoveHighPrioritySender struct {
+	// The address of the governance authority, as a bech32 string.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// The bech32 address of the sender to unregister.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address" yaml:"address"`
+	// The namespace under which the sender was registered.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
 }
 
-func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PowerFlags) > 0 {
-		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerFlags[iNdEx])
-			copy(dAtA[i:], m.PowerFlags[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRemoveHighPrioritySender) Reset()         { *m = MsgRemoveHighPrioritySender{} }
+func (m *MsgRemoveHighPrioritySender) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHighPrioritySender) ProtoMessage()    {}
+func (*MsgRemoveHighPrioritySender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{18}
+}
+func (m *MsgRemoveHighPrioritySender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHighPrioritySender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHighPrioritySender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgRemoveHighPrioritySender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHighPrioritySender.Merge(m, src)
+}
+func (m *MsgRemoveHighPrioritySender) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHighPrioritySender) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHighPrioritySender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveHighPrioritySender proto.InternalMessageInfo
+
+func (m *MsgRemoveHighPrioritySender) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRemoveHighPrioritySender) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveHighPrioritySender) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveHighPrioritySenderResponse is an empty reply.
+type MsgRemoveHighPrioritySenderResponse struct {
 }
 
-func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveHighPrioritySenderResponse) Reset()         { *m = MsgRemoveHighPrioritySenderResponse{} }
+func (m *MsgRemoveHighPrioritySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHighPrioritySenderResponse) ProtoMessage()    {}
+func (*MsgRemoveHighPrioritySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{19}
+}
+func (m *MsgRemoveHighPrioritySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHighPrioritySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHighPrioritySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveHighPrioritySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHighPrioritySenderResponse.Merge(m, src)
+}
+func (m *MsgRemoveHighPrioritySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHighPrioritySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHighPrioritySenderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveHighPrioritySenderResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgDeliverInbound)(nil), "agoric.swingset.MsgDeliverInbound")
+	proto.RegisterType((*MsgDeliverInboundResponse)(nil), "agoric.swingset.MsgDeliverInboundResponse")
+	proto.RegisterType((*MsgWalletAction)(nil), "agoric.swingset.MsgWalletAction")
+	proto.RegisterType((*MsgWalletActionResponse)(nil), "agoric.swingset.MsgWalletActionResponse")
+	proto.RegisterType((*MsgWalletSpendAction)(nil), "agoric.swingset.MsgWalletSpendAction")
+	proto.RegisterType((*MsgWalletSpendActionResponse)(nil), "agoric.swingset.MsgWalletSpendActionResponse")
+	proto.RegisterType((*MsgProvision)(nil), "agoric.swingset.MsgProvision")
+	proto.RegisterType((*MsgProvisionResponse)(nil), "agoric.swingset.MsgProvisionResponse")
+	proto.RegisterType((*MsgInstallBundle)(nil), "agoric.swingset.MsgInstallBundle")
+	proto.RegisterType((*MsgInstallBundleResponse)(nil), "agoric.swingset.MsgInstallBundleResponse")
+	proto.RegisterType((*MsgBeginBundleUpload)(nil), "agoric.swingset.MsgBeginBundleUpload")
+	proto.RegisterType((*MsgBeginBundleUploadResponse)(nil), "agoric.swingset.MsgBeginBundleUploadResponse")
+	proto.RegisterType((*MsgUploadBundleChunk)(nil), "agoric.swingset.MsgUploadBundleChunk")
+	proto.RegisterType((*MsgUploadBundleChunkResponse)(nil), "agoric.swingset.MsgUploadBundleChunkResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "agoric.swingset.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "agoric.swingset.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddHighPrioritySender)(nil), "agoric.swingset.MsgAddHighPrioritySender")
+	proto.RegisterType((*MsgAddHighPrioritySenderResponse)(nil), "agoric.swingset.MsgAddHighPrioritySenderResponse")
+	proto.RegisterType((*MsgRemoveHighPrioritySender)(nil), "agoric.swingset.MsgRemoveHighPrioritySender")
+	proto.RegisterType((*MsgRemoveHighPrioritySenderResponse)(nil), "agoric.swingset.MsgRemoveHighPrioritySenderResponse")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/msgs.proto", fileDescriptor_788baa062b181a57) }
+
+var fileDescriptor_788baa062b181a57 = []byte{
+	// 1258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0xb3, 0x69, 0xbe, 0xd9, 0x97, 0x4d, 0xdb, 0x58, 0x69, 0xb3, 0x75, 0xdb, 0x9d, 0xed,
+	0x54, 0xd1, 0x77, 0xf9, 0x91, 0xac, 0xda, 0x22, 0x21, 0xb5, 0x48, 0x25, 0x6e, 0x85, 0x5a, 0xa4,
+	0xa0, 0xe0, 0xaa, 0x42, 0xaa, 0x40, 0xe9, 0xc4, 0x1e, 0xbc, 0x56, 0x76, 0x6d, 0xcb, 0xe3, 0x6d,
+	0x9b, 0x1e, 0x90, 0x38, 0x72, 0x83, 0x7f, 0x00, 0xc1, 0x95, 0x3f, 0x80, 0x23, 0xe7, 0x1e, 0x7b,
+	0x44, 0x20, 0x59, 0x28, 0xbd, 0xa0, 0x3d, 0xee, 0x91, 0x13, 0x9a, 0x19, 0x7b, 0xec, 0x5d, 0x3b,
+	0x4d, 0x28, 0x52, 0x11, 0x9c, 0xb2, 0xf3, 0x79, 0x9f, 0x99, 0xf7, 0x99, 0xf7, 0x66, 0xe6, 0xbd,
+	0x18, 0x0c, 0xe2, 0x06, 0x91, 0x67, 0x77, 0xd9, 0x63, 0xcf, 0x77, 0x19, 0x8d, 0xbb, 0x03, 0xe6,
+	0xb2, 0x8d, 0x30, 0x0a, 0xe2, 0x40, 0x3f, 0x25, 0x6d, 0x1b, 0x99, 0xcd, 0x58, 0x71, 0x03, 0x37,
+	0x10, 0xb6, 0x2e, 0xff, 0x25, 0x69, 0x46, 0x6b, 0x7a, 0x89, 0xec, 0x87, 0xb4, 0xe3, 0x6f, 0x67,
+	0x61, 0x79, 0x8b, 0xb9, 0xb7, 0x69, 0xdf, 0x7b, 0x44, 0xa3, 0xbb, 0xfe, 0x6e, 0x30, 0xf4, 0x1d,
+	0xfd, 0x06, 0x2c, 0x0c, 0x28, 0x63, 0xc4, 0xa5, 0xac, 0xa9, 0xb5, 0x6b, 0x9d, 0xba, 0x89, 0x46,
+	0x09, 0x52, 0xd8, 0x38, 0x41, 0xa7, 0xf6, 0xc9, 0xa0, 0x7f, 0x1d, 0x67, 0x08, 0xb6, 0x94, 0x51,
+	0x7f, 0x0b, 0xe6, 0xfc, 0xe1, 0x80, 0x35, 0x67, 0xdb, 0xb5, 0xce, 0x9c, 0xb9, 0x3a, 0x4a, 0x90,
+	0x18, 0x8f, 0x13, 0xb4, 0x28, 0x27, 0xf1, 0x11, 0xb6, 0x04, 0xa8, 0xff, 0x1f, 0x6a, 0xc4, 0xde,
+	0x6b, 0xd6, 0xda, 0x5a, 0x67, 0xce, 0x3c, 0x33, 0x4a, 0x10, 0x1f, 0x8e, 0x13, 0x04, 0x92, 0x4a,
+	0xec, 0x3d, 0x6c, 0x71, 0x48, 0x0f, 0xa1, 0xce, 0x86, 0xbb, 0x03, 0x2f, 0x8e, 0x69, 0xd4, 0x9c,
+	0x6b, 0x6b, 0x9d, 0x86, 0x69, 0x8d, 0x12, 0x94, 0x83, 0xe3, 0x04, 0x9d, 0x96, 0x93, 0x14, 0x84,
+	0xff, 0x48, 0xd0, 0xba, 0xeb, 0xc5, 0xbd, 0xe1, 0xee, 0x86, 0x1d, 0x0c, 0xba, 0x76, 0xc0, 0x06,
+	0x01, 0x4b, 0xff, 0xac, 0x33, 0x67, 0xaf, 0x1b, 0xef, 0x87, 0x94, 0x6d, 0x6c, 0xda, 0xf6, 0xa6,
+	0xe3, 0x44, 0x94, 0x31, 0x2b, 0x5f, 0xef, 0xfa, 0xdc, 0xef, 0xdf, 0xa1, 0x19, 0x7c, 0x1e, 0xce,
+	0x95, 0xe2, 0x63, 0x51, 0x16, 0x06, 0x3e, 0xa3, 0xf8, 0x1b, 0x0d, 0x4e, 0x6d, 0x31, 0xf7, 0x13,
+	0xd2, 0xef, 0xd3, 0x78, 0xd3, 0x8e, 0xbd, 0xc0, 0xd7, 0x1f, 0xc2, 0x89, 0xe0, 0xb1, 0x4f, 0xa3,
+	0xa6, 0x26, 0x44, 0x7e, 0x38, 0x4a, 0x90, 0x04, 0xc6, 0x09, 0x6a, 0x48, 0x81, 0x62, 0xf8, 0x0a,
+	0xe2, 0xe4, 0x3a, 0xfa, 0x59, 0x98, 0x27, 0xc2, 0x57, 0x73, 0xb6, 0xad, 0x75, 0xea, 0x56, 0x3a,
+	0x4a, 0x05, 0x9f, 0x83, 0xd5, 0x29, 0x49, 0x4a, 0xee, 0xf7, 0x1a, 0xac, 0x28, 0xdb, 0xbd, 0x90,
+	0xfa, 0xce, 0x6b, 0xd3, 0x7c, 0x09, 0x1a, 0x8c, 0x3b, 0xdc, 0x99, 0x50, 0xbe, 0xc8, 0x72, 0x11,
+	0xa9, 0xfc, 0x16, 0x5c, 0xa8, 0x92, 0xa8, 0xf6, 0xf0, 0x65, 0x0d, 0x1a, 0x5b, 0xcc, 0xdd, 0x8e,
+	0x82, 0x47, 0x1e, 0xe3, 0xda, 0x6f, 0xc0, 0x82, 0xef, 0xd9, 0x7b, 0x3e, 0x19, 0x50, 0x21, 0x3f,
+	0x3d, 0xab, 0x19, 0x96, 0x9f, 0xd5, 0x0c, 0xc1, 0x96, 0x32, 0xea, 0x3d, 0xf8, 0x1f, 0x91, 0x42,
+	0x85, 0xa2, 0x86, 0xf9, 0xd1, 0x28, 0x41, 0x19, 0x34, 0x4e, 0xd0, 0x49, 0x39, 0x35, 0x05, 0x5e,
+	0x61, 0xfb, 0xd9, 0x5a, 0xba, 0x05, 0x8b, 0x61, 0xf0, 0x98, 0x46, 0x3b, 0x9f, 0xf7, 0x89, 0xcb,
+	0x9a, 0x35, 0x71, 0xab, 0xae, 0x1c, 0x24, 0x08, 0xb6, 0x39, 0xfc, 0x01, 0x47, 0x47, 0x09, 0x82,
+	0x50, 0x8d, 0xc6, 0x09, 0x5a, 0x96, 0xee, 0x73, 0x0c, 0x5b, 0x05, 0xc2, 0x3f, 0x76, 0x27, 0xce,
+	0xc2, 0x4a, 0x31, 0x05, 0x2a, 0x37, 0xbf, 0xcc, 0xc2, 0xe9, 0x2d, 0xe6, 0xde, 0xf5, 0x59, 0x4c,
+	0xfa, 0x7d, 0x73, 0xe8, 0x3b, 0x7d, 0xaa, 0x5f, 0x83, 0xf9, 0x5d, 0xf1, 0x2b, 0xcd, 0xce, 0xf9,
+	0x51, 0x82, 0x52, 0x64, 0x9c, 0xa0, 0x25, 0x29, 0x4f, 0x8e, 0xb1, 0x95, 0x1a, 0x26, 0x77, 0x36,
+	0xfb, 0x1a, 0x76, 0xa6, 0x7f, 0x0a, 0xcb, 0x76, 0x30, 0x08, 0x39, 0x4c, 0x9d, 0x9d, 0x54, 0x71,
+	0x4d, 0x78, 0xee, 0x8e, 0x12, 0x74, 0x3a, 0x37, 0x9a, 0x99, 0xf6, 0x55, 0x29, 0x60, 0xda, 0x82,
+	0xad, 0x12, 0x59, 0xdf, 0x84, 0xe5, 0xa1, 0x5f, 0x58, 0x9f, 0x79, 0x4f, 0xa9, 0xc8, 0x58, 0xcd,
+	0x5c, 0xe1, 0xab, 0x17, 0x8d, 0xf7, 0xbc, 0xa7, 0xd4, 0x2a, 0x21, 0xd8, 0x80, 0xe6, 0x74, 0x6c,
+	0x55, 0xe0, 0x7f, 0xaa, 0x89, 0x8c, 0x98, 0xd4, 0xf5, 0x7c, 0x69, 0xba, 0x1f, 0xf6, 0x03, 0xe2,
+	0x4c, 0xc6, 0x51, 0x7b, 0x1d, 0x71, 0xbc, 0x03, 0x8d, 0x90, 0xec, 0x73, 0xe7, 0x3b, 0x3d, 0xc2,
+	0x7a, 0xf2, 0xa2, 0x9b, 0x6b, 0xa3, 0x04, 0x2d, 0xa6, 0xf8, 0x1d, 0xc2, 0x7a, 0xe3, 0x04, 0xe9,
+	0xe9, 0xd9, 0xce, 0x41, 0x6c, 0x15, 0x29, 0xfa, 0xfb, 0x00, 0x71, 0x10, 0x93, 0xbe, 0x0c, 0x56,
+	0x4d, 0x04, 0xeb, 0x12, 0x17, 0x2f, 0x50, 0x1e, 0x93, 0x5c, 0xbc, 0x82, 0xb0, 0x95, 0x9b, 0xf5,
+	0xdb, 0xb0, 0x68, 0xf7, 0x86, 0xfe, 0xde, 0x8e, 0x1d, 0x0c, 0xfd, 0x58, 0xc4, 0x7b, 0xc9, 0xbc,
+	0xcc, 0x6f, 0x99, 0x80, 0x6f, 0x71, 0x34, 0xbf, 0x65, 0x39, 0x86, 0xad, 0x02, 0x81, 0x9f, 0x8c,
+	0x72, 0xee, 0x4e, 0x08, 0x39, 0xdd, 0xaa, 0xdc, 0xe5, 0x27, 0xa3, 0x94, 0xc3, 0x72, 0x5a, 0x27,
+	0x5e, 0xbd, 0x52, 0xfe, 0x54, 0x82, 0x7f, 0x9c, 0x15, 0x09, 0x96, 0xa8, 0x64, 0xdc, 0xe2, 0x0a,
+	0xff, 0xd5, 0x09, 0xee, 0xc2, 0x09, 0xcf, 0x77, 0xe8, 0x13, 0x91, 0xdb, 0x25, 0xf3, 0x1c, 0xaf,
+	0x3a, 0x02, 0xc8, 0xab, 0x8e, 0x18, 0x62, 0x4b, 0xc2, 0xbc, 0xb3, 0x70, 0x48, 0x4c, 0xd2, 0xa7,
+	0x4e, 0x74, 0x16, 0x7c, 0x9c, 0x77, 0x16, 0x7c, 0x84, 0x2d, 0x01, 0xa6, 0x81, 0x7d, 0x0f, 0x2e,
+	0x54, 0xc5, 0x2d, 0x0b, 0xac, 0x7e, 0x01, 0xea, 0x3c, 0x21, 0x7d, 0x1a, 0x53, 0x47, 0xc4, 0x6f,
+	0xc1, 0xca, 0x01, 0xfc, 0x83, 0xac, 0xef, 0xf7, 0x43, 0x87, 0xc4, 0x74, 0x9b, 0x44, 0x64, 0xc0,
+	0xf4, 0x9b, 0x50, 0x27, 0xc3, 0xb8, 0x17, 0x44, 0x5e, 0xbc, 0x9f, 0x3e, 0x69, 0xe2, 0x54, 0x2a,
+	0x30, 0x8f, 0xb8, 0x82, 0xb0, 0x95, 0x9b, 0xf5, 0x6d, 0x98, 0x0f, 0xc5, 0x52, 0x22, 0x74, 0x8b,
+	0x57, 0x57, 0x37, 0xa6, 0x5a, 0xb9, 0x0d, 0xe9, 0xc9, 0x44, 0xcf, 0x12, 0x34, 0xc3, 0x5f, 0x4b,
+	0x49, 0xcf, 0x5f, 0x4b, 0x39, 0xc6, 0x56, 0x6a, 0x98, 0x28, 0xfc, 0x45, 0xad, 0xea, 0xf8, 0xfc,
+	0xaa, 0x89, 0xc7, 0x63, 0xd3, 0x71, 0xee, 0x78, 0x6e, 0x6f, 0x3b, 0xf2, 0x84, 0x92, 0x7b, 0xd4,
+	0x77, 0x68, 0xf4, 0xf7, 0x37, 0xf4, 0xee, 0x64, 0x11, 0xad, 0x9b, 0x17, 0x5f, 0x5a, 0x44, 0xf3,
+	0x9a, 0x78, 0x13, 0xea, 0xbc, 0x0a, 0xb3, 0x90, 0xd8, 0xf2, 0x82, 0xa7, 0x9e, 0x15, 0x98, 0x7b,
+	0x56, 0x10, 0xb6, 0x72, 0x73, 0xba, 0x71, 0x0c, 0xed, 0xc3, 0x36, 0xa7, 0x22, 0x90, 0x68, 0x70,
+	0x7e, 0x8b, 0xb9, 0x16, 0x1d, 0x04, 0x8f, 0xe8, 0x7f, 0x31, 0x08, 0x6b, 0x70, 0xf9, 0x25, 0xfb,
+	0xcb, 0xe2, 0x70, 0xf5, 0xab, 0x05, 0xa8, 0x6d, 0x31, 0x57, 0xff, 0x0c, 0x96, 0x26, 0xcb, 0xf4,
+	0xa5, 0xd2, 0x29, 0x9c, 0xae, 0x36, 0xc6, 0x1b, 0x47, 0x52, 0xd4, 0xb5, 0x7a, 0x08, 0x27, 0xa7,
+	0xfe, 0xa5, 0xc0, 0x55, 0x93, 0x27, 0x39, 0xc6, 0x9b, 0x47, 0x73, 0x94, 0x87, 0x07, 0xd0, 0x98,
+	0x68, 0xbb, 0xdb, 0x55, 0x73, 0x8b, 0x0c, 0xa3, 0x73, 0x14, 0x43, 0xad, 0xed, 0xc1, 0x72, 0xb9,
+	0x47, 0x5e, 0x3b, 0x7c, 0x7a, 0x81, 0x66, 0xac, 0x1f, 0x8b, 0xa6, 0x5c, 0x7d, 0x0c, 0xf5, 0xbc,
+	0x95, 0xbd, 0x58, 0x35, 0x57, 0x99, 0x8d, 0xb5, 0x97, 0x9a, 0x8b, 0x91, 0x99, 0x78, 0xb0, 0x2a,
+	0x23, 0x53, 0x64, 0x18, 0x9d, 0xa3, 0x18, 0x6a, 0xed, 0x21, 0x9c, 0xa9, 0x7e, 0x44, 0x2a, 0xcf,
+	0x46, 0x25, 0xd5, 0xb8, 0x72, 0x6c, 0xaa, 0x72, 0xfb, 0x05, 0x34, 0x0f, 0xbd, 0xb9, 0x6f, 0x57,
+	0x2d, 0x77, 0x18, 0xdb, 0x78, 0xe7, 0xaf, 0xb0, 0x8b, 0x07, 0xa2, 0xdc, 0x5b, 0x55, 0xa6, 0xa3,
+	0x44, 0x33, 0xd6, 0x8f, 0x45, 0x2b, 0xba, 0x2a, 0x57, 0xf9, 0xb5, 0xea, 0x04, 0x4d, 0xd1, 0x8c,
+	0xf5, 0x63, 0xd1, 0x32, 0x57, 0xe6, 0xfd, 0x67, 0x07, 0x2d, 0xed, 0xf9, 0x41, 0x4b, 0xfb, 0xed,
+	0xa0, 0xa5, 0x7d, 0xfd, 0xa2, 0x35, 0xf3, 0xfc, 0x45, 0x6b, 0xe6, 0xe7, 0x17, 0xad, 0x99, 0x07,
+	0x37, 0x0a, 0xdd, 0xc1, 0xa6, 0xfc, 0x80, 0x20, 0x57, 0x16, 0xdd, 0x81, 0x1b, 0xf4, 0x89, 0xef,
+	0x66, 0x6d, 0xc3, 0x93, 0xfc, 0xdb, 0x82, 0x68, 0x1b, 0x76, 0xe7, 0xc5, 0x97, 0x85, 0x6b, 0x7f,
+	0x0e, 0x00, 0x5f, 0x2b, 0xe8, 0xb6, 0xbe, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Install a JavaScript sources bundle on the chain's SwingSet controller.
+	InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error)
+	// Send inbound messages.
+	DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error)
+	// Perform a low-privilege wallet action.
+	WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error)
+	// Perform a wallet action that spends assets.
+	WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error)
+	// Provision a new endpoint.
+	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
+	// Replace the module parameters.  Only the governance authority may do so.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Register a sender whose messages are given high priority.  Only the
+	// governance authority may do so.
+	AddHighPrioritySender(ctx context.Context, in *MsgAddHighPrioritySender, opts ...grpc.CallOption) (*MsgAddHighPrioritySenderResponse, error)
+	// Unregister a high priority sender.  Only the governance authority may do
+	// so.
+	RemoveHighPrioritySender(ctx context.Context, in *MsgRemoveHighPrioritySender, opts ...grpc.CallOption) (*MsgRemoveHighPrioritySenderResponse, error)
+	// Start a bundle installation whose contents are sent in several chunks.
+	BeginBundleUpload(ctx context.Context, in *MsgBeginBundleUpload, opts ...grpc.CallOption) (*MsgBeginBundleUploadResponse, error)
+	// Send one chunk of a bundle upload.  The bundle is installed once all of
+	// its chunks have arrived.
+	UploadBundleChunk(ctx context.Context, in *MsgUploadBundleChunk, opts ...grpc.CallOption) (*MsgUploadBundleChunkResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error) {
+	out := new(MsgInstallBundleResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/InstallBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error) {
+	out := new(MsgDeliverInboundResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/DeliverInbound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UncompressedSize != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CompressedBundle) > 0 {
-		i -= len(m.CompressedBundle)
-		copy(dAtA[i:], m.CompressedBundle)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error) {
+	out := new(MsgWalletActionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error) {
+	out := new(MsgWalletSpendActionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletSpendAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Bundle) > 0 {
-		i -= len(m.Bundle)
-		copy(dAtA[i:], m.Bundle)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Bundle)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error) {
+	out := new(MsgProvisionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Provision", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInstallBundleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgInstallBundleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddHighPrioritySender(ctx context.Context, in *MsgAddHighPrioritySender, opts ...grpc.CallOption) (*MsgAddHighPrioritySenderResponse, error) {
+	out := new(MsgAddHighPrioritySenderResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/AddHighPrioritySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInstallBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RemoveHighPrioritySender(ctx context.Context, in *MsgRemoveHighPrioritySender, opts ...grpc.CallOption) (*MsgRemoveHighPrioritySenderResponse, error) {
+	out := new(MsgRemoveHighPrioritySenderResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/RemoveHighPrioritySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) BeginBundleUpload(ctx context.Context, in *MsgBeginBundleUpload, opts ...grpc.CallOption) (*MsgBeginBundleUploadResponse, error) {
+	out := new(MsgBeginBundleUploadResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/BeginBundleUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgDeliverInbound) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) UploadBundleChunk(ctx context.Context, in *MsgUploadBundleChunk, opts ...grpc.CallOption) (*MsgUploadBundleChunkResponse, error) {
+	out := new(MsgUploadBundleChunkResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/UploadBundleChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Install a JavaScript sources bundle on the chain's SwingSet controller.
+	InstallBundle(context.Context, *MsgInstallBundle) (*MsgInstallBundleResponse, error)
+	// Send inbound messages.
+	DeliverInbound(context.Context, *MsgDeliverInbound) (*MsgDeliverInboundResponse, error)
+	// Perform a low-privilege wallet action.
+	WalletAction(context.Context, *MsgWalletAction) (*MsgWalletActionResponse, error)
+	// Perform a wallet action that spends assets.
+	WalletSpendAction(context.Context, *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error)
+	// Provision a new endpoint.
+	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
+	// Replace the module parameters.  Only the governance authority may do so.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Register a sender whose messages are given high priority.  Only the
+	// governance authority may do so.
+	AddHighPrioritySender(context.Context, *MsgAddHighPrioritySender) (*MsgAddHighPrioritySenderResponse, error)
+	// Unregister a high priority sender.  Only the governance authority may do
+	// so.
+	RemoveHighPrioritySender(context.Context, *MsgRemoveHighPrioritySender) (*MsgRemoveHighPrioritySenderResponse, error)
+	// Start a bundle installation whose contents are sent in several chunks.
+	BeginBundleUpload(context.Context, *MsgBeginBundleUpload) (*MsgBeginBundleUploadResponse, error)
+	// Send one chunk of a bundle upload.  The bundle is installed once all of
+	// its chunks have arrived.
+	UploadBundleChunk(context.Context, *MsgUploadBundleChunk) (*MsgUploadBundleChunkResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) InstallBundle(ctx context.Context, req *MsgInstallBundle) (*MsgInstallBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallBundle not implemented")
+}
+func (*UnimplementedMsgServer) DeliverInbound(ctx context.Context, req *MsgDeliverInbound) (*MsgDeliverInboundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverInbound not implemented")
+}
+func (*UnimplementedMsgServer) WalletAction(ctx context.Context, req *MsgWalletAction) (*MsgWalletActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletAction not implemented")
+}
+func (*UnimplementedMsgServer) WalletSpendAction(ctx context.Context, req *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletSpendAction not implemented")
+}
+func (*UnimplementedMsgServer) Provision(ctx context.Context, req *MsgProvision) (*MsgProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddHighPrioritySender(ctx context.Context, req *MsgAddHighPrioritySender) (*MsgAddHighPrioritySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHighPrioritySender not implemented")
+}
+func (*UnimplementedMsgServer) RemoveHighPrioritySender(ctx context.Context, req *MsgRemoveHighPrioritySender) (*MsgRemoveHighPrioritySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHighPrioritySender not implemented")
+}
+func (*UnimplementedMsgServer) BeginBundleUpload(ctx context.Context, req *MsgBeginBundleUpload) (*MsgBeginBundleUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginBundleUpload not implemented")
+}
+func (*UnimplementedMsgServer) UploadBundleChunk(ctx context.Context, req *MsgUploadBundleChunk) (*MsgUploadBundleChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadBundleChunk not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_InstallBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstallBundle)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Nums) > 0 {
-		l = 0
-		for _, e := range m.Nums {
-			l += sovMsgs(uint64(e))
-		}
-		n += 1 + sovMsgs(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(MsgServer).InstallBundle(ctx, in)
 	}
-	if m.Ack != 0 {
-		n += 1 + sovMsgs(uint64(m.Ack))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/InstallBundle",
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstallBundle(ctx, req.(*MsgInstallBundle))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeliverInboundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeliverInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeliverInbound)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DeliverInbound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/DeliverInbound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeliverInbound(ctx, req.(*MsgDeliverInbound))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletAction) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WalletAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WalletAction(ctx, in)
 	}
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletAction",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletAction(ctx, req.(*MsgWalletAction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WalletSpendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletSpendAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).WalletSpendAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletSpendAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletSpendAction(ctx, req.(*MsgWalletSpendAction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletSpendAction) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProvision)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Provision(ctx, in)
 	}
-	l = len(m.SpendAction)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/Provision",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Provision(ctx, req.(*MsgProvision))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletSpendActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProvision) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddHighPrioritySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddHighPrioritySender)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Nickname)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddHighPrioritySender(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/AddHighPrioritySender",
 	}
-	if len(m.PowerFlags) > 0 {
-		for _, s := range m.PowerFlags {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddHighPrioritySender(ctx, req.(*MsgAddHighPrioritySender))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveHighPrioritySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveHighPrioritySender)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveHighPrioritySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/RemoveHighPrioritySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveHighPrioritySender(ctx, req.(*MsgRemoveHighPrioritySender))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginBundleUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginBundleUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginBundleUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/BeginBundleUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginBundleUpload(ctx, req.(*MsgBeginBundleUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UploadBundleChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUploadBundleChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UploadBundleChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/UploadBundleChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UploadBundleChunk(ctx, req.(*MsgUploadBundleChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.swingset.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InstallBundle",
+			Handler:    _Msg_InstallBundle_Handler,
+		},
+		{
+			MethodName: "DeliverInbound",
+			Handler:    _Msg_DeliverInbound_Handler,
+		},
+		{
+			MethodName: "WalletAction",
+			Handler:    _Msg_WalletAction_Handler,
+		},
+		{
+			MethodName: "WalletSpendAction",
+			Handler:    _Msg_WalletSpendAction_Handler,
+		},
+		{
+			MethodName: "Provision",
+			Handler:    _Msg_Provision_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddHighPrioritySender",
+			Handler:    _Msg_AddHighPrioritySender_Handler,
+		},
+		{
+			MethodName: "RemoveHighPrioritySender",
+			Handler:    _Msg_RemoveHighPrioritySender_Handler,
+		},
+		{
+			MethodName: "BeginBundleUpload",
+			Handler:    _Msg_BeginBundleUpload_Handler,
+		},
+		{
+			MethodName: "UploadBundleChunk",
+			Handler:    _Msg_UploadBundleChunk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/swingset/msgs.proto",
+}
+
+func (m *MsgDeliverInbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverInbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Ack != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Ack))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nums) > 0 {
+		dAtA2 := make([]byte, len(m.Nums)*10)
+		var j1 int
+		for _, num := range m.Nums {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMsgs(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverInboundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverInboundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletSpendAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletSpendAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletSpendAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendAction) > 0 {
+		i -= len(m.SpendAction)
+		copy(dAtA[i:], m.SpendAction)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SpendAction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletSpendActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletSpendActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletSpendActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CompressedBundle) > 0 {
+		i -= len(m.CompressedBundle)
+		copy(dAtA[i:], m.CompressedBundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bundle) > 0 {
+		i -= len(m.Bundle)
+		copy(dAtA[i:], m.Bundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Bundle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginBundleUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginBundleUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginBundleUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginBundleUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginBundleUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginBundleUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadBundleChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadBundleChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadBundleChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadBundleChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadBundleChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadBundleChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddHighPrioritySender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddHighPrioritySender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddHighPrioritySender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddHighPrioritySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddHighPrioritySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddHighPrioritySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHighPrioritySender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHighPrioritySender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHighPrioritySender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHighPrioritySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHighPrioritySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHighPrioritySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeliverInbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.Nums) > 0 {
+		l = 0
+		for _, e := range m.Nums {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	if m.Ack != 0 {
+		n += 1 + sovMsgs(uint64(m.Ack))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeliverInboundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWalletActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletSpendAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SpendAction)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWalletSpendActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.PowerFlags) > 0 {
+		for _, s := range m.PowerFlags {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstallBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bundle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CompressedBundle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+	}
+	return n
+}
+
+func (m *MsgInstallBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBeginBundleUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovMsgs(uint64(m.TotalSize))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovMsgs(uint64(m.ChunkCount))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+	}
+	return n
+}
+
+func (m *MsgBeginBundleUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUploadBundleChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovMsgs(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUploadBundleChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Completed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddHighPrioritySender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddHighPrioritySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveHighPrioritySender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveHighPrioritySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverInbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nums = append(m.Nums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nums) == 0 {
+					m.Nums = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nums = append(m.Nums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nums", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			m.Ack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ack |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverInboundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletSpendAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletSpendAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletSpendActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletSpendActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nickname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBundle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedBundle = append(m.CompressedBundle[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedBundle == nil {
+				m.CompressedBundle = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgProvisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgInstallBundle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bundle)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.CompressedBundle)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.UncompressedSize != 0 {
-		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgInstallBundleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginBundleUpload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,15 +3718,49 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverInbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginBundleUpload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginBundleUpload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1428,89 +3788,32 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			m.PayloadHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMsgs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nums = append(m.Nums, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMsgs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMsgs
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMsgs
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nums) == 0 {
-					m.Nums = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMsgs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nums = append(m.Nums, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nums", wireType)
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
 			}
-			m.Ack = 0
+			m.ChunkCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1520,16 +3823,16 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ack |= uint64(b&0x7F) << shift
+				m.ChunkCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
 			}
-			var byteLen int
+			m.UncompressedSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1539,26 +3842,11 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.UncompressedSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1580,7 +3868,7 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginBundleUploadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1603,10 +3891,10 @@ func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverInboundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginBundleUploadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginBundleUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1630,7 +3918,7 @@ func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadBundleChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,15 +3941,15 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadBundleChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadBundleChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1688,14 +3976,14 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,7 +4011,60 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.PayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1746,7 +4087,7 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadBundleChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1769,12 +4110,32 @@ func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadBundleChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadBundleChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1796,7 +4157,7 @@ func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1819,17 +4180,17 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletSpendAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletSpendAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1839,31 +4200,29 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1873,23 +4232,24 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendAction = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1912,7 +4272,7 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1935,10 +4295,10 @@ func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletSpendActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletSpendActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1962,7 +4322,7 @@ func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProvision) Unmarshal(dAtA []byte) error {
+func (m *MsgAddHighPrioritySender) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,15 +4345,15 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProvision: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddHighPrioritySender: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddHighPrioritySender: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2021,13 +4381,13 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nickname = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2037,29 +4397,27 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2087,41 +4445,7 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2144,7 +4468,7 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddHighPrioritySenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,10 +4491,10 @@ func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddHighPrioritySenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddHighPrioritySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2194,7 +4518,7 @@ func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveHighPrioritySender) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2217,15 +4541,15 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstallBundle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveHighPrioritySender: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstallBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveHighPrioritySender: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2253,13 +4577,13 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bundle = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2269,31 +4593,29 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2303,45 +4625,24 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompressedBundle = append(m.CompressedBundle[:0], dAtA[iNdEx:postIndex]...)
-			if m.CompressedBundle == nil {
-				m.CompressedBundle = []byte{}
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
-			}
-			m.UncompressedSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UncompressedSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2363,7 +4664,7 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveHighPrioritySenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,10 +4687,10 @@ func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstallBundleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveHighPrioritySenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstallBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveHighPrioritySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3,8 +3,10 @@ package types
 import (
 	"bytes"
 	"math"
+	"strings"
 	"testing"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/bundle/bundletest"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
@@ -262,3 +264,266 @@ func TestInstallBundle_Compress(t *testing.T) {
 		t.Errorf("wanted Uncompress error for high uncompressed size")
 	}
 }
+
+func TestUpdateParams_ValidateBasic(t *testing.T) {
+	badParams := DefaultParams()
+	badParams.BeansPerUnit = append(badParams.BeansPerUnit, StringBeans{Key: ""})
+
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgUpdateParams
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgUpdateParams{},
+			shouldErr: true,
+		},
+		{
+			name:      "bad authority",
+			msg:       NewMsgUpdateParams("agoric1bogus", DefaultParams()),
+			shouldErr: true,
+		},
+		{
+			name: "default params",
+			msg:  NewMsgUpdateParams(addr.String(), DefaultParams()),
+		},
+		{
+			name:      "empty params",
+			msg:       NewMsgUpdateParams(addr.String(), Params{}),
+			shouldErr: true,
+		},
+		{
+			name:      "bad params",
+			msg:       NewMsgUpdateParams(addr.String(), badParams),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+
+	msg := NewMsgUpdateParams(addr.String(), DefaultParams())
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(addr) {
+		t.Errorf("got signers %v, want [%s]", signers, addr)
+	}
+}
+
+func TestHighPrioritySender_ValidateBasic(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       sdk.Msg
+		shouldErr bool
+	}{
+		{
+			name: "add",
+			msg:  NewMsgAddHighPrioritySender(addr.String(), addr, "oracle"),
+		},
+		{
+			name: "remove",
+			msg:  NewMsgRemoveHighPrioritySender(addr.String(), addr, "keeper_1"),
+		},
+		{
+			name:      "empty",
+			msg:       &MsgAddHighPrioritySender{},
+			shouldErr: true,
+		},
+		{
+			name:      "bad address",
+			msg:       &MsgAddHighPrioritySender{Authority: addr.String(), Address: "nope", Namespace: "oracle"},
+			shouldErr: true,
+		},
+		{
+			name:      "empty namespace",
+			msg:       NewMsgRemoveHighPrioritySender(addr.String(), addr, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "bad namespace",
+			msg:       NewMsgAddHighPrioritySender(addr.String(), addr, "an oracle"),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestBundleUpload_ValidateBasic(t *testing.T) {
+	payload := []byte("0123456789")
+	begin, chunks, err := NewBundleUploadMsgs(payload, 0, 4, addr)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if begin.ChunkCount != 3 || len(chunks) != 3 {
+		t.Fatalf("got %d chunks declared and %d made, want 3", begin.ChunkCount, len(chunks))
+	}
+	if got := string(chunks[2].Data); got != "89" {
+		t.Errorf("got last chunk %q, want %q", got, "89")
+	}
+
+	for _, tt := range []struct {
+		name      string
+		msg       sdk.Msg
+		shouldErr bool
+	}{
+		{
+			name: "begin",
+			msg:  begin,
+		},
+		{
+			name: "chunk",
+			msg:  chunks[0],
+		},
+		{
+			name:      "empty begin",
+			msg:       &MsgBeginBundleUpload{},
+			shouldErr: true,
+		},
+		{
+			name:      "empty chunk",
+			msg:       &MsgUploadBundleChunk{},
+			shouldErr: true,
+		},
+		{
+			name: "uppercase hash",
+			msg: &MsgBeginBundleUpload{
+				Submitter:   addr,
+				PayloadHash: strings.ToUpper(begin.PayloadHash),
+				TotalSize:   10,
+				ChunkCount:  3,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "more chunks than bytes",
+			msg: &MsgBeginBundleUpload{
+				Submitter:   addr,
+				PayloadHash: begin.PayloadHash,
+				TotalSize:   10,
+				ChunkCount:  11,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "too many chunks",
+			msg: &MsgBeginBundleUpload{
+				Submitter:   addr,
+				PayloadHash: begin.PayloadHash,
+				TotalSize:   bundleUncompressedSizeLimit - 1,
+				ChunkCount:  MaxBundleUploadChunks + 1,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "too large",
+			msg: &MsgBeginBundleUpload{
+				Submitter:   addr,
+				PayloadHash: begin.PayloadHash,
+				TotalSize:   bundleUncompressedSizeLimit,
+				ChunkCount:  1,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "empty chunk data",
+			msg: &MsgUploadBundleChunk{
+				Submitter:   addr,
+				PayloadHash: begin.PayloadHash,
+				Index:       1,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "chunk index out of range",
+			msg: &MsgUploadBundleChunk{
+				Submitter:   addr,
+				PayloadHash: begin.PayloadHash,
+				Index:       MaxBundleUploadChunks,
+				Data:        []byte{1},
+			},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestInstallBundle_BundleID(t *testing.T) {
+	bundle, wantID := bundletest.NewBundleJSON("42")
+
+	compressed := NewMsgInstallBundle(bundle, addr)
+	if err := compressed.Compress(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		msg    *MsgInstallBundle
+		wantID string
+	}{
+		{
+			name:   "uncompressed",
+			msg:    NewMsgInstallBundle(bundle, addr),
+			wantID: wantID,
+		},
+		{
+			name:   "compressed",
+			msg:    compressed,
+			wantID: wantID,
+		},
+		{
+			name: "not JSON",
+			msg:  NewMsgInstallBundle("true", addr),
+		},
+		{
+			name: "other format",
+			msg:  NewMsgInstallBundle(`{"moduleFormat":"getExport","source":""}`, addr),
+		},
+		{
+			name: "hash mismatch",
+			msg:  NewMsgInstallBundle(strings.Replace(bundle, wantID[3:], strings.Repeat("0", 128), 1), addr),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := tt.msg.BundleID()
+			if ok != (tt.wantID != "") || id != tt.wantID {
+				t.Errorf("got bundle ID %q, %v, want %q", id, ok, tt.wantID)
+			}
+		})
+	}
+	if len(compressed.CompressedBundle) == 0 || compressed.Bundle != "" {
+		t.Errorf("BundleID modified the message")
+	}
+
+	// ValidateBasic checks only the shape of an endoZipBase64 bundle.
+	if err := NewMsgInstallBundle(strings.Replace(bundle, wantID[3:], strings.Repeat("0", 128), 1), addr).ValidateBasic(); err != nil {
+		t.Errorf("unexpected validation error %s", err)
+	}
+	if err := NewMsgInstallBundle(strings.Replace(bundle, wantID[3:], "nope", 1), addr).ValidateBasic(); err == nil {
+		t.Errorf("wanted validation error for malformed hash")
+	}
+}
@@ -0,0 +1,777 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: agoric/swingset/node.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SwingStoreExportStatusRequest is the request type for the
+// Node/SwingStoreExportStatus RPC method.
+type SwingStoreExportStatusRequest struct {
+}
+
+func (m *SwingStoreExportStatusRequest) Reset()         { *m = SwingStoreExportStatusRequest{} }
+func (m *SwingStoreExportStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreExportStatusRequest) ProtoMessage()    {}
+func (*SwingStoreExportStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a842930a2285dbc4, []int{0}
+}
+func (m *SwingStoreExportStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreExportStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreExportStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwingStoreExportStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreExportStatusRequest.Merge(m, src)
+}
+func (m *SwingStoreExportStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreExportStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreExportStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwingStoreExportStatusRequest proto.InternalMessageInfo
+
+// SwingStoreExportStatusResponse is the response type for the
+// Node/SwingStoreExportStatus RPC method.  The fields other than active are
+// only set if an operation is in progress.
+type SwingStoreExportStatusResponse struct {
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// is_restore indicates whether the operation is a restore instead of an
+	// export.
+	IsRestore bool `protobuf:"varint,2,opt,name=is_restore,json=isRestore,proto3" json:"is_restore,omitempty"`
+	// block_height is the block height of the operation, or 0 for an export of
+	// the latest height.
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// phase is the current phase of the operation, such as "preparing",
+	// "started", "retrieving", "restoring" or "aborting".
+	Phase     string        `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	StartTime time.Time     `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	Elapsed   time.Duration `protobuf:"bytes,6,opt,name=elapsed,proto3,stdduration" json:"elapsed"`
+}
+
+func (m *SwingStoreExportStatusResponse) Reset()         { *m = SwingStoreExportStatusResponse{} }
+func (m *SwingStoreExportStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreExportStatusResponse) ProtoMessage()    {}
+func (*SwingStoreExportStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a842930a2285dbc4, []int{1}
+}
+func (m *SwingStoreExportStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreExportStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreExportStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwingStoreExportStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreExportStatusResponse.Merge(m, src)
+}
+func (m *SwingStoreExportStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreExportStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreExportStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwingStoreExportStatusResponse proto.InternalMessageInfo
+
+func (m *SwingStoreExportStatusResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *SwingStoreExportStatusResponse) GetIsRestore() bool {
+	if m != nil {
+		return m.IsRestore
+	}
+	return false
+}
+
+func (m *SwingStoreExportStatusResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SwingStoreExportStatusResponse) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *SwingStoreExportStatusResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *SwingStoreExportStatusResponse) GetElapsed() time.Duration {
+	if m != nil {
+		return m.Elapsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SwingStoreExportStatusRequest)(nil), "agoric.swingset.SwingStoreExportStatusRequest")
+	proto.RegisterType((*SwingStoreExportStatusResponse)(nil), "agoric.swingset.SwingStoreExportStatusResponse")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/node.proto", fileDescriptor_a842930a2285dbc4) }
+
+var fileDescriptor_a842930a2285dbc4 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x21, 0x0d, 0xcd, 0x15, 0x09, 0xe9, 0x54, 0x55, 0xc6, 0xa2, 0x4e, 0xc8, 0x94,
+	0x05, 0x9f, 0x28, 0x03, 0x03, 0x62, 0xa0, 0x80, 0xc4, 0xc4, 0xe0, 0xc0, 0xc2, 0x62, 0x5d, 0xec,
+	0xe3, 0x72, 0x6a, 0xe2, 0x67, 0xfc, 0x9e, 0xa1, 0xac, 0x7c, 0x82, 0x4a, 0x30, 0xf0, 0x39, 0x58,
+	0xf9, 0x02, 0x1d, 0x2b, 0xb1, 0x30, 0x01, 0x4a, 0xf8, 0x20, 0xc8, 0x77, 0x8e, 0x90, 0x4a, 0x41,
+	0xdd, 0xfc, 0xde, 0xef, 0xff, 0xfe, 0xbe, 0xf7, 0xbf, 0xe3, 0xa1, 0x32, 0x50, 0xd9, 0x4c, 0xe2,
+	0x5b, 0x5b, 0x18, 0xd4, 0x24, 0x0b, 0xc8, 0x75, 0x5c, 0x56, 0x40, 0x20, 0xae, 0x7b, 0x16, 0x6f,
+	0x58, 0xb8, 0x6b, 0xc0, 0x80, 0x63, 0xb2, 0xf9, 0xf2, 0xb2, 0xf0, 0xa6, 0x01, 0x30, 0x0b, 0x2d,
+	0x55, 0x69, 0xa5, 0x2a, 0x0a, 0x20, 0x45, 0x16, 0x0a, 0x6c, 0x69, 0xd4, 0x52, 0x57, 0xcd, 0xea,
+	0x57, 0x32, 0xaf, 0x2b, 0x27, 0x68, 0xf9, 0xf0, 0x3c, 0x27, 0xbb, 0xd4, 0x48, 0x6a, 0x59, 0x7a,
+	0xc1, 0x78, 0xc8, 0xf7, 0xa7, 0xcd, 0x01, 0xa6, 0x04, 0x95, 0x7e, 0x72, 0x5c, 0x42, 0x45, 0x53,
+	0x52, 0x54, 0x63, 0xa2, 0x5f, 0xd7, 0x1a, 0x69, 0xfc, 0xb1, 0xcb, 0xa3, 0x7f, 0x29, 0xb0, 0x84,
+	0x02, 0xb5, 0xd8, 0xe3, 0x7d, 0x95, 0x91, 0x7d, 0xa3, 0x03, 0x36, 0x62, 0x93, 0xed, 0xa4, 0xad,
+	0xc4, 0x3e, 0xe7, 0x16, 0xd3, 0x4a, 0x63, 0x33, 0x19, 0x74, 0x1d, 0x1b, 0x58, 0x4c, 0x7c, 0x43,
+	0xdc, 0xe2, 0xd7, 0x66, 0x0b, 0xc8, 0x8e, 0xd2, 0xb9, 0xb6, 0x66, 0x4e, 0xc1, 0x95, 0x11, 0x9b,
+	0xf4, 0x92, 0x1d, 0xd7, 0x7b, 0xea, 0x5a, 0x62, 0x97, 0x6f, 0x95, 0x73, 0x85, 0x3a, 0xe8, 0x8d,
+	0xd8, 0x64, 0x90, 0xf8, 0x42, 0x3c, 0xe2, 0x1c, 0x49, 0x55, 0x94, 0x36, 0xcb, 0x04, 0x5b, 0x23,
+	0x36, 0xd9, 0x39, 0x08, 0x63, 0xbf, 0x69, 0xbc, 0xd9, 0x34, 0x7e, 0xbe, 0xd9, 0xf4, 0x70, 0xfb,
+	0xf4, 0xfb, 0xb0, 0x73, 0xf2, 0x63, 0xc8, 0x92, 0x81, 0x9b, 0x6b, 0x88, 0x78, 0xc0, 0xaf, 0xea,
+	0x85, 0x2a, 0x51, 0xe7, 0x41, 0xdf, 0x39, 0xdc, 0xf8, 0xcb, 0xe1, 0x71, 0x9b, 0xa5, 0x37, 0xf8,
+	0xd4, 0x18, 0x6c, 0x66, 0x0e, 0xbe, 0x30, 0xde, 0x7b, 0x06, 0xb9, 0x16, 0x9f, 0x19, 0xdf, 0xbb,
+	0x38, 0x1f, 0x11, 0xc7, 0xe7, 0xae, 0x38, 0xfe, 0x6f, 0xd4, 0xa1, 0xbc, 0xb4, 0xde, 0x07, 0x3f,
+	0xbe, 0xf7, 0xfe, 0xeb, 0xaf, 0x0f, 0xdd, 0x3b, 0x42, 0xca, 0x8b, 0xde, 0x99, 0xaf, 0x52, 0x17,
+	0x76, 0xaa, 0xdd, 0x7c, 0x8a, 0xce, 0xe0, 0xf0, 0xc5, 0xe9, 0x2a, 0x62, 0x67, 0xab, 0x88, 0xfd,
+	0x5c, 0x45, 0xec, 0x64, 0x1d, 0x75, 0xce, 0xd6, 0x51, 0xe7, 0xdb, 0x3a, 0xea, 0xbc, 0xbc, 0x6f,
+	0x2c, 0xcd, 0xeb, 0x59, 0x9c, 0xc1, 0x52, 0x3e, 0xf4, 0xa6, 0xde, 0xfb, 0x36, 0xe6, 0x47, 0xd2,
+	0xc0, 0x42, 0x15, 0x46, 0x66, 0x80, 0x4b, 0x40, 0x79, 0xfc, 0xe7, 0x7f, 0xf4, 0xae, 0xd4, 0x38,
+	0xeb, 0xbb, 0xe8, 0xee, 0xfe, 0x1e, 0x00, 0xac, 0xc2, 0xcd, 0xf4, 0xf7, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	// SwingStoreExportStatus reports the swing-store export or restore in
+	// progress on the node, if any.
+	SwingStoreExportStatus(ctx context.Context, in *SwingStoreExportStatusRequest, opts ...grpc.CallOption) (*SwingStoreExportStatusResponse, error)
+}
+
+type nodeClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewNodeClient(cc grpc1.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) SwingStoreExportStatus(ctx context.Context, in *SwingStoreExportStatusRequest, opts ...grpc.CallOption) (*SwingStoreExportStatusResponse, error) {
+	out := new(SwingStoreExportStatusResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Node/SwingStoreExportStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	// SwingStoreExportStatus reports the swing-store export or restore in
+	// progress on the node, if any.
+	SwingStoreExportStatus(context.Context, *SwingStoreExportStatusRequest) (*SwingStoreExportStatusResponse, error)
+}
+
+// UnimplementedNodeServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeServer struct {
+}
+
+func (*UnimplementedNodeServer) SwingStoreExportStatus(ctx context.Context, req *SwingStoreExportStatusRequest) (*SwingStoreExportStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwingStoreExportStatus not implemented")
+}
+
+func RegisterNodeServer(s grpc1.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_SwingStoreExportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwingStoreExportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SwingStoreExportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Node/SwingStoreExportStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SwingStoreExportStatus(ctx, req.(*SwingStoreExportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.swingset.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SwingStoreExportStatus",
+			Handler:    _Node_SwingStoreExportStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/swingset/node.proto",
+}
+
+func (m *SwingStoreExportStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreExportStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreExportStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SwingStoreExportStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreExportStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreExportStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Elapsed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Elapsed):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintNode(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintNode(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsRestore {
+		i--
+		if m.IsRestore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNode(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwingStoreExportStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SwingStoreExportStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	if m.IsRestore {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovNode(uint64(m.BlockHeight))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovNode(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Elapsed)
+	n += 1 + l + sovNode(uint64(l))
+	return n
+}
+
+func sovNode(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNode(x uint64) (n int) {
+	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwingStoreExportStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwingStoreExportStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwingStoreExportStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwingStoreExportStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwingStoreExportStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwingStoreExportStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRestore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRestore = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Elapsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNode(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNode
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNode
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNode
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNode        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNode          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNode = fmt.Errorf("proto: unexpected end of group")
+)
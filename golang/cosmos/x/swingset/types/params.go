@@ -53,6 +53,10 @@ func DefaultParams() Params {
 		FeeUnitPrice:       DefaultFeeUnitPrice,
 		PowerFlagFees:      DefaultPowerFlagFees,
 		QueueMax:           DefaultQueueMax,
+		FeeMarket:          DefaultFeeMarket(),
+		InboundRateLimit:   DefaultInboundRateLimit(),
+
+		BundleUploadExpiryBlocks: DefaultBundleUploadExpiryBlocks,
 	}
 }
 
@@ -89,6 +93,15 @@ func (p Params) ValidateBasic() error {
 	if err := validateQueueMax(p.QueueMax); err != nil {
 		return err
 	}
+	if err := validateFeeMarket(p.FeeMarket); err != nil {
+		return err
+	}
+	if err := validateInboundRateLimit(p.InboundRateLimit); err != nil {
+		return err
+	}
+	if p.BundleUploadExpiryBlocks > MaxBundleUploadExpiryBlocks {
+		return fmt.Errorf("bundle upload expiry blocks must not exceed %d: %d", MaxBundleUploadExpiryBlocks, p.BundleUploadExpiryBlocks)
+	}
 
 	return nil
 }
@@ -165,6 +178,59 @@ func validateQueueMax(i interface{}) error {
 	return nil
 }
 
+// isDecUnset returns whether a non-nullable Dec field was absent or zero.
+func isDecUnset(d sdk.Dec) bool {
+	return d.IsNil() || d.IsZero()
+}
+
+// IsUnset returns whether the fee market configuration was never populated,
+// as is the case for params that predate it.
+func (fm FeeMarket) IsUnset() bool {
+	return !fm.Enabled && fm.WindowBlocks == 0 &&
+		isDecUnset(fm.TargetOccupancy) && isDecUnset(fm.AdjustmentRate) &&
+		isDecUnset(fm.MinMultiplier) && isDecUnset(fm.MaxMultiplier)
+}
+
+func validateFeeMarket(fm FeeMarket) error {
+	if fm.IsUnset() {
+		return nil
+	}
+	if fm.WindowBlocks == 0 {
+		return fmt.Errorf("fee market window blocks must be positive")
+	}
+	if fm.TargetOccupancy.IsNil() || !fm.TargetOccupancy.IsPositive() || fm.TargetOccupancy.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee market target occupancy must be in (0, 1]: %s", fm.TargetOccupancy)
+	}
+	if fm.AdjustmentRate.IsNil() || !fm.AdjustmentRate.IsPositive() || fm.AdjustmentRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee market adjustment rate must be in (0, 1]: %s", fm.AdjustmentRate)
+	}
+	if fm.MinMultiplier.IsNil() || !fm.MinMultiplier.IsPositive() {
+		return fmt.Errorf("fee market min multiplier must be positive: %s", fm.MinMultiplier)
+	}
+	if fm.MaxMultiplier.IsNil() || fm.MaxMultiplier.LT(fm.MinMultiplier) {
+		return fmt.Errorf("fee market max multiplier %s must not be less than min multiplier %s", fm.MaxMultiplier, fm.MinMultiplier)
+	}
+	return nil
+}
+
+// IsEnabled returns whether the inbound rate limit should be enforced.
+func (rl InboundRateLimit) IsEnabled() bool {
+	return rl.WindowBlocks > 0
+}
+
+func validateInboundRateLimit(rl InboundRateLimit) error {
+	if !rl.IsEnabled() {
+		return nil
+	}
+	if rl.WindowBlocks > MaxInboundRateLimitWindowBlocks {
+		return fmt.Errorf("inbound rate limit window blocks must not exceed %d: %d", MaxInboundRateLimitWindowBlocks, rl.WindowBlocks)
+	}
+	if rl.MaxMessages == 0 {
+		return fmt.Errorf("inbound rate limit max messages must be positive when enabled")
+	}
+	return nil
+}
+
 // UpdateParams appends any missing params, configuring them to their defaults,
 // then returning the updated params or an error. Existing params are not
 // modified, regardless of their value, and they are not removed if they no
@@ -186,6 +252,9 @@ func UpdateParams(params Params) (Params, error) {
 	params.BeansPerUnit = newBpu
 	params.PowerFlagFees = newPff
 	params.QueueMax = newQm
+	if params.FeeMarket.IsUnset() {
+		params.FeeMarket = DefaultFeeMarket()
+	}
 	return params, nil
 }
 
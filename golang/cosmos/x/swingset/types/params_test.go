@@ -105,6 +105,7 @@ func TestUpdateParams(t *testing.T) {
 		FeeUnitPrice:       sdk.NewCoins(sdk.NewInt64Coin("denom", 789)),
 		PowerFlagFees:      DefaultPowerFlagFees,
 		QueueMax:           DefaultQueueMax,
+		FeeMarket:          DefaultFeeMarket(),
 	}
 	got, err := UpdateParams(in)
 	if err != nil {
@@ -114,3 +115,61 @@ func TestUpdateParams(t *testing.T) {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
+
+func TestValidateFeeMarket(t *testing.T) {
+	dec := sdk.MustNewDecFromStr
+	for _, tt := range []struct {
+		name      string
+		mutate    func(fm *FeeMarket)
+		shouldErr bool
+	}{
+		{name: "default", mutate: func(fm *FeeMarket) {}},
+		{name: "unset", mutate: func(fm *FeeMarket) { *fm = FeeMarket{} }},
+		{name: "enabled", mutate: func(fm *FeeMarket) { fm.Enabled = true }},
+		{name: "zero_window", mutate: func(fm *FeeMarket) { fm.WindowBlocks = 0 }, shouldErr: true},
+		{name: "zero_target", mutate: func(fm *FeeMarket) { fm.TargetOccupancy = dec("0") }, shouldErr: true},
+		{name: "target_too_big", mutate: func(fm *FeeMarket) { fm.TargetOccupancy = dec("1.1") }, shouldErr: true},
+		{name: "negative_rate", mutate: func(fm *FeeMarket) { fm.AdjustmentRate = dec("-0.1") }, shouldErr: true},
+		{name: "zero_min", mutate: func(fm *FeeMarket) { fm.MinMultiplier = dec("0") }, shouldErr: true},
+		{name: "max_below_min", mutate: func(fm *FeeMarket) { fm.MaxMultiplier = dec("0.5") }, shouldErr: true},
+		{name: "min_below_one", mutate: func(fm *FeeMarket) { fm.MinMultiplier = dec("0.25") }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			tt.mutate(&params.FeeMarket)
+			err := params.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestValidateInboundRateLimit(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		rateLimit InboundRateLimit
+		shouldErr bool
+	}{
+		{name: "default", rateLimit: DefaultInboundRateLimit()},
+		{name: "disabled_with_max", rateLimit: InboundRateLimit{WindowBlocks: 0, MaxMessages: 5}},
+		{name: "enabled", rateLimit: InboundRateLimit{WindowBlocks: 10, MaxMessages: 5}},
+		{name: "zero_max", rateLimit: InboundRateLimit{WindowBlocks: 10}, shouldErr: true},
+		{name: "window_too_big", rateLimit: InboundRateLimit{WindowBlocks: MaxInboundRateLimitWindowBlocks + 1, MaxMessages: 5}, shouldErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.InboundRateLimit = tt.rateLimit
+			err := params.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
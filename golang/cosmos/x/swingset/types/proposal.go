@@ -1,7 +1,6 @@
 package types
 
 import (
-	"encoding/json"
 	"strings"
 
 	sdkioerrors "cosmossdk.io/errors"
@@ -53,10 +52,17 @@ func (cep *CoreEvalProposal) ValidateBasic() error {
 	if len(cep.Evals) == 0 {
 		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "no core evals provided")
 	}
+	// The outcome of each core eval is recorded under its content hash.
+	seen := make(map[string]int, len(cep.Evals))
 	for i, eval := range cep.Evals {
 		if err := eval.ValidateBasic(); err != nil {
 			return sdkioerrors.Wrapf(err, "invalid core eval %d", i)
 		}
+		contentHash := eval.ContentHash()
+		if first, found := seen[contentHash]; found {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "core eval %d duplicates core eval %d", i, first)
+		}
+		seen[contentHash] = i
 	}
 
 	return nil
@@ -65,9 +71,7 @@ func (cep *CoreEvalProposal) ValidateBasic() error {
 // ValidateBasic runs basic stateless validity checks
 func (ce CoreEval) ValidateBasic() error {
 	// Check the permits.
-	var rm json.RawMessage
-	err := json.Unmarshal([]byte(ce.JsonPermits), &rm)
-	if err != nil {
+	if err := ValidateCoreEvalPermit(ce.JsonPermits); err != nil {
 		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid permit.json: %s", err.Error())
 	}
 
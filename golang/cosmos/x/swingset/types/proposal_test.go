@@ -71,7 +71,7 @@ evals:
 `, text)
 	require.Nil(t, cep.ValidateBasic())
 
-	ce2 := CoreEval{JsonPermits: `{"a": true}`, JsCode: "bar"}
+	ce2 := CoreEval{JsonPermits: `{"consume": {"a": true}}`, JsCode: "bar"}
 	cep = NewCoreEvalProposal("test title", "test description", []CoreEval{ce2})
 	require.NoError(t, cep.ValidateBasic())
 
@@ -79,11 +79,66 @@ evals:
 	cep = NewCoreEvalProposal("test title", "test description", []CoreEval{ce3})
 	require.Error(t, cep.ValidateBasic())
 
-	ce4 := CoreEval{JsonPermits: `{"a": true}`, JsCode: ""}
+	ce4 := CoreEval{JsonPermits: `{"consume": {"a": true}}`, JsCode: ""}
 	cep = NewCoreEvalProposal("test title", "test description", []CoreEval{ce4})
 	require.Error(t, cep.ValidateBasic())
 
 	ce5 := CoreEval{JsonPermits: "BAD-JSON", JsCode: "bar"}
 	cep = NewCoreEvalProposal("test title", "test description", []CoreEval{ce5})
 	require.Error(t, cep.ValidateBasic())
+
+	// The same (permit, code) pair would have a single outcome record.
+	cep = NewCoreEvalProposal("test title", "test description", []CoreEval{ce1, ce2, ce1})
+	require.ErrorContains(t, cep.ValidateBasic(), "core eval 2 duplicates core eval 0")
+	ce6 := CoreEval{JsonPermits: ce2.JsonPermits, JsCode: ce2.JsCode + " "}
+	cep = NewCoreEvalProposal("test title", "test description", []CoreEval{ce2, ce6})
+	require.NoError(t, cep.ValidateBasic())
+}
+
+func TestCoreEvalPermit(t *testing.T) {
+	valid := []string{
+		`true`,
+		`"zoe"`,
+		`{}`,
+		`[]`,
+		`{"consume": {"zoe": "zoe", "board": true}, "produce": {"psmKit": true}}`,
+		`{"brand": {"consume": {"IST": true}, "produce": {"DAI": true}}, "issuer": true}`,
+		`{"vatPowers": {"D": true}, "evaluateBundleCap": true, "home": {"produce": {"x": true}}}`,
+		`{"vbankAsset": {"consume": {"ATOM": true}}, "namedVat": {"consume": {"zoe": true}}}`,
+	}
+	for _, permit := range valid {
+		ce := CoreEval{JsonPermits: permit, JsCode: "bar"}
+		require.NoError(t, ce.ValidateBasic(), permit)
+	}
+
+	invalid := []string{
+		``,
+		`{`,
+		`false`,
+		`null`,
+		`1`,
+		`true true`,
+		`{"consume": false}`,
+		`{"consume": {"zoe": 1}}`,
+		`{"consume": {"zoe": null}}`,
+		`{"brand": [true, false]}`,
+		`{"futurePower": true}`,
+		`{"consume": {"zoe": true}, "comsume": {"board": true}}`,
+		`[true]`,
+	}
+	for _, permit := range invalid {
+		ce := CoreEval{JsonPermits: permit, JsCode: "bar"}
+		require.Error(t, ce.ValidateBasic(), permit)
+	}
+}
+
+func TestCoreEvalContentHash(t *testing.T) {
+	ce := CoreEval{JsonPermits: "true", JsCode: "1"}
+	// sha256(sha256("true") || sha256("1"))
+	require.Equal(t, "7eb08302d653aef9a01de9d80a150ce73469e8704445880533d2e028d2097d79", ce.ContentHash())
+	require.Equal(t, "b5bea41b6c623f7c09f1bf24dcae58ebab3c0cdd90ad966bc43a45b44867e12b", ce.PermitHash())
+	require.Equal(t, "6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b", ce.CodeHash())
+
+	other := CoreEval{JsonPermits: "true1", JsCode: ""}
+	require.NotEqual(t, ce.ContentHash(), other.ContentHash())
 }
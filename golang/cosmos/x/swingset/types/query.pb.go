@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -113,23 +114,22 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-// QueryEgressRequest is the request type for the Query/Egress RPC method
-type QueryEgressRequest struct {
-	Peer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
+// QueryStateRequest is the request type for the Query/State RPC method.
+type QueryStateRequest struct {
 }
 
-func (m *QueryEgressRequest) Reset()         { *m = QueryEgressRequest{} }
-func (m *QueryEgressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEgressRequest) ProtoMessage()    {}
-func (*QueryEgressRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStateRequest) Reset()         { *m = QueryStateRequest{} }
+func (m *QueryStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRequest) ProtoMessage()    {}
+func (*QueryStateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_76266f656a1a9971, []int{2}
 }
-func (m *QueryEgressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEgressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -139,42 +139,36 @@ func (m *QueryEgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryEgressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEgressRequest.Merge(m, src)
+func (m *QueryStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRequest.Merge(m, src)
 }
-func (m *QueryEgressRequest) XXX_Size() int {
+func (m *QueryStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEgressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEgressRequest.DiscardUnknown(m)
+func (m *QueryStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEgressRequest proto.InternalMessageInfo
-
-func (m *QueryEgressRequest) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.Peer
-	}
-	return nil
-}
+var xxx_messageInfo_QueryStateRequest proto.InternalMessageInfo
 
-// QueryEgressResponse is the egress response.
-type QueryEgressResponse struct {
-	Egress *Egress `protobuf:"bytes,1,opt,name=egress,proto3" json:"egress,omitempty"`
+// QueryStateResponse is the response type for the Query/State RPC method.
+type QueryStateResponse struct {
+	// state defines the current state of the module.
+	State State `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
 }
 
-func (m *QueryEgressResponse) Reset()         { *m = QueryEgressResponse{} }
-func (m *QueryEgressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEgressResponse) ProtoMessage()    {}
-func (*QueryEgressResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStateResponse) Reset()         { *m = QueryStateResponse{} }
+func (m *QueryStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateResponse) ProtoMessage()    {}
+func (*QueryStateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_76266f656a1a9971, []int{3}
 }
-func (m *QueryEgressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEgressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -184,42 +178,43 @@ func (m *QueryEgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryEgressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEgressResponse.Merge(m, src)
+func (m *QueryStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateResponse.Merge(m, src)
 }
-func (m *QueryEgressResponse) XXX_Size() int {
+func (m *QueryStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEgressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEgressResponse.DiscardUnknown(m)
+func (m *QueryStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEgressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStateResponse proto.InternalMessageInfo
 
-func (m *QueryEgressResponse) GetEgress() *Egress {
+func (m *QueryStateResponse) GetState() State {
 	if m != nil {
-		return m.Egress
+		return m.State
 	}
-	return nil
+	return State{}
 }
 
-// QueryMailboxRequest is the mailbox query.
-type QueryMailboxRequest struct {
-	Peer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
+// QueryHighPrioritySendersRequest is the request type for the
+// Query/HighPrioritySenders RPC method.
+type QueryHighPrioritySendersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMailboxRequest) Reset()         { *m = QueryMailboxRequest{} }
-func (m *QueryMailboxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryMailboxRequest) ProtoMessage()    {}
-func (*QueryMailboxRequest) Descriptor() ([]byte, []int) {
+func (m *QueryHighPrioritySendersRequest) Reset()         { *m = QueryHighPrioritySendersRequest{} }
+func (m *QueryHighPrioritySendersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHighPrioritySendersRequest) ProtoMessage()    {}
+func (*QueryHighPrioritySendersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_76266f656a1a9971, []int{4}
 }
-func (m *QueryMailboxRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryHighPrioritySendersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMailboxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHighPrioritySendersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMailboxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHighPrioritySendersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -229,42 +224,44 @@ func (m *QueryMailboxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryMailboxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMailboxRequest.Merge(m, src)
+func (m *QueryHighPrioritySendersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHighPrioritySendersRequest.Merge(m, src)
 }
-func (m *QueryMailboxRequest) XXX_Size() int {
+func (m *QueryHighPrioritySendersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMailboxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMailboxRequest.DiscardUnknown(m)
+func (m *QueryHighPrioritySendersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHighPrioritySendersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMailboxRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryHighPrioritySendersRequest proto.InternalMessageInfo
 
-func (m *QueryMailboxRequest) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *QueryHighPrioritySendersRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Peer
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryMailboxResponse is the mailbox response.
-type QueryMailboxResponse struct {
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"value"`
+// QueryHighPrioritySendersResponse is the response type for the
+// Query/HighPrioritySenders RPC method.
+type QueryHighPrioritySendersResponse struct {
+	Senders    []HighPrioritySender `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMailboxResponse) Reset()         { *m = QueryMailboxResponse{} }
-func (m *QueryMailboxResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMailboxResponse) ProtoMessage()    {}
-func (*QueryMailboxResponse) Descriptor() ([]byte, []int) {
+func (m *QueryHighPrioritySendersResponse) Reset()         { *m = QueryHighPrioritySendersResponse{} }
+func (m *QueryHighPrioritySendersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHighPrioritySendersResponse) ProtoMessage()    {}
+func (*QueryHighPrioritySendersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_76266f656a1a9971, []int{5}
 }
-func (m *QueryMailboxResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHighPrioritySendersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMailboxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHighPrioritySendersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMailboxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHighPrioritySendersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -274,500 +271,3544 @@ func (m *QueryMailboxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryMailboxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMailboxResponse.Merge(m, src)
+func (m *QueryHighPrioritySendersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHighPrioritySendersResponse.Merge(m, src)
 }
-func (m *QueryMailboxResponse) XXX_Size() int {
+func (m *QueryHighPrioritySendersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMailboxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMailboxResponse.DiscardUnknown(m)
+func (m *QueryHighPrioritySendersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHighPrioritySendersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMailboxResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHighPrioritySendersResponse proto.InternalMessageInfo
 
-func (m *QueryMailboxResponse) GetValue() string {
+func (m *QueryHighPrioritySendersResponse) GetSenders() []HighPrioritySender {
 	if m != nil {
-		return m.Value
+		return m.Senders
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.swingset.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.swingset.QueryParamsResponse")
-	proto.RegisterType((*QueryEgressRequest)(nil), "agoric.swingset.QueryEgressRequest")
-	proto.RegisterType((*QueryEgressResponse)(nil), "agoric.swingset.QueryEgressResponse")
-	proto.RegisterType((*QueryMailboxRequest)(nil), "agoric.swingset.QueryMailboxRequest")
-	proto.RegisterType((*QueryMailboxResponse)(nil), "agoric.swingset.QueryMailboxResponse")
+func (m *QueryHighPrioritySendersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("agoric/swingset/query.proto", fileDescriptor_76266f656a1a9971) }
-
-var fileDescriptor_76266f656a1a9971 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcf, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0xa7, 0xcb, 0x6e, 0xc5, 0xec, 0x82, 0x10, 0x07, 0xd6, 0x1d, 0x25, 0x59, 0xe3, 0xcf,
-	0xcb, 0x36, 0xb0, 0xe2, 0x45, 0x4f, 0x33, 0xe0, 0x8f, 0x83, 0x82, 0x0e, 0x78, 0x11, 0x2f, 0x99,
-	0x4e, 0x88, 0xc5, 0xb6, 0xe9, 0x36, 0xa9, 0xee, 0xb0, 0x88, 0xe0, 0x5f, 0x20, 0xf8, 0x4f, 0xed,
-	0x71, 0xc1, 0x8b, 0xa7, 0x22, 0x33, 0x9e, 0xf6, 0x38, 0x47, 0xbd, 0x48, 0x93, 0x54, 0xed, 0x94,
-	0xea, 0x6d, 0x4f, 0xd3, 0xbc, 0xf7, 0xf2, 0xfd, 0xbc, 0x97, 0xf7, 0x1d, 0x70, 0x99, 0x09, 0x99,
-	0x47, 0x21, 0x55, 0xef, 0xa2, 0x54, 0x28, 0xae, 0xe9, 0x41, 0xc1, 0xf3, 0x59, 0x90, 0xe5, 0x52,
-	0x4b, 0x78, 0xc1, 0x26, 0x83, 0x3a, 0x39, 0xe8, 0x0b, 0x29, 0xa4, 0xc9, 0xd1, 0xea, 0xcb, 0x96,
-	0x0d, 0xd0, 0xaa, 0x46, 0xfd, 0xe1, 0xf2, 0x57, 0x84, 0x94, 0x22, 0xe6, 0x94, 0x65, 0x11, 0x65,
-	0x69, 0x2a, 0x35, 0xd3, 0x91, 0x4c, 0x95, 0xcd, 0x92, 0x3e, 0x80, 0xcf, 0x2b, 0xe6, 0x33, 0x96,
-	0xb3, 0x44, 0x8d, 0xf9, 0x41, 0xc1, 0x95, 0x26, 0x4f, 0xc0, 0xc5, 0x46, 0x54, 0x65, 0x32, 0x55,
-	0x1c, 0xde, 0x05, 0x7e, 0x66, 0x22, 0x97, 0xbc, 0x5d, 0xef, 0xf6, 0xe6, 0xfe, 0x76, 0xb0, 0xd2,
-	0x62, 0x60, 0x2f, 0x8c, 0xd6, 0x8f, 0x4b, 0xdc, 0x1b, 0xbb, 0x62, 0x92, 0x3b, 0xc6, 0x03, 0x91,
-	0x73, 0x55, 0x33, 0xe0, 0x2b, 0xb0, 0x9e, 0x71, 0x9e, 0x1b, 0xa9, 0xad, 0xd1, 0xe3, 0xd3, 0x12,
-	0x9b, 0xf3, 0xb2, 0xc4, 0x9b, 0x33, 0x96, 0xc4, 0xf7, 0x48, 0x75, 0x22, 0x3f, 0x4a, 0xbc, 0x27,
-	0x22, 0xfd, 0xba, 0x98, 0x04, 0xa1, 0x4c, 0x68, 0x28, 0x55, 0x22, 0x95, 0xfb, 0xd9, 0x53, 0xd3,
-	0x37, 0x54, 0xcf, 0x32, 0xae, 0x82, 0x61, 0x18, 0x0e, 0xa7, 0x53, 0x23, 0x6f, 0x54, 0xc8, 0x43,
-	0x37, 0x41, 0xcd, 0x74, 0x13, 0x50, 0xe0, 0x73, 0x13, 0xe9, 0x9c, 0xc0, 0x5d, 0x70, 0x65, 0x44,
-	0x39, 0x9d, 0xa7, 0x2c, 0x8a, 0x27, 0xf2, 0xf0, 0x6c, 0x9a, 0x7f, 0x04, 0xfa, 0x4d, 0xe8, 0xef,
-	0xee, 0x37, 0xde, 0xb2, 0xb8, 0xe0, 0x06, 0x7b, 0x7e, 0xb4, 0x73, 0x5a, 0x62, 0x1b, 0x58, 0x96,
-	0x78, 0xcb, 0x72, 0xcd, 0x91, 0x8c, 0x6d, 0x78, 0xff, 0xe7, 0x1a, 0xd8, 0x30, 0x4a, 0x50, 0x03,
-	0xdf, 0xee, 0x06, 0x5e, 0x6b, 0x8d, 0xdc, 0x36, 0xc0, 0xe0, 0xfa, 0xbf, 0x8b, 0x6c, 0x3f, 0x04,
-	0x7f, 0xfc, 0xf2, 0xfd, 0xf3, 0xda, 0x0e, 0xdc, 0xa6, 0xab, 0x1e, 0xb4, 0x9b, 0x87, 0x47, 0xc0,
-	0xb7, 0xef, 0xd9, 0x45, 0x6d, 0x58, 0xa2, 0x8b, 0xda, 0xdc, 0x21, 0xb9, 0x69, 0xa8, 0xbb, 0x10,
-	0xb5, 0xa8, 0x76, 0x67, 0xf4, 0xa8, 0x7a, 0xc4, 0xf7, 0xf0, 0x03, 0x38, 0xe7, 0x1e, 0x10, 0x76,
-	0x08, 0x37, 0x97, 0x3a, 0xb8, 0xf1, 0x9f, 0x2a, 0xc7, 0xbf, 0x65, 0xf8, 0x57, 0x21, 0x6e, 0xf1,
-	0x13, 0x5b, 0xe9, 0x1a, 0x18, 0xbd, 0x38, 0x9e, 0x23, 0xef, 0x64, 0x8e, 0xbc, 0x6f, 0x73, 0xe4,
-	0x7d, 0x5a, 0xa0, 0xde, 0xc9, 0x02, 0xf5, 0xbe, 0x2e, 0x50, 0xef, 0xe5, 0xfd, 0xbf, 0x5c, 0x31,
-	0xb4, 0x22, 0x56, 0xcb, 0xb8, 0x42, 0xc8, 0x98, 0xa5, 0xa2, 0xb6, 0xcb, 0xe1, 0x1f, 0x7d, 0x63,
-	0x97, 0x89, 0x6f, 0xfe, 0xb9, 0x77, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x53, 0xc2, 0x07, 0xc5,
-	0x3d, 0x04, 0x00, 0x00,
+// QueryBundleUploadRequest is the request type for the Query/BundleUpload RPC
+// method.
+type QueryBundleUploadRequest struct {
+	// The bech32 address of the submitter.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The lowercase hex SHA-512 of the upload payload.
+	PayloadHash string `protobuf:"bytes,2,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryBundleUploadRequest) Reset()         { *m = QueryBundleUploadRequest{} }
+func (m *QueryBundleUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleUploadRequest) ProtoMessage()    {}
+func (*QueryBundleUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{6}
+}
+func (m *QueryBundleUploadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleUploadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBundleUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleUploadRequest.Merge(m, src)
+}
+func (m *QueryBundleUploadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleUploadRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBundleUploadRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the swingset module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Egress queries a provisioned egress.
-	Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error)
-	// Return the contents of a peer's outbound mailbox.
-	Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error)
+func (m *QueryBundleUploadRequest) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBundleUploadRequest) GetPayloadHash() string {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryBundleUploadResponse is the response type for the Query/BundleUpload
+// RPC method.
+type QueryBundleUploadResponse struct {
+	Upload BundleUpload `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload"`
+	// The indices of the chunks not yet received, in increasing order.
+	MissingChunks []uint32 `protobuf:"varint,2,rep,packed,name=missing_chunks,json=missingChunks,proto3" json:"missing_chunks,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBundleUploadResponse) Reset()         { *m = QueryBundleUploadResponse{} }
+func (m *QueryBundleUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleUploadResponse) ProtoMessage()    {}
+func (*QueryBundleUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{7}
+}
+func (m *QueryBundleUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBundleUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleUploadResponse.Merge(m, src)
+}
+func (m *QueryBundleUploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleUploadResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error) {
-	out := new(QueryEgressResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Egress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBundleUploadResponse proto.InternalMessageInfo
+
+func (m *QueryBundleUploadResponse) GetUpload() BundleUpload {
+	if m != nil {
+		return m.Upload
 	}
-	return out, nil
+	return BundleUpload{}
 }
 
-func (c *queryClient) Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error) {
-	out := new(QueryMailboxResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Mailbox", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBundleUploadResponse) GetMissingChunks() []uint32 {
+	if m != nil {
+		return m.MissingChunks
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the swingset module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Egress queries a provisioned egress.
-	Egress(context.Context, *QueryEgressRequest) (*QueryEgressResponse, error)
-	// Return the contents of a peer's outbound mailbox.
-	Mailbox(context.Context, *QueryMailboxRequest) (*QueryMailboxResponse, error)
+// QueryBundlesRequest is the request type for the Query/Bundles RPC method.
+type QueryBundlesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBundlesRequest) Reset()         { *m = QueryBundlesRequest{} }
+func (m *QueryBundlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBundlesRequest) ProtoMessage()    {}
+func (*QueryBundlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryBundlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Egress(ctx context.Context, req *QueryEgressRequest) (*QueryEgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
+func (m *QueryBundlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Mailbox(ctx context.Context, req *QueryMailboxRequest) (*QueryMailboxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mailbox not implemented")
+func (m *QueryBundlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundlesRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBundlesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryBundlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundlesRequest.DiscardUnknown(m)
 }
 
-func _Query_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEgressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Egress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Query/Egress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Egress(ctx, req.(*QueryEgressRequest))
+var xxx_messageInfo_QueryBundlesRequest proto.InternalMessageInfo
+
+func (m *QueryBundlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Mailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Mailbox(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Query/Mailbox",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Mailbox(ctx, req.(*QueryMailboxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryBundlesResponse is the response type for the Query/Bundles RPC method.
+type QueryBundlesResponse struct {
+	Bundles    []SubmittedBundle   `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.swingset.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Egress",
-			Handler:    _Query_Egress_Handler,
-		},
-		{
-			MethodName: "Mailbox",
-			Handler:    _Query_Mailbox_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/swingset/query.proto",
+func (m *QueryBundlesResponse) Reset()         { *m = QueryBundlesResponse{} }
+func (m *QueryBundlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBundlesResponse) ProtoMessage()    {}
+func (*QueryBundlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{9}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBundlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBundlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundlesResponse.Merge(m, src)
+}
+func (m *QueryBundlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundlesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryBundlesResponse proto.InternalMessageInfo
+
+func (m *QueryBundlesResponse) GetBundles() []SubmittedBundle {
+	if m != nil {
+		return m.Bundles
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBundlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBundleRequest is the request type for the Query/Bundle RPC method.
+type QueryBundleRequest struct {
+	BundleId string `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBundleRequest) Reset()         { *m = QueryBundleRequest{} }
+func (m *QueryBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleRequest) ProtoMessage()    {}
+func (*QueryBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{10}
+}
+func (m *QueryBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleRequest.Merge(m, src)
+}
+func (m *QueryBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEgressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBundleRequest proto.InternalMessageInfo
+
+func (m *QueryBundleRequest) GetBundleId() string {
+	if m != nil {
+		return m.BundleId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEgressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBundleResponse is the response type for the Query/Bundle RPC method.
+type QueryBundleResponse struct {
+	Bundle SubmittedBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle"`
 }
 
-func (m *QueryEgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryBundleResponse) Reset()         { *m = QueryBundleResponse{} }
+func (m *QueryBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleResponse) ProtoMessage()    {}
+func (*QueryBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{11}
+}
+func (m *QueryBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleResponse.Merge(m, src)
+}
+func (m *QueryBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEgressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBundleResponse proto.InternalMessageInfo
+
+func (m *QueryBundleResponse) GetBundle() SubmittedBundle {
+	if m != nil {
+		return m.Bundle
 	}
-	return dAtA[:n], nil
+	return SubmittedBundle{}
 }
 
-func (m *QueryEgressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCoreEvalsRequest is the request type for the Query/CoreEvals RPC
+// method.
+type QueryCoreEvalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Egress != nil {
-		{
-			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCoreEvalsRequest) Reset()         { *m = QueryCoreEvalsRequest{} }
+func (m *QueryCoreEvalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoreEvalsRequest) ProtoMessage()    {}
+func (*QueryCoreEvalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{12}
+}
+func (m *QueryCoreEvalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoreEvalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoreEvalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCoreEvalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoreEvalsRequest.Merge(m, src)
+}
+func (m *QueryCoreEvalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoreEvalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoreEvalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCoreEvalsRequest proto.InternalMessageInfo
+
+func (m *QueryCoreEvalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMailboxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCoreEvalsResponse is the response type for the Query/CoreEvals RPC
+// method.
+type QueryCoreEvalsResponse struct {
+	CoreEvals  []CoreEvalRecord    `protobuf:"bytes,1,rep,name=core_evals,json=coreEvals,proto3" json:"core_evals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMailboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCoreEvalsResponse) Reset()         { *m = QueryCoreEvalsResponse{} }
+func (m *QueryCoreEvalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoreEvalsResponse) ProtoMessage()    {}
+func (*QueryCoreEvalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{13}
+}
+func (m *QueryCoreEvalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoreEvalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoreEvalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCoreEvalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoreEvalsResponse.Merge(m, src)
+}
+func (m *QueryCoreEvalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoreEvalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoreEvalsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCoreEvalsResponse proto.InternalMessageInfo
+
+func (m *QueryCoreEvalsResponse) GetCoreEvals() []CoreEvalRecord {
+	if m != nil {
+		return m.CoreEvals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMailboxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCoreEvalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryMailboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryCoreEvalRequest is the request type for the Query/CoreEval RPC method.
+type QueryCoreEvalRequest struct {
+	ContentHash string `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryCoreEvalRequest) Reset()         { *m = QueryCoreEvalRequest{} }
+func (m *QueryCoreEvalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoreEvalRequest) ProtoMessage()    {}
+func (*QueryCoreEvalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{14}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryCoreEvalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoreEvalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoreEvalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCoreEvalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoreEvalRequest.Merge(m, src)
+}
+func (m *QueryCoreEvalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoreEvalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoreEvalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCoreEvalRequest proto.InternalMessageInfo
+
+func (m *QueryCoreEvalRequest) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryEgressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryCoreEvalResponse is the response type for the Query/CoreEval RPC
+// method.
+type QueryCoreEvalResponse struct {
+	CoreEval CoreEvalRecord `protobuf:"bytes,1,opt,name=core_eval,json=coreEval,proto3" json:"core_eval"`
+}
+
+func (m *QueryCoreEvalResponse) Reset()         { *m = QueryCoreEvalResponse{} }
+func (m *QueryCoreEvalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoreEvalResponse) ProtoMessage()    {}
+func (*QueryCoreEvalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{15}
+}
+func (m *QueryCoreEvalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoreEvalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoreEvalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryCoreEvalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoreEvalResponse.Merge(m, src)
+}
+func (m *QueryCoreEvalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoreEvalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoreEvalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCoreEvalResponse proto.InternalMessageInfo
+
+func (m *QueryCoreEvalResponse) GetCoreEval() CoreEvalRecord {
+	if m != nil {
+		return m.CoreEval
 	}
-	return n
+	return CoreEvalRecord{}
 }
 
-func (m *QueryEgressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryEgressRequest is the request type for the Query/Egress RPC method
+type QueryEgressRequest struct {
+	Peer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
+}
+
+func (m *QueryEgressRequest) Reset()         { *m = QueryEgressRequest{} }
+func (m *QueryEgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEgressRequest) ProtoMessage()    {}
+func (*QueryEgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{16}
+}
+func (m *QueryEgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryEgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEgressRequest.Merge(m, src)
+}
+func (m *QueryEgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEgressRequest proto.InternalMessageInfo
+
+func (m *QueryEgressRequest) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+// QueryEgressResponse is the egress response.
+type QueryEgressResponse struct {
+	Egress *Egress `protobuf:"bytes,1,opt,name=egress,proto3" json:"egress,omitempty"`
+}
+
+func (m *QueryEgressResponse) Reset()         { *m = QueryEgressResponse{} }
+func (m *QueryEgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEgressResponse) ProtoMessage()    {}
+func (*QueryEgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{17}
+}
+func (m *QueryEgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEgressResponse.Merge(m, src)
+}
+func (m *QueryEgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEgressResponse proto.InternalMessageInfo
+
+func (m *QueryEgressResponse) GetEgress() *Egress {
+	if m != nil {
+		return m.Egress
+	}
+	return nil
+}
+
+// QueryMailboxRequest is the mailbox query.
+type QueryMailboxRequest struct {
+	Peer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
+}
+
+func (m *QueryMailboxRequest) Reset()         { *m = QueryMailboxRequest{} }
+func (m *QueryMailboxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMailboxRequest) ProtoMessage()    {}
+func (*QueryMailboxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{18}
+}
+func (m *QueryMailboxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMailboxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMailboxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMailboxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMailboxRequest.Merge(m, src)
+}
+func (m *QueryMailboxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMailboxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMailboxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMailboxRequest proto.InternalMessageInfo
+
+func (m *QueryMailboxRequest) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+// QueryMailboxResponse is the mailbox response.
+type QueryMailboxResponse struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"value"`
+}
+
+func (m *QueryMailboxResponse) Reset()         { *m = QueryMailboxResponse{} }
+func (m *QueryMailboxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMailboxResponse) ProtoMessage()    {}
+func (*QueryMailboxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{19}
+}
+func (m *QueryMailboxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMailboxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMailboxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMailboxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMailboxResponse.Merge(m, src)
+}
+func (m *QueryMailboxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMailboxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMailboxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMailboxResponse proto.InternalMessageInfo
+
+func (m *QueryMailboxResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.swingset.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.swingset.QueryParamsResponse")
+	proto.RegisterType((*QueryStateRequest)(nil), "agoric.swingset.QueryStateRequest")
+	proto.RegisterType((*QueryStateResponse)(nil), "agoric.swingset.QueryStateResponse")
+	proto.RegisterType((*QueryHighPrioritySendersRequest)(nil), "agoric.swingset.QueryHighPrioritySendersRequest")
+	proto.RegisterType((*QueryHighPrioritySendersResponse)(nil), "agoric.swingset.QueryHighPrioritySendersResponse")
+	proto.RegisterType((*QueryBundleUploadRequest)(nil), "agoric.swingset.QueryBundleUploadRequest")
+	proto.RegisterType((*QueryBundleUploadResponse)(nil), "agoric.swingset.QueryBundleUploadResponse")
+	proto.RegisterType((*QueryBundlesRequest)(nil), "agoric.swingset.QueryBundlesRequest")
+	proto.RegisterType((*QueryBundlesResponse)(nil), "agoric.swingset.QueryBundlesResponse")
+	proto.RegisterType((*QueryBundleRequest)(nil), "agoric.swingset.QueryBundleRequest")
+	proto.RegisterType((*QueryBundleResponse)(nil), "agoric.swingset.QueryBundleResponse")
+	proto.RegisterType((*QueryCoreEvalsRequest)(nil), "agoric.swingset.QueryCoreEvalsRequest")
+	proto.RegisterType((*QueryCoreEvalsResponse)(nil), "agoric.swingset.QueryCoreEvalsResponse")
+	proto.RegisterType((*QueryCoreEvalRequest)(nil), "agoric.swingset.QueryCoreEvalRequest")
+	proto.RegisterType((*QueryCoreEvalResponse)(nil), "agoric.swingset.QueryCoreEvalResponse")
+	proto.RegisterType((*QueryEgressRequest)(nil), "agoric.swingset.QueryEgressRequest")
+	proto.RegisterType((*QueryEgressResponse)(nil), "agoric.swingset.QueryEgressResponse")
+	proto.RegisterType((*QueryMailboxRequest)(nil), "agoric.swingset.QueryMailboxRequest")
+	proto.RegisterType((*QueryMailboxResponse)(nil), "agoric.swingset.QueryMailboxResponse")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/query.proto", fileDescriptor_76266f656a1a9971) }
+
+var fileDescriptor_76266f656a1a9971 = []byte{
+	// 1134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0xd5,
+	0x17, 0xcd, 0xa4, 0x8d, 0x13, 0xdf, 0xa4, 0xbf, 0x9f, 0x78, 0x09, 0xa9, 0x33, 0x69, 0x6d, 0x67,
+	0x92, 0x38, 0x21, 0x6a, 0x67, 0x48, 0x10, 0x8b, 0x12, 0x09, 0x11, 0x87, 0xb6, 0x41, 0x02, 0x29,
+	0xb8, 0xca, 0x86, 0x82, 0xac, 0xe7, 0xf1, 0xd3, 0x78, 0x54, 0x7b, 0xde, 0x74, 0xde, 0x38, 0x24,
+	0xb2, 0xa2, 0x0a, 0xf6, 0x48, 0x48, 0x7c, 0x02, 0x56, 0x20, 0x56, 0x7c, 0x8c, 0x2e, 0x2b, 0xb1,
+	0x80, 0x95, 0x85, 0x12, 0x56, 0x5d, 0x76, 0xc9, 0x0a, 0xf9, 0xbd, 0x3b, 0x8e, 0xc7, 0xe3, 0x3f,
+	0x41, 0x8a, 0x58, 0xd5, 0x73, 0xe7, 0xde, 0x73, 0xce, 0xbd, 0xf7, 0xcd, 0x3b, 0x0d, 0x2c, 0x53,
+	0x87, 0x07, 0xae, 0x6d, 0x89, 0xaf, 0x5d, 0xcf, 0x11, 0x2c, 0xb4, 0x9e, 0x37, 0x59, 0x70, 0x6a,
+	0xfa, 0x01, 0x0f, 0x39, 0xf9, 0xbf, 0x7a, 0x69, 0x46, 0x2f, 0xf5, 0x05, 0x87, 0x3b, 0x5c, 0xbe,
+	0xb3, 0x3a, 0xbf, 0x54, 0x9a, 0x9e, 0xed, 0xc7, 0x88, 0x7e, 0xe0, 0xfb, 0x3b, 0x0e, 0xe7, 0x4e,
+	0x9d, 0x59, 0xd4, 0x77, 0x2d, 0xea, 0x79, 0x3c, 0xa4, 0xa1, 0xcb, 0x3d, 0x81, 0x6f, 0xb7, 0x6c,
+	0x2e, 0x1a, 0x5c, 0x58, 0x15, 0x2a, 0x98, 0x62, 0xb7, 0x8e, 0xb7, 0x2b, 0x2c, 0xa4, 0xdb, 0x96,
+	0x4f, 0x1d, 0xd7, 0x93, 0xc9, 0x2a, 0xd7, 0x58, 0x00, 0xf2, 0x79, 0x27, 0xe3, 0x90, 0x06, 0xb4,
+	0x21, 0x4a, 0xec, 0x79, 0x93, 0x89, 0xd0, 0xf8, 0x14, 0xe6, 0x63, 0x51, 0xe1, 0x73, 0x4f, 0x30,
+	0xf2, 0x3e, 0xa4, 0x7c, 0x19, 0xc9, 0x68, 0x79, 0x6d, 0x73, 0x76, 0xe7, 0xb6, 0xd9, 0xd7, 0x8e,
+	0xa9, 0x0a, 0x8a, 0x37, 0x5f, 0xb6, 0x73, 0x13, 0x25, 0x4c, 0x36, 0xe6, 0xe1, 0x2d, 0x89, 0xf6,
+	0x24, 0xa4, 0x21, 0x8b, 0x28, 0x0e, 0x80, 0xf4, 0x06, 0x91, 0x61, 0x07, 0xa6, 0x44, 0x27, 0x80,
+	0x04, 0x8b, 0x09, 0x02, 0x99, 0x8e, 0xf8, 0x2a, 0xd5, 0x70, 0x21, 0x27, 0x91, 0x0e, 0x5c, 0xa7,
+	0x76, 0x18, 0xb8, 0x3c, 0x70, 0xc3, 0xd3, 0x27, 0xcc, 0xab, 0xb2, 0x20, 0xea, 0x87, 0x3c, 0x02,
+	0xb8, 0xec, 0x1c, 0xb1, 0x0b, 0xa6, 0x1a, 0x93, 0xd9, 0x19, 0x93, 0xa9, 0x96, 0x84, 0x63, 0x32,
+	0x0f, 0xa9, 0x13, 0x09, 0x2d, 0xf5, 0x54, 0x1a, 0xbf, 0x6a, 0x90, 0x1f, 0xce, 0x85, 0x3d, 0xec,
+	0xc3, 0xb4, 0x50, 0xa1, 0x8c, 0x96, 0xbf, 0xb1, 0x39, 0xbb, 0xb3, 0x9a, 0xe8, 0x22, 0x59, 0x8e,
+	0x2d, 0x45, 0x95, 0xe4, 0x71, 0x4c, 0xf1, 0xa4, 0x54, 0xbc, 0x31, 0x56, 0xb1, 0x52, 0x10, 0x93,
+	0xfc, 0x14, 0x32, 0x52, 0x71, 0xb1, 0xe9, 0x55, 0xeb, 0xec, 0xc8, 0xaf, 0x73, 0x5a, 0x8d, 0xc6,
+	0x72, 0x07, 0xd2, 0xa2, 0x59, 0x69, 0xb8, 0x61, 0xc8, 0x02, 0x39, 0x95, 0x74, 0xe9, 0x32, 0x40,
+	0x56, 0x60, 0xce, 0xa7, 0xa7, 0x9d, 0xfc, 0x72, 0x8d, 0x8a, 0x9a, 0x14, 0x91, 0x2e, 0xcd, 0x62,
+	0xec, 0x80, 0x8a, 0x9a, 0xf1, 0x02, 0x96, 0x06, 0x80, 0xe3, 0x1c, 0x76, 0x21, 0xd5, 0x94, 0x11,
+	0x1c, 0xf8, 0xdd, 0xc4, 0x18, 0x7a, 0xcb, 0xa2, 0x33, 0xa3, 0x4a, 0xc8, 0x3a, 0xfc, 0xaf, 0xe1,
+	0x0a, 0xe1, 0x7a, 0x4e, 0xd9, 0xae, 0x35, 0xbd, 0x67, 0x22, 0x33, 0x99, 0xbf, 0xb1, 0x79, 0xab,
+	0x74, 0x0b, 0xa3, 0xfb, 0x32, 0x68, 0x7c, 0x85, 0x07, 0x55, 0x21, 0x5d, 0xfb, 0xbe, 0x7f, 0xd4,
+	0x60, 0x21, 0x8e, 0x8f, 0xbd, 0x7d, 0x04, 0xd3, 0x15, 0x15, 0xc2, 0x1d, 0xe7, 0x93, 0x27, 0x15,
+	0x07, 0x59, 0x55, 0xb5, 0xd1, 0x82, 0xb1, 0xec, 0xfa, 0x16, 0xbc, 0x8d, 0x1f, 0x92, 0xa2, 0x89,
+	0x26, 0xb0, 0x0c, 0x69, 0xc5, 0x54, 0x76, 0xab, 0xb8, 0xda, 0x19, 0x15, 0xf8, 0xa4, 0x6a, 0x1c,
+	0xc5, 0xa6, 0xd6, 0x6d, 0xea, 0x43, 0x48, 0xa9, 0x14, 0x9c, 0xd8, 0x55, 0x7b, 0xc2, 0x2a, 0xa3,
+	0x0c, 0x6f, 0x4b, 0xd8, 0x7d, 0x1e, 0xb0, 0x87, 0xc7, 0xb4, 0x7e, 0xed, 0xeb, 0xf8, 0x49, 0x83,
+	0xc5, 0x7e, 0x06, 0xd4, 0xfe, 0x31, 0x80, 0xcd, 0x03, 0x56, 0x66, 0x9d, 0x28, 0xee, 0x24, 0x97,
+	0xd0, 0x1f, 0xd5, 0x95, 0x98, 0xcd, 0x83, 0xe8, 0xc8, 0xa5, 0xed, 0x08, 0xed, 0xfa, 0x96, 0xf2,
+	0x00, 0xcf, 0xcd, 0x25, 0xa1, 0x9a, 0xc4, 0x0a, 0xcc, 0xd9, 0xdc, 0x0b, 0x99, 0x17, 0xaa, 0x6f,
+	0x4a, 0x6d, 0x66, 0x16, 0x63, 0xf2, 0x9b, 0x7a, 0xda, 0x37, 0xc5, 0x6e, 0x8b, 0x45, 0x48, 0x77,
+	0x5b, 0xc4, 0x21, 0x5e, 0xb1, 0xc3, 0x99, 0xa8, 0x43, 0x23, 0xc0, 0xc3, 0xf2, 0xd0, 0x09, 0x98,
+	0xe8, 0xee, 0xe7, 0x4b, 0xb8, 0xe9, 0x33, 0xbc, 0x02, 0xe6, 0x8a, 0x07, 0xaf, 0xdb, 0x39, 0xf9,
+	0xfc, 0xa6, 0x9d, 0x9b, 0x3d, 0xa5, 0x8d, 0xfa, 0x07, 0x46, 0xe7, 0xc9, 0xf8, 0xbb, 0x9d, 0xbb,
+	0xef, 0xb8, 0x61, 0xad, 0x59, 0x31, 0x6d, 0xde, 0xb0, 0xd0, 0x64, 0xd4, 0x3f, 0xf7, 0x45, 0xf5,
+	0x99, 0x15, 0x9e, 0xfa, 0x4c, 0x98, 0x7b, 0xb6, 0xbd, 0x57, 0xad, 0x4a, 0x78, 0x89, 0x62, 0x3c,
+	0x82, 0xf9, 0x18, 0x27, 0xb6, 0x63, 0x41, 0x8a, 0xc9, 0xc8, 0x50, 0x33, 0xc1, 0x02, 0x4c, 0x33,
+	0x04, 0xe2, 0x7c, 0x46, 0xdd, 0x7a, 0x85, 0x9f, 0xfc, 0x37, 0xe2, 0x1f, 0xc3, 0x42, 0x9c, 0xb4,
+	0xab, 0x7e, 0xea, 0x98, 0xd6, 0x9b, 0xea, 0x53, 0x49, 0x17, 0x97, 0x5e, 0xb7, 0x73, 0x2a, 0xf0,
+	0xa6, 0x9d, 0x9b, 0x53, 0xbc, 0xf2, 0xd1, 0x28, 0xa9, 0xf0, 0xce, 0xef, 0x00, 0x53, 0x12, 0x89,
+	0x84, 0x90, 0x52, 0x36, 0x49, 0x92, 0xc6, 0x90, 0xf4, 0x62, 0x7d, 0x6d, 0x74, 0x92, 0xd2, 0x63,
+	0xe4, 0xbe, 0xfd, 0xed, 0xaf, 0x1f, 0x26, 0x97, 0xc8, 0x6d, 0xab, 0xff, 0xbf, 0x0e, 0xca, 0x84,
+	0x89, 0x0f, 0x53, 0xd2, 0x3b, 0x89, 0x31, 0x18, 0xaf, 0xd7, 0x9c, 0xf5, 0xd5, 0x91, 0x39, 0x48,
+	0x99, 0x95, 0x94, 0x19, 0xb2, 0x98, 0xa0, 0x94, 0xbe, 0x4c, 0x7e, 0xd1, 0x60, 0x7e, 0x80, 0x4f,
+	0x92, 0x77, 0x07, 0x83, 0x0f, 0xb7, 0x6f, 0x7d, 0xfb, 0x5f, 0x54, 0xa0, 0x38, 0x53, 0x8a, 0xdb,
+	0x24, 0x85, 0x84, 0xb8, 0x9a, 0xeb, 0xd4, 0xca, 0x3e, 0x96, 0x95, 0x23, 0xbf, 0xfd, 0x59, 0x83,
+	0xb9, 0x5e, 0x3b, 0x22, 0xef, 0x0c, 0xe6, 0x1c, 0x60, 0xa3, 0xfa, 0xd6, 0x55, 0x52, 0x51, 0xd7,
+	0x9e, 0xd4, 0xb5, 0x4b, 0x1e, 0x24, 0x74, 0xe1, 0x75, 0xad, 0xfc, 0xcf, 0x6a, 0x75, 0x7d, 0xf8,
+	0xcc, 0x6a, 0xf5, 0xda, 0xf0, 0x19, 0x39, 0x81, 0xe9, 0x22, 0x9a, 0xc8, 0xda, 0x28, 0xe6, 0xee,
+	0xf8, 0xd6, 0xc7, 0x64, 0xa1, 0xb4, 0xbc, 0x94, 0xa6, 0x93, 0xcc, 0x10, 0x69, 0x82, 0x7c, 0xa3,
+	0x41, 0x4a, 0x55, 0x0d, 0x3b, 0xba, 0x31, 0x13, 0xd2, 0xd7, 0x46, 0x27, 0x21, 0xef, 0x3d, 0xc9,
+	0x5b, 0x20, 0x6b, 0xc3, 0x78, 0xad, 0x56, 0xd7, 0xca, 0xce, 0x3a, 0x1a, 0xd2, 0xdd, 0xeb, 0x9f,
+	0x14, 0x06, 0x33, 0xf4, 0x3b, 0x90, 0xbe, 0x31, 0x36, 0x0f, 0xc5, 0xac, 0x4a, 0x31, 0x77, 0xc9,
+	0x72, 0x42, 0xcc, 0xa5, 0xbd, 0x90, 0xef, 0x34, 0x98, 0x89, 0x4a, 0xc9, 0xfa, 0x68, 0xe8, 0x48,
+	0x41, 0x61, 0x5c, 0x1a, 0x0a, 0xd8, 0x91, 0x02, 0xee, 0x91, 0xad, 0x11, 0x02, 0xac, 0x56, 0xaf,
+	0x89, 0x9c, 0x91, 0x16, 0xa4, 0xd4, 0x5d, 0x39, 0x6c, 0x2d, 0xb1, 0xeb, 0x5e, 0x5f, 0x1b, 0x9d,
+	0x84, 0x42, 0x0a, 0x52, 0x48, 0x9e, 0x64, 0x13, 0x42, 0xd4, 0x7d, 0x6c, 0xb5, 0x7c, 0xc6, 0x82,
+	0x33, 0xf2, 0x02, 0xa6, 0xf1, 0x72, 0x1c, 0x76, 0x1c, 0xe3, 0x17, 0xb6, 0xbe, 0x3e, 0x26, 0x0b,
+	0xf9, 0x37, 0x24, 0xff, 0x0a, 0xc9, 0x25, 0xf8, 0x1b, 0x2a, 0x13, 0x05, 0x14, 0x8f, 0x5e, 0x9e,
+	0x67, 0xb5, 0x57, 0xe7, 0x59, 0xed, 0xcf, 0xf3, 0xac, 0xf6, 0xfd, 0x45, 0x76, 0xe2, 0xd5, 0x45,
+	0x76, 0xe2, 0x8f, 0x8b, 0xec, 0xc4, 0x17, 0xbb, 0x3d, 0x37, 0xfe, 0x9e, 0x02, 0x51, 0x58, 0xf2,
+	0xc6, 0x77, 0x78, 0x9d, 0x7a, 0x4e, 0x64, 0x05, 0x27, 0x97, 0xf8, 0xd2, 0x0a, 0x2a, 0x29, 0xf9,
+	0x07, 0xd2, 0x7b, 0xff, 0x0c, 0x00, 0x1f, 0xca, 0x4f, 0x56, 0xd0, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the swingset module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// State queries the current state of the swingset module, including the fee
+	// multiplier.
+	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// HighPrioritySenders lists the governance-registered high priority senders.
+	HighPrioritySenders(ctx context.Context, in *QueryHighPrioritySendersRequest, opts ...grpc.CallOption) (*QueryHighPrioritySendersResponse, error)
+	// BundleUpload queries the progress of a chunked bundle upload.
+	BundleUpload(ctx context.Context, in *QueryBundleUploadRequest, opts ...grpc.CallOption) (*QueryBundleUploadResponse, error)
+	// Bundles lists the bundles submitted for installation.  Bundles installed
+	// before these submissions were recorded are not listed.
+	Bundles(ctx context.Context, in *QueryBundlesRequest, opts ...grpc.CallOption) (*QueryBundlesResponse, error)
+	// Bundle queries a bundle submitted for installation by its ID.
+	Bundle(ctx context.Context, in *QueryBundleRequest, opts ...grpc.CallOption) (*QueryBundleResponse, error)
+	// CoreEvals lists the core-evals passed by governance.
+	CoreEvals(ctx context.Context, in *QueryCoreEvalsRequest, opts ...grpc.CallOption) (*QueryCoreEvalsResponse, error)
+	// CoreEval queries a core-eval passed by governance by its content hash.
+	CoreEval(ctx context.Context, in *QueryCoreEvalRequest, opts ...grpc.CallOption) (*QueryCoreEvalResponse, error)
+	// Egress queries a provisioned egress.
+	Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error)
+	// Return the contents of a peer's outbound mailbox.
+	Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
+	out := new(QueryStateResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HighPrioritySenders(ctx context.Context, in *QueryHighPrioritySendersRequest, opts ...grpc.CallOption) (*QueryHighPrioritySendersResponse, error) {
+	out := new(QueryHighPrioritySendersResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/HighPrioritySenders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BundleUpload(ctx context.Context, in *QueryBundleUploadRequest, opts ...grpc.CallOption) (*QueryBundleUploadResponse, error) {
+	out := new(QueryBundleUploadResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/BundleUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Bundles(ctx context.Context, in *QueryBundlesRequest, opts ...grpc.CallOption) (*QueryBundlesResponse, error) {
+	out := new(QueryBundlesResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Bundles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Bundle(ctx context.Context, in *QueryBundleRequest, opts ...grpc.CallOption) (*QueryBundleResponse, error) {
+	out := new(QueryBundleResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Bundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoreEvals(ctx context.Context, in *QueryCoreEvalsRequest, opts ...grpc.CallOption) (*QueryCoreEvalsResponse, error) {
+	out := new(QueryCoreEvalsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/CoreEvals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoreEval(ctx context.Context, in *QueryCoreEvalRequest, opts ...grpc.CallOption) (*QueryCoreEvalResponse, error) {
+	out := new(QueryCoreEvalResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/CoreEval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error) {
+	out := new(QueryEgressResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Egress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error) {
+	out := new(QueryMailboxResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Mailbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the swingset module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// State queries the current state of the swingset module, including the fee
+	// multiplier.
+	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// HighPrioritySenders lists the governance-registered high priority senders.
+	HighPrioritySenders(context.Context, *QueryHighPrioritySendersRequest) (*QueryHighPrioritySendersResponse, error)
+	// BundleUpload queries the progress of a chunked bundle upload.
+	BundleUpload(context.Context, *QueryBundleUploadRequest) (*QueryBundleUploadResponse, error)
+	// Bundles lists the bundles submitted for installation.  Bundles installed
+	// before these submissions were recorded are not listed.
+	Bundles(context.Context, *QueryBundlesRequest) (*QueryBundlesResponse, error)
+	// Bundle queries a bundle submitted for installation by its ID.
+	Bundle(context.Context, *QueryBundleRequest) (*QueryBundleResponse, error)
+	// CoreEvals lists the core-evals passed by governance.
+	CoreEvals(context.Context, *QueryCoreEvalsRequest) (*QueryCoreEvalsResponse, error)
+	// CoreEval queries a core-eval passed by governance by its content hash.
+	CoreEval(context.Context, *QueryCoreEvalRequest) (*QueryCoreEvalResponse, error)
+	// Egress queries a provisioned egress.
+	Egress(context.Context, *QueryEgressRequest) (*QueryEgressResponse, error)
+	// Return the contents of a peer's outbound mailbox.
+	Mailbox(context.Context, *QueryMailboxRequest) (*QueryMailboxResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (*UnimplementedQueryServer) HighPrioritySenders(ctx context.Context, req *QueryHighPrioritySendersRequest) (*QueryHighPrioritySendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HighPrioritySenders not implemented")
+}
+func (*UnimplementedQueryServer) BundleUpload(ctx context.Context, req *QueryBundleUploadRequest) (*QueryBundleUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BundleUpload not implemented")
+}
+func (*UnimplementedQueryServer) Bundles(ctx context.Context, req *QueryBundlesRequest) (*QueryBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bundles not implemented")
+}
+func (*UnimplementedQueryServer) Bundle(ctx context.Context, req *QueryBundleRequest) (*QueryBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bundle not implemented")
+}
+func (*UnimplementedQueryServer) CoreEvals(ctx context.Context, req *QueryCoreEvalsRequest) (*QueryCoreEvalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoreEvals not implemented")
+}
+func (*UnimplementedQueryServer) CoreEval(ctx context.Context, req *QueryCoreEvalRequest) (*QueryCoreEvalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoreEval not implemented")
+}
+func (*UnimplementedQueryServer) Egress(ctx context.Context, req *QueryEgressRequest) (*QueryEgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
+}
+func (*UnimplementedQueryServer) Mailbox(ctx context.Context, req *QueryMailboxRequest) (*QueryMailboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mailbox not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).State(ctx, req.(*QueryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HighPrioritySenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHighPrioritySendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HighPrioritySenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/HighPrioritySenders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HighPrioritySenders(ctx, req.(*QueryHighPrioritySendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BundleUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBundleUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BundleUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/BundleUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BundleUpload(ctx, req.(*QueryBundleUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Bundles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bundles(ctx, req.(*QueryBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Bundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bundle(ctx, req.(*QueryBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CoreEvals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoreEvalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoreEvals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/CoreEvals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoreEvals(ctx, req.(*QueryCoreEvalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CoreEval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoreEvalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoreEval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/CoreEval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoreEval(ctx, req.(*QueryCoreEvalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Egress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Egress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Egress(ctx, req.(*QueryEgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Mailbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mailbox(ctx, req.(*QueryMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.swingset.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _Query_State_Handler,
+		},
+		{
+			MethodName: "HighPrioritySenders",
+			Handler:    _Query_HighPrioritySenders_Handler,
+		},
+		{
+			MethodName: "BundleUpload",
+			Handler:    _Query_BundleUpload_Handler,
+		},
+		{
+			MethodName: "Bundles",
+			Handler:    _Query_Bundles_Handler,
+		},
+		{
+			MethodName: "Bundle",
+			Handler:    _Query_Bundle_Handler,
+		},
+		{
+			MethodName: "CoreEvals",
+			Handler:    _Query_CoreEvals_Handler,
+		},
+		{
+			MethodName: "CoreEval",
+			Handler:    _Query_CoreEval_Handler,
+		},
+		{
+			MethodName: "Egress",
+			Handler:    _Query_Egress_Handler,
+		},
+		{
+			MethodName: "Mailbox",
+			Handler:    _Query_Mailbox_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/swingset/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHighPrioritySendersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHighPrioritySendersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHighPrioritySendersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHighPrioritySendersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHighPrioritySendersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHighPrioritySendersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Senders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingChunks) > 0 {
+		dAtA6 := make([]byte, len(m.MissingChunks)*10)
+		var j5 int
+		for _, num := range m.MissingChunks {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Upload.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bundles) > 0 {
+		for iNdEx := len(m.Bundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BundleId) > 0 {
+		i -= len(m.BundleId)
+		copy(dAtA[i:], m.BundleId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BundleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoreEvalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoreEvalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoreEvalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoreEvalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoreEvalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoreEvalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CoreEvals) > 0 {
+		for iNdEx := len(m.CoreEvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoreEvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoreEvalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoreEvalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoreEvalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoreEvalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoreEvalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoreEvalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CoreEval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Egress != nil {
+		{
+			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHighPrioritySendersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHighPrioritySendersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for _, e := range m.Senders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBundleUploadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBundleUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upload.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MissingChunks) > 0 {
+		l = 0
+		for _, e := range m.MissingChunks {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryBundlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBundlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bundles) > 0 {
+		for _, e := range m.Bundles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BundleId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bundle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCoreEvalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCoreEvalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoreEvals) > 0 {
+		for _, e := range m.CoreEvals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCoreEvalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCoreEvalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoreEval.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHighPrioritySendersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHighPrioritySendersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHighPrioritySendersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, HighPrioritySender{})
+			if err := m.Senders[len(m.Senders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleUploadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleUploadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingChunks = append(m.MissingChunks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingChunks) == 0 {
+					m.MissingChunks = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingChunks = append(m.MissingChunks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingChunks", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundlesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundlesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundles = append(m.Bundles, SubmittedBundle{})
+			if err := m.Bundles[len(m.Bundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryMailboxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryMailboxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCoreEvalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoreEvalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoreEvalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryCoreEvalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoreEvalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoreEvalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreEvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreEvals = append(m.CoreEvals, CoreEvalRecord{})
+			if err := m.CoreEvals[len(m.CoreEvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCoreEvalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -790,12 +3831,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoreEvalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoreEvalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -817,7 +3890,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCoreEvalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,15 +3913,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoreEvalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoreEvalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreEval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -875,7 +3948,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoreEval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -161,6 +165,15 @@ type Params struct {
 	// nodes must all serialize and deserialize the existing order without
 	// permuting it.
 	QueueMax []QueueSize `protobuf:"bytes,5,rep,name=queue_max,json=queueMax,proto3" json:"queue_max"`
+	// Configuration of the optional fee market, which scales the beans charged
+	// for inbound messages according to recent inbound queue occupancy.
+	FeeMarket FeeMarket `protobuf:"bytes,6,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market"`
+	// Per-sender limit on the rate of inbound queue messages.  Senders in the
+	// highPrioritySenders list are exempt.
+	InboundRateLimit InboundRateLimit `protobuf:"bytes,7,opt,name=inbound_rate_limit,json=inboundRateLimit,proto3" json:"inbound_rate_limit"`
+	// The number of blocks after which an incomplete chunked bundle upload is
+	// discarded.  Zero disables chunked bundle uploads.
+	BundleUploadExpiryBlocks uint32 `protobuf:"varint,8,opt,name=bundle_upload_expiry_blocks,json=bundleUploadExpiryBlocks,proto3" json:"bundle_upload_expiry_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -230,18 +243,175 @@ func (m *Params) GetQueueMax() []QueueSize {
 	return nil
 }
 
+func (m *Params) GetFeeMarket() FeeMarket {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return FeeMarket{}
+}
+
+func (m *Params) GetInboundRateLimit() InboundRateLimit {
+	if m != nil {
+		return m.InboundRateLimit
+	}
+	return InboundRateLimit{}
+}
+
+func (m *Params) GetBundleUploadExpiryBlocks() uint32 {
+	if m != nil {
+		return m.BundleUploadExpiryBlocks
+	}
+	return 0
+}
+
+// FeeMarket configures dynamic bean pricing driven by inbound queue pressure.
+//
+// Each block, the occupancy of the inbound queue (its length as a fraction of
+// its maximum size) is recorded.  The fee multiplier is then adjusted by
+//
+//	multiplier *= 1 + adjustment_rate * (average - target) / target
+//
+// where average is the mean occupancy over the last window_blocks blocks, and
+// the result is clamped to [min_multiplier, max_multiplier].
+type FeeMarket struct {
+	// Whether the fee market is active.  If false, the multiplier is always 1.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The number of recent blocks over which to average the queue occupancy.
+	WindowBlocks uint32 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// The average occupancy, in (0, 1], at which the multiplier is stable.
+	TargetOccupancy github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_occupancy,json=targetOccupancy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_occupancy"`
+	// The largest fraction, in (0, 1], by which the multiplier may change in a
+	// single block.
+	AdjustmentRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=adjustment_rate,json=adjustmentRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_rate"`
+	// The lower bound of the multiplier.  Must be positive.
+	MinMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_multiplier,json=minMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_multiplier"`
+	// The upper bound of the multiplier.  Must not be less than min_multiplier.
+	MaxMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_multiplier,json=maxMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_multiplier"`
+}
+
+func (m *FeeMarket) Reset()         { *m = FeeMarket{} }
+func (m *FeeMarket) String() string { return proto.CompactTextString(m) }
+func (*FeeMarket) ProtoMessage()    {}
+func (*FeeMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{3}
+}
+func (m *FeeMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarket.Merge(m, src)
+}
+func (m *FeeMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarket proto.InternalMessageInfo
+
+func (m *FeeMarket) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarket) GetWindowBlocks() uint32 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// InboundRateLimit bounds the number of inbound queue messages admitted from
+// any one sender within a sliding window of recent blocks.
+type InboundRateLimit struct {
+	// The number of most recent blocks, including the current one, over which
+	// messages are counted.  Zero disables the limit.
+	WindowBlocks uint32 `protobuf:"varint,1,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// The maximum number of inbound messages admitted from a sender within the
+	// window.
+	MaxMessages uint32 `protobuf:"varint,2,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+}
+
+func (m *InboundRateLimit) Reset()         { *m = InboundRateLimit{} }
+func (m *InboundRateLimit) String() string { return proto.CompactTextString(m) }
+func (*InboundRateLimit) ProtoMessage()    {}
+func (*InboundRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{4}
+}
+func (m *InboundRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundRateLimit.Merge(m, src)
+}
+func (m *InboundRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundRateLimit proto.InternalMessageInfo
+
+func (m *InboundRateLimit) GetWindowBlocks() uint32 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *InboundRateLimit) GetMaxMessages() uint32 {
+	if m != nil {
+		return m.MaxMessages
+	}
+	return 0
+}
+
 // The current state of the module.
 type State struct {
 	// The allowed number of items to add to queues, as determined by SwingSet.
 	// Transactions which attempt to enqueue more should be rejected.
 	QueueAllowed []QueueSize `protobuf:"bytes,1,rep,name=queue_allowed,json=queueAllowed,proto3" json:"queue_allowed"`
+	// The multiplier currently applied to beans charged for inbound messages.
+	// It is 1 unless the fee market is enabled.
+	FeeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_multiplier,json=feeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_multiplier"`
+	// The inbound queue occupancy of recent blocks, oldest first, as used by the
+	// fee market.  Empty unless the fee market is enabled.
+	InboundQueueOccupancy []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,rep,name=inbound_queue_occupancy,json=inboundQueueOccupancy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inbound_queue_occupancy"`
 }
 
 func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{3}
+	return fileDescriptor_ff9c341e0de15f8b, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,26 +447,25 @@ func (m *State) GetQueueAllowed() []QueueSize {
 	return nil
 }
 
-// Map element of a string key to a Nat bean count.
-type StringBeans struct {
-	// What the beans are for.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The actual bean value.
-	Beans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=beans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beans"`
+// InboundSenderHistory records the number of inbound messages admitted from a
+// single sender in recent blocks, for enforcing the InboundRateLimit.
+type InboundSenderHistory struct {
+	// Per-block counts, oldest first.  Blocks without messages are omitted.
+	Counts []InboundBlockCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts"`
 }
 
-func (m *StringBeans) Reset()         { *m = StringBeans{} }
-func (m *StringBeans) String() string { return proto.CompactTextString(m) }
-func (*StringBeans) ProtoMessage()    {}
-func (*StringBeans) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{4}
+func (m *InboundSenderHistory) Reset()         { *m = InboundSenderHistory{} }
+func (m *InboundSenderHistory) String() string { return proto.CompactTextString(m) }
+func (*InboundSenderHistory) ProtoMessage()    {}
+func (*InboundSenderHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{6}
 }
-func (m *StringBeans) XXX_Unmarshal(b []byte) error {
+func (m *InboundSenderHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InboundSenderHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StringBeans.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InboundSenderHistory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -306,43 +475,43 @@ func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *StringBeans) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StringBeans.Merge(m, src)
+func (m *InboundSenderHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundSenderHistory.Merge(m, src)
 }
-func (m *StringBeans) XXX_Size() int {
+func (m *InboundSenderHistory) XXX_Size() int {
 	return m.Size()
 }
-func (m *StringBeans) XXX_DiscardUnknown() {
-	xxx_messageInfo_StringBeans.DiscardUnknown(m)
+func (m *InboundSenderHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundSenderHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StringBeans proto.InternalMessageInfo
+var xxx_messageInfo_InboundSenderHistory proto.InternalMessageInfo
 
-func (m *StringBeans) GetKey() string {
+func (m *InboundSenderHistory) GetCounts() []InboundBlockCount {
 	if m != nil {
-		return m.Key
+		return m.Counts
 	}
-	return ""
+	return nil
 }
 
-// Map a provisioning power flag to its corresponding fee.
-type PowerFlagFee struct {
-	PowerFlag string                                   `protobuf:"bytes,1,opt,name=power_flag,json=powerFlag,proto3" json:"power_flag,omitempty"`
-	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+// The number of inbound messages admitted from a sender in one block.
+type InboundBlockCount struct {
+	BlockHeight int64  `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Count       uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *PowerFlagFee) Reset()         { *m = PowerFlagFee{} }
-func (m *PowerFlagFee) String() string { return proto.CompactTextString(m) }
-func (*PowerFlagFee) ProtoMessage()    {}
-func (*PowerFlagFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{5}
+func (m *InboundBlockCount) Reset()         { *m = InboundBlockCount{} }
+func (m *InboundBlockCount) String() string { return proto.CompactTextString(m) }
+func (*InboundBlockCount) ProtoMessage()    {}
+func (*InboundBlockCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{7}
 }
-func (m *PowerFlagFee) XXX_Unmarshal(b []byte) error {
+func (m *InboundBlockCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InboundBlockCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PowerFlagFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InboundBlockCount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -352,52 +521,58 @@ func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PowerFlagFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PowerFlagFee.Merge(m, src)
+func (m *InboundBlockCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundBlockCount.Merge(m, src)
 }
-func (m *PowerFlagFee) XXX_Size() int {
+func (m *InboundBlockCount) XXX_Size() int {
 	return m.Size()
 }
-func (m *PowerFlagFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_PowerFlagFee.DiscardUnknown(m)
+func (m *InboundBlockCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundBlockCount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PowerFlagFee proto.InternalMessageInfo
+var xxx_messageInfo_InboundBlockCount proto.InternalMessageInfo
 
-func (m *PowerFlagFee) GetPowerFlag() string {
+func (m *InboundBlockCount) GetBlockHeight() int64 {
 	if m != nil {
-		return m.PowerFlag
+		return m.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *PowerFlagFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *InboundBlockCount) GetCount() uint32 {
 	if m != nil {
-		return m.Fee
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-// Map element of a string key to a size.
-type QueueSize struct {
-	// What the size is for.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The actual size value.
-	Size_ int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+// HighPrioritySender is an entry of the governance-managed registry of senders
+// whose messages are placed on the high priority queue.  An address may be
+// registered under several namespaces.
+type HighPrioritySender struct {
+	// The bech32 address of the sender.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address" yaml:"address"`
+	// A label for the reason of the priority, such as "oracle" or "keeper".
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	// The block height at which the sender was added.
+	AddedHeight int64 `protobuf:"varint,3,opt,name=added_height,json=addedHeight,proto3" json:"addedHeight" yaml:"addedHeight"`
+	// The block time at which the sender was added.
+	AddedTime time.Time `protobuf:"bytes,4,opt,name=added_time,json=addedTime,proto3,stdtime" json:"addedTime" yaml:"addedTime"`
 }
 
-func (m *QueueSize) Reset()         { *m = QueueSize{} }
-func (m *QueueSize) String() string { return proto.CompactTextString(m) }
-func (*QueueSize) ProtoMessage()    {}
-func (*QueueSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{6}
+func (m *HighPrioritySender) Reset()         { *m = HighPrioritySender{} }
+func (m *HighPrioritySender) String() string { return proto.CompactTextString(m) }
+func (*HighPrioritySender) ProtoMessage()    {}
+func (*HighPrioritySender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{8}
 }
-func (m *QueueSize) XXX_Unmarshal(b []byte) error {
+func (m *HighPrioritySender) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HighPrioritySender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueSize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HighPrioritySender.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -407,52 +582,82 @@ func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueueSize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueSize.Merge(m, src)
+func (m *HighPrioritySender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighPrioritySender.Merge(m, src)
 }
-func (m *QueueSize) XXX_Size() int {
+func (m *HighPrioritySender) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueSize) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueSize.DiscardUnknown(m)
+func (m *HighPrioritySender) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighPrioritySender.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueSize proto.InternalMessageInfo
+var xxx_messageInfo_HighPrioritySender proto.InternalMessageInfo
 
-func (m *QueueSize) GetKey() string {
+func (m *HighPrioritySender) GetAddress() string {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return ""
 }
 
-func (m *QueueSize) GetSize_() int32 {
+func (m *HighPrioritySender) GetNamespace() string {
 	if m != nil {
-		return m.Size_
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *HighPrioritySender) GetAddedHeight() int64 {
+	if m != nil {
+		return m.AddedHeight
 	}
 	return 0
 }
 
-// Egress is the format for a swingset egress.
-type Egress struct {
-	Nickname string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
-	Peer     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
-	// TODO: Remove these power flags as they are deprecated and have no effect.
-	PowerFlags []string `protobuf:"bytes,3,rep,name=power_flags,json=powerFlags,proto3" json:"powerFlags" yaml:"powerFlags"`
+func (m *HighPrioritySender) GetAddedTime() time.Time {
+	if m != nil {
+		return m.AddedTime
+	}
+	return time.Time{}
 }
 
-func (m *Egress) Reset()         { *m = Egress{} }
-func (m *Egress) String() string { return proto.CompactTextString(m) }
-func (*Egress) ProtoMessage()    {}
-func (*Egress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{7}
+// BundleUpload records the progress of a chunked bundle upload.  The chunks
+// themselves are stored separately until the upload completes or expires.
+type BundleUpload struct {
+	// The bech32 address of the submitter.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter" yaml:"submitter"`
+	// The lowercase hex SHA-512 of the upload payload.
+	PayloadHash string `protobuf:"bytes,2,opt,name=payload_hash,json=payloadHash,proto3" json:"payloadHash" yaml:"payloadHash"`
+	// The declared size in bytes of the upload payload.
+	TotalSize int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"totalSize" yaml:"totalSize"`
+	// The declared number of chunks.
+	ChunkCount uint32 `protobuf:"varint,4,opt,name=chunk_count,json=chunkCount,proto3" json:"chunkCount" yaml:"chunkCount"`
+	// Size in bytes of the uncompressed bundle if the payload is gzip
+	// compressed, otherwise zero.
+	UncompressedSize int64 `protobuf:"varint,5,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize" yaml:"uncompressedSize"`
+	// The number of distinct chunks received so far.
+	ReceivedChunks uint32 `protobuf:"varint,6,opt,name=received_chunks,json=receivedChunks,proto3" json:"receivedChunks" yaml:"receivedChunks"`
+	// The total size in bytes of the chunks received so far.
+	ReceivedSize int64 `protobuf:"varint,7,opt,name=received_size,json=receivedSize,proto3" json:"receivedSize" yaml:"receivedSize"`
+	// The block height at which the upload began.
+	StartHeight int64 `protobuf:"varint,8,opt,name=start_height,json=startHeight,proto3" json:"startHeight" yaml:"startHeight"`
+	// The block height at the end of which an incomplete upload is discarded.
+	ExpiryHeight int64 `protobuf:"varint,9,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiryHeight" yaml:"expiryHeight"`
 }
-func (m *Egress) XXX_Unmarshal(b []byte) error {
+
+func (m *BundleUpload) Reset()         { *m = BundleUpload{} }
+func (m *BundleUpload) String() string { return proto.CompactTextString(m) }
+func (*BundleUpload) ProtoMessage()    {}
+func (*BundleUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{9}
+}
+func (m *BundleUpload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BundleUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Egress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BundleUpload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -462,60 +667,118 @@ func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Egress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Egress.Merge(m, src)
+func (m *BundleUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleUpload.Merge(m, src)
 }
-func (m *Egress) XXX_Size() int {
+func (m *BundleUpload) XXX_Size() int {
 	return m.Size()
 }
-func (m *Egress) XXX_DiscardUnknown() {
-	xxx_messageInfo_Egress.DiscardUnknown(m)
+func (m *BundleUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleUpload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Egress proto.InternalMessageInfo
+var xxx_messageInfo_BundleUpload proto.InternalMessageInfo
 
-func (m *Egress) GetNickname() string {
+func (m *BundleUpload) GetSubmitter() string {
 	if m != nil {
-		return m.Nickname
+		return m.Submitter
 	}
 	return ""
 }
 
-func (m *Egress) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *BundleUpload) GetPayloadHash() string {
 	if m != nil {
-		return m.Peer
+		return m.PayloadHash
 	}
-	return nil
+	return ""
 }
 
-func (m *Egress) GetPowerFlags() []string {
+func (m *BundleUpload) GetTotalSize() int64 {
 	if m != nil {
-		return m.PowerFlags
+		return m.TotalSize
 	}
-	return nil
+	return 0
 }
 
-// SwingStoreArtifact encodes an artifact of a swing-store export.
-// Artifacts may be stored or transmitted in any order. Most handlers do
-// maintain the artifact order from their original source as an effect of how
-// they handle the artifacts.
-type SwingStoreArtifact struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data" yaml:"data"`
+func (m *BundleUpload) GetChunkCount() uint32 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
 }
 
-func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
-func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
-func (*SwingStoreArtifact) ProtoMessage()    {}
-func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{8}
+func (m *BundleUpload) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
+	}
+	return 0
 }
-func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
+
+func (m *BundleUpload) GetReceivedChunks() uint32 {
+	if m != nil {
+		return m.ReceivedChunks
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetReceivedSize() int64 {
+	if m != nil {
+		return m.ReceivedSize
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BundleUpload) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// SubmittedBundle records a bundle submitted to the controller for
+// installation, either by MsgInstallBundle or by a chunked upload.  Bundles are
+// only recorded if their content matches the hash in their bundle ID.
+//
+// A record is "pending" from when the bundle is queued for the controller
+// until the controller reports installing it, and is removed if the
+// controller reports that it failed, so that it may be submitted again.
+// Bundles installed before these records were kept, or by a core proposal at
+// bootstrap, have no record; the controller's bundle store remains the
+// authority on what is installed.
+type SubmittedBundle struct {
+	// The bundle ID, "b1-" followed by the lowercase hex SHA-512 of the
+	// compartment map in the bundle's archive.
+	BundleID string `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundleId" yaml:"bundleId"`
+	// The bech32 address of the first submitter.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter" yaml:"submitter"`
+	// The block height of the first submission.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height" yaml:"height"`
+	// The size in bytes of the uncompressed bundle JSON.
+	Size_ int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size" yaml:"size"`
+	// "pending" until the controller reports that it has "installed" the
+	// bundle.
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status" yaml:"status"`
+}
+
+func (m *SubmittedBundle) Reset()         { *m = SubmittedBundle{} }
+func (m *SubmittedBundle) String() string { return proto.CompactTextString(m) }
+func (*SubmittedBundle) ProtoMessage()    {}
+func (*SubmittedBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{10}
+}
+func (m *SubmittedBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubmittedBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SwingStoreArtifact.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubmittedBundle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -525,858 +788,3858 @@ func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SwingStoreArtifact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwingStoreArtifact.Merge(m, src)
+func (m *SubmittedBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmittedBundle.Merge(m, src)
 }
-func (m *SwingStoreArtifact) XXX_Size() int {
+func (m *SubmittedBundle) XXX_Size() int {
 	return m.Size()
 }
-func (m *SwingStoreArtifact) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwingStoreArtifact.DiscardUnknown(m)
+func (m *SubmittedBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmittedBundle.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SwingStoreArtifact proto.InternalMessageInfo
+var xxx_messageInfo_SubmittedBundle proto.InternalMessageInfo
 
-func (m *SwingStoreArtifact) GetName() string {
+func (m *SubmittedBundle) GetBundleID() string {
 	if m != nil {
-		return m.Name
+		return m.BundleID
 	}
 	return ""
 }
 
-func (m *SwingStoreArtifact) GetData() []byte {
+func (m *SubmittedBundle) GetSubmitter() string {
 	if m != nil {
-		return m.Data
+		return m.Submitter
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
-	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
-	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
-	proto.RegisterType((*State)(nil), "agoric.swingset.State")
-	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
-	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
-	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
-	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
-	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
-}
-
-func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
-
-var fileDescriptor_ff9c341e0de15f8b = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x49, 0x52, 0x9a, 0x97, 0x6c, 0xbb, 0x0c, 0x95, 0x36, 0x54, 0x6c, 0xa6, 0xf2, 0x85,
-	0x4a, 0xab, 0x8d, 0xb7, 0x20, 0x84, 0x94, 0x15, 0x87, 0xb8, 0xea, 0x6a, 0x25, 0x04, 0x0a, 0x8e,
-	0xca, 0x01, 0x81, 0xac, 0x89, 0x33, 0x31, 0xd3, 0x3a, 0x1e, 0xef, 0xcc, 0xf4, 0xd7, 0xfe, 0x03,
-	0x70, 0x41, 0x42, 0x9c, 0x38, 0xf6, 0xcc, 0x5f, 0xb2, 0xc7, 0x3d, 0x22, 0x0e, 0x06, 0xb5, 0x17,
-	0xd4, 0x63, 0x8e, 0x48, 0x48, 0x68, 0x66, 0x1c, 0xc7, 0xa2, 0x48, 0xf4, 0xc2, 0x29, 0xf3, 0x7e,
-	0x7d, 0xef, 0x7d, 0xdf, 0x1b, 0x4f, 0xa0, 0x47, 0x62, 0x2e, 0x58, 0xe4, 0xc9, 0x33, 0x96, 0xc6,
-	0x92, 0xaa, 0xf2, 0xd0, 0xcf, 0x04, 0x57, 0x1c, 0x6d, 0xda, 0x78, 0x7f, 0xe9, 0xde, 0xde, 0x8a,
-	0x79, 0xcc, 0x4d, 0xcc, 0xd3, 0x27, 0x9b, 0xb6, 0xdd, 0x8b, 0xb8, 0x9c, 0x73, 0xe9, 0x4d, 0x88,
-	0xa4, 0xde, 0xe9, 0xde, 0x84, 0x2a, 0xb2, 0xe7, 0x45, 0x9c, 0xa5, 0x36, 0xee, 0x7e, 0xeb, 0xc0,
-	0xfd, 0x7d, 0x2e, 0xe8, 0xc1, 0x29, 0x49, 0x46, 0x82, 0x67, 0x5c, 0x92, 0x04, 0x6d, 0x41, 0x53,
-	0x31, 0x95, 0xd0, 0xae, 0xb3, 0xe3, 0xec, 0xb6, 0x02, 0x6b, 0xa0, 0x1d, 0x68, 0x4f, 0xa9, 0x8c,
-	0x04, 0xcb, 0x14, 0xe3, 0x69, 0xf7, 0x0d, 0x13, 0xab, 0xba, 0xd0, 0x87, 0xd0, 0xa4, 0xa7, 0x24,
-	0x91, 0xdd, 0xfa, 0x4e, 0x7d, 0xb7, 0xfd, 0xfe, 0x3b, 0xfd, 0x7f, 0xcc, 0xd8, 0x5f, 0x76, 0xf2,
-	0x1b, 0xaf, 0x72, 0x5c, 0x0b, 0x6c, 0xf6, 0xa0, 0xf1, 0xdd, 0x25, 0xae, 0xb9, 0x12, 0xd6, 0x97,
-	0x61, 0x34, 0x80, 0xce, 0x91, 0xe4, 0x69, 0x98, 0x51, 0x31, 0x67, 0x4a, 0xda, 0x39, 0xfc, 0x07,
-	0x8b, 0x1c, 0xbf, 0x7d, 0x41, 0xe6, 0xc9, 0xc0, 0xad, 0x46, 0xdd, 0xa0, 0xad, 0xcd, 0x91, 0xb5,
-	0xd0, 0x23, 0x78, 0xf3, 0x48, 0x86, 0x11, 0x9f, 0x52, 0x3b, 0xa2, 0x8f, 0x16, 0x39, 0xde, 0x58,
-	0x96, 0x99, 0x80, 0x1b, 0xac, 0x1d, 0xc9, 0x7d, 0x7d, 0xf8, 0xbe, 0x0e, 0x6b, 0x23, 0x22, 0xc8,
-	0x5c, 0xa2, 0xe7, 0xb0, 0x31, 0xa1, 0x24, 0x95, 0x1a, 0x36, 0x3c, 0x49, 0x99, 0xea, 0x3a, 0x86,
-	0xc5, 0xbb, 0xb7, 0x58, 0x8c, 0x95, 0x60, 0x69, 0xec, 0xeb, 0xe4, 0x82, 0x48, 0xc7, 0x54, 0x8e,
-	0xa8, 0x38, 0x4c, 0x99, 0x42, 0x2f, 0x60, 0x63, 0x46, 0xa9, 0xc1, 0x08, 0x33, 0xc1, 0x22, 0x3d,
-	0x88, 0xd5, 0xc3, 0x2e, 0xa3, 0xaf, 0x97, 0xd1, 0x2f, 0x96, 0xd1, 0xdf, 0xe7, 0x2c, 0xf5, 0x9f,
-	0x68, 0x98, 0x9f, 0x7f, 0xc3, 0xbb, 0x31, 0x53, 0xdf, 0x9c, 0x4c, 0xfa, 0x11, 0x9f, 0x7b, 0xc5,
-	0xe6, 0xec, 0xcf, 0x63, 0x39, 0x3d, 0xf6, 0xd4, 0x45, 0x46, 0xa5, 0x29, 0x90, 0x41, 0x67, 0x46,
-	0xa9, 0xee, 0x36, 0xd2, 0x0d, 0xd0, 0x13, 0xd8, 0x9a, 0x70, 0xae, 0xa4, 0x12, 0x24, 0x0b, 0x4f,
-	0x89, 0x0a, 0x23, 0x9e, 0xce, 0x58, 0xdc, 0xad, 0x9b, 0x25, 0xa1, 0x32, 0xf6, 0x05, 0x51, 0xfb,
-	0x26, 0x82, 0x3e, 0x81, 0xcd, 0x8c, 0x9f, 0x51, 0x11, 0xce, 0x12, 0x12, 0x87, 0x33, 0x4a, 0x65,
-	0xb7, 0x61, 0xa6, 0x7c, 0x78, 0x8b, 0xef, 0x48, 0xe7, 0x3d, 0x4b, 0x48, 0xfc, 0x8c, 0xd2, 0x82,
-	0xf0, 0xbd, 0xac, 0xe2, 0x93, 0xe8, 0x63, 0x68, 0xbd, 0x38, 0xa1, 0x27, 0x34, 0x9c, 0x93, 0xf3,
-	0x6e, 0xd3, 0xc0, 0x6c, 0xdf, 0x82, 0xf9, 0x5c, 0x67, 0x8c, 0xd9, 0xcb, 0x25, 0xc6, 0xba, 0x29,
-	0xf9, 0x94, 0x9c, 0x0f, 0xd6, 0x7f, 0xba, 0xc4, 0xb5, 0x3f, 0x2e, 0xb1, 0xe3, 0x7e, 0x06, 0xcd,
-	0xb1, 0x22, 0x8a, 0xa2, 0x03, 0xb8, 0x67, 0x11, 0x49, 0x92, 0xf0, 0x33, 0x3a, 0x2d, 0x96, 0xf1,
-	0xdf, 0xa8, 0x1d, 0x53, 0x36, 0xb4, 0x55, 0x6e, 0x02, 0xed, 0xca, 0xb6, 0xd0, 0x7d, 0xa8, 0x1f,
-	0xd3, 0x8b, 0xe2, 0x5a, 0xeb, 0x23, 0x3a, 0x80, 0xa6, 0xd9, 0x5d, 0x71, 0x57, 0x3c, 0x8d, 0xf1,
-	0x6b, 0x8e, 0xdf, 0xbb, 0xc3, 0x1e, 0x0e, 0x59, 0xaa, 0x02, 0x5b, 0x3d, 0x68, 0x98, 0xe9, 0x7f,
-	0x74, 0xa0, 0x53, 0x15, 0x0b, 0x3d, 0x04, 0x58, 0x89, 0x5c, 0xb4, 0x6d, 0x95, 0xd2, 0xa1, 0xaf,
-	0xa1, 0x3e, 0xa3, 0xff, 0xcb, 0xed, 0xd0, 0xb8, 0xc5, 0x50, 0x1f, 0x41, 0xab, 0xd4, 0xe8, 0x5f,
-	0x04, 0x40, 0xd0, 0x90, 0xec, 0xa5, 0xfd, 0x56, 0x9a, 0x81, 0x39, 0x17, 0x85, 0x7f, 0x39, 0xb0,
-	0x76, 0x10, 0x0b, 0x2a, 0x25, 0x7a, 0x0a, 0xeb, 0x29, 0x8b, 0x8e, 0x53, 0x32, 0x2f, 0xde, 0x04,
-	0x1f, 0xdf, 0xe4, 0xb8, 0xf4, 0x2d, 0x72, 0xbc, 0x69, 0x3f, 0xb0, 0xa5, 0xc7, 0x0d, 0xca, 0x20,
-	0xfa, 0x0a, 0x1a, 0x19, 0xa5, 0xc2, 0x74, 0xe8, 0xf8, 0xcf, 0x6f, 0x72, 0x6c, 0xec, 0x45, 0x8e,
-	0xdb, 0xb6, 0x48, 0x5b, 0xee, 0x9f, 0x39, 0x7e, 0x7c, 0x07, 0x7a, 0xc3, 0x28, 0x1a, 0x4e, 0xa7,
-	0x7a, 0xa8, 0xc0, 0xa0, 0xa0, 0x00, 0xda, 0x2b, 0x89, 0xed, 0xcb, 0xd3, 0xf2, 0xf7, 0xae, 0x72,
-	0x0c, 0xe5, 0x26, 0xe4, 0x4d, 0x8e, 0xa1, 0x54, 0x5d, 0x2e, 0x72, 0xfc, 0x56, 0xd1, 0xb8, 0xf4,
-	0xb9, 0x41, 0x25, 0xc1, 0xf0, 0xaf, 0xb9, 0x0a, 0xd0, 0x58, 0xdf, 0xb2, 0xb1, 0xe2, 0x82, 0x0e,
-	0x85, 0x62, 0x33, 0x12, 0x29, 0xf4, 0x08, 0x1a, 0x15, 0x19, 0x1e, 0x68, 0x36, 0x85, 0x04, 0x05,
-	0x1b, 0x4b, 0xdf, 0x38, 0x75, 0xf2, 0x94, 0x28, 0x52, 0x50, 0x37, 0xc9, 0xda, 0x5e, 0x25, 0x6b,
-	0xcb, 0x0d, 0x8c, 0xd3, 0x76, 0xf5, 0x0f, 0x5f, 0x5d, 0xf5, 0x9c, 0xd7, 0x57, 0x3d, 0xe7, 0xf7,
-	0xab, 0x9e, 0xf3, 0xc3, 0x75, 0xaf, 0xf6, 0xfa, 0xba, 0x57, 0xfb, 0xe5, 0xba, 0x57, 0xfb, 0xf2,
-	0x69, 0x45, 0x9e, 0xa1, 0xfd, 0x73, 0xb0, 0x1f, 0x83, 0x91, 0x27, 0xe6, 0x09, 0x49, 0xe3, 0xa5,
-	0x6e, 0xe7, 0xab, 0xff, 0x0d, 0xa3, 0xdb, 0x64, 0xcd, 0x3c, 0xf7, 0x1f, 0xfc, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xee, 0x34, 0x5f, 0xf6, 0x57, 0x06, 0x00, 0x00,
+func (m *SubmittedBundle) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SubmittedBundle) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
-		return false
-	}
-	for i := range this.BeansPerUnit {
-		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
-			return false
-		}
-	}
-	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
-		return false
-	}
-	for i := range this.FeeUnitPrice {
-		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
-			return false
-		}
-	}
-	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
-		return false
-	}
-	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
-		return false
-	}
-	for i := range this.PowerFlagFees {
-		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
-			return false
-		}
-	}
-	if len(this.QueueMax) != len(that1.QueueMax) {
-		return false
-	}
-	for i := range this.QueueMax {
-		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
-			return false
-		}
+func (m *SubmittedBundle) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return true
+	return ""
 }
-func (this *StringBeans) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*StringBeans)
-	if !ok {
-		that2, ok := that.(StringBeans)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if !this.Beans.Equal(that1.Beans) {
-		return false
-	}
-	return true
+// CoreEvalRecord records a core-eval passed by governance and the outcome of
+// its evaluation as reported by the controller.
+type CoreEvalRecord struct {
+	// The lowercase hex SHA-256 of the concatenated SHA-256 digests of the
+	// permit and the code.
+	ContentHash string `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"contentHash" yaml:"contentHash"`
+	// The lowercase hex SHA-256 of the permit.
+	PermitHash string `protobuf:"bytes,2,opt,name=permit_hash,json=permitHash,proto3" json:"permitHash" yaml:"permitHash"`
+	// The lowercase hex SHA-256 of the code.
+	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"codeHash" yaml:"codeHash"`
+	// The block height at which the core-eval was last queued.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height" yaml:"height"`
+	// "pending" until the controller reports an outcome of "succeeded" or
+	// "failed".
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status" yaml:"status"`
+	// The error reported by the controller for a failed evaluation.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error" yaml:"error"`
+	// The block height at which the outcome was reported.
+	OutcomeHeight int64 `protobuf:"varint,7,opt,name=outcome_height,json=outcomeHeight,proto3" json:"outcomeHeight" yaml:"outcomeHeight"`
 }
-func (this *PowerFlagFee) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*PowerFlagFee)
-	if !ok {
-		that2, ok := that.(PowerFlagFee)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PowerFlag != that1.PowerFlag {
-		return false
-	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
-	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
-			return false
+func (m *CoreEvalRecord) Reset()         { *m = CoreEvalRecord{} }
+func (m *CoreEvalRecord) String() string { return proto.CompactTextString(m) }
+func (*CoreEvalRecord) ProtoMessage()    {}
+func (*CoreEvalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{11}
+}
+func (m *CoreEvalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoreEvalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoreEvalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *QueueSize) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CoreEvalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoreEvalRecord.Merge(m, src)
+}
+func (m *CoreEvalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoreEvalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoreEvalRecord.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueueSize)
-	if !ok {
-		that2, ok := that.(QueueSize)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if this.Size_ != that1.Size_ {
-		return false
+var xxx_messageInfo_CoreEvalRecord proto.InternalMessageInfo
+
+func (m *CoreEvalRecord) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
 	}
-	return true
+	return ""
 }
-func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *CoreEvalRecord) GetPermitHash() string {
+	if m != nil {
+		return m.PermitHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CoreEvalProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CoreEvalRecord) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
 }
 
-func (m *CoreEvalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Evals) > 0 {
-		for iNdEx := len(m.Evals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Evals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *CoreEvalRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CoreEval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CoreEvalRecord) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CoreEval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CoreEvalRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *CoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JsCode) > 0 {
-		i -= len(m.JsCode)
-		copy(dAtA[i:], m.JsCode)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsCode)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JsonPermits) > 0 {
-		i -= len(m.JsonPermits)
-		copy(dAtA[i:], m.JsonPermits)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsonPermits)))
-		i--
-		dAtA[i] = 0xa
+func (m *CoreEvalRecord) GetOutcomeHeight() int64 {
+	if m != nil {
+		return m.OutcomeHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Map element of a string key to a Nat bean count.
+type StringBeans struct {
+	// What the beans are for.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The actual bean value.
+	Beans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=beans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beans"`
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StringBeans) Reset()         { *m = StringBeans{} }
+func (m *StringBeans) String() string { return proto.CompactTextString(m) }
+func (*StringBeans) ProtoMessage()    {}
+func (*StringBeans) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{12}
 }
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueueMax) > 0 {
-		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PowerFlagFees) > 0 {
-		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.BootstrapVatConfig) > 0 {
-		i -= len(m.BootstrapVatConfig)
-		copy(dAtA[i:], m.BootstrapVatConfig)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FeeUnitPrice) > 0 {
-		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.BeansPerUnit) > 0 {
-		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *StringBeans) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StringBeans.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StringBeans) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringBeans.Merge(m, src)
+}
+func (m *StringBeans) XXX_Size() int {
+	return m.Size()
+}
+func (m *StringBeans) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringBeans.DiscardUnknown(m)
 }
 
-func (m *State) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StringBeans proto.InternalMessageInfo
+
+func (m *StringBeans) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Map a provisioning power flag to its corresponding fee.
+type PowerFlagFee struct {
+	PowerFlag string                                   `protobuf:"bytes,1,opt,name=power_flag,json=powerFlag,proto3" json:"power_flag,omitempty"`
+	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueueAllowed) > 0 {
-		for iNdEx := len(m.QueueAllowed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueueAllowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PowerFlagFee) Reset()         { *m = PowerFlagFee{} }
+func (m *PowerFlagFee) String() string { return proto.CompactTextString(m) }
+func (*PowerFlagFee) ProtoMessage()    {}
+func (*PowerFlagFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{13}
+}
+func (m *PowerFlagFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerFlagFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *StringBeans) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PowerFlagFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerFlagFee.Merge(m, src)
 }
-
-func (m *StringBeans) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PowerFlagFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerFlagFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerFlagFee.DiscardUnknown(m)
 }
 
-func (m *StringBeans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Beans.Size()
-		i -= size
-		if _, err := m.Beans.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSwingset(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_PowerFlagFee proto.InternalMessageInfo
+
+func (m *PowerFlagFee) GetPowerFlag() string {
+	if m != nil {
+		return m.PowerFlag
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PowerFlagFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PowerFlagFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PowerFlagFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Map element of a string key to a size.
+type QueueSize struct {
+	// What the size is for.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The actual size value.
+	Size_ int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *PowerFlagFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.PowerFlag) > 0 {
-		i -= len(m.PowerFlag)
-		copy(dAtA[i:], m.PowerFlag)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlag)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueueSize) Reset()         { *m = QueueSize{} }
+func (m *QueueSize) String() string { return proto.CompactTextString(m) }
+func (*QueueSize) ProtoMessage()    {}
+func (*QueueSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{14}
 }
-
-func (m *QueueSize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueueSize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSize.Merge(m, src)
+}
+func (m *QueueSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSize.DiscardUnknown(m)
 }
 
-func (m *QueueSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueueSize proto.InternalMessageInfo
+
+func (m *QueueSize) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Egress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueSize) GetSize_() int32 {
+	if m != nil {
+		return m.Size_
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Egress is the format for a swingset egress.
+type Egress struct {
+	Nickname string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
+	Peer     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
+	// TODO: Remove these power flags as they are deprecated and have no effect.
+	PowerFlags []string `protobuf:"bytes,3,rep,name=power_flags,json=powerFlags,proto3" json:"powerFlags" yaml:"powerFlags"`
 }
 
-func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PowerFlags) > 0 {
-		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerFlags[iNdEx])
-			copy(dAtA[i:], m.PowerFlags[iNdEx])
-			i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *Egress) Reset()         { *m = Egress{} }
+func (m *Egress) String() string { return proto.CompactTextString(m) }
+func (*Egress) ProtoMessage()    {}
+func (*Egress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{15}
+}
+func (m *Egress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Egress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Peer)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Egress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Egress.Merge(m, src)
+}
+func (m *Egress) XXX_Size() int {
+	return m.Size()
+}
+func (m *Egress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Egress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Egress proto.InternalMessageInfo
+
+func (m *Egress) GetNickname() string {
+	if m != nil {
+		return m.Nickname
 	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Nickname)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Egress) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Peer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Egress) GetPowerFlags() []string {
+	if m != nil {
+		return m.PowerFlags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SwingStoreArtifact encodes an artifact of a swing-store export.
+// Artifacts may be stored or transmitted in any order. Most handlers do
+// maintain the artifact order from their original source as an effect of how
+// they handle the artifacts.
+type SwingStoreArtifact struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data" yaml:"data"`
 }
 
-func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
+func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreArtifact) ProtoMessage()    {}
+func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{16}
+}
+func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreArtifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SwingStoreArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreArtifact.Merge(m, src)
+}
+func (m *SwingStoreArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreArtifact.DiscardUnknown(m)
 }
 
-func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwingset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_SwingStoreArtifact proto.InternalMessageInfo
+
+func (m *SwingStoreArtifact) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *CoreEvalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	if len(m.Evals) > 0 {
-		for _, e := range m.Evals {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+
+func (m *SwingStoreArtifact) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return n
+	return nil
 }
 
-func (m *CoreEval) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JsonPermits)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	l = len(m.JsCode)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
+	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
+	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
+	proto.RegisterType((*FeeMarket)(nil), "agoric.swingset.FeeMarket")
+	proto.RegisterType((*InboundRateLimit)(nil), "agoric.swingset.InboundRateLimit")
+	proto.RegisterType((*State)(nil), "agoric.swingset.State")
+	proto.RegisterType((*InboundSenderHistory)(nil), "agoric.swingset.InboundSenderHistory")
+	proto.RegisterType((*InboundBlockCount)(nil), "agoric.swingset.InboundBlockCount")
+	proto.RegisterType((*HighPrioritySender)(nil), "agoric.swingset.HighPrioritySender")
+	proto.RegisterType((*BundleUpload)(nil), "agoric.swingset.BundleUpload")
+	proto.RegisterType((*SubmittedBundle)(nil), "agoric.swingset.SubmittedBundle")
+	proto.RegisterType((*CoreEvalRecord)(nil), "agoric.swingset.CoreEvalRecord")
+	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
+	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
+	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
+	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
+	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
+
+var fileDescriptor_ff9c341e0de15f8b = []byte{
+	// 1860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0x45, 0x52, 0x12, 0x87, 0xa4, 0x24, 0x4f, 0x14, 0x98, 0x71, 0x6a, 0xad, 0xbc, 0x41,
+	0x1b, 0x07, 0x46, 0xc8, 0xb8, 0x41, 0x11, 0x40, 0x69, 0x90, 0x88, 0xb2, 0x0c, 0x19, 0xb5, 0x51,
+	0x75, 0x14, 0xf5, 0x0b, 0x29, 0xb6, 0xc3, 0xdd, 0x21, 0x35, 0xd6, 0x72, 0x67, 0xb3, 0x33, 0xd4,
+	0x47, 0xfe, 0x81, 0xf6, 0x52, 0x20, 0xe8, 0xa9, 0x47, 0x9f, 0x7b, 0xec, 0xad, 0xc7, 0x02, 0x3d,
+	0xe4, 0x98, 0xde, 0x8a, 0x1e, 0x36, 0x85, 0xdc, 0x43, 0xc1, 0x23, 0x8f, 0x05, 0x0a, 0x14, 0xf3,
+	0x66, 0xf6, 0x43, 0x92, 0x0b, 0x08, 0x02, 0x72, 0x12, 0xe7, 0xf7, 0xde, 0xfc, 0xde, 0x9b, 0xf7,
+	0x31, 0xfb, 0x46, 0x68, 0x9d, 0x8e, 0x44, 0xc2, 0xfd, 0x9e, 0x3c, 0xe1, 0xd1, 0x48, 0x32, 0x95,
+	0xff, 0xe8, 0xc6, 0x89, 0x50, 0x02, 0xaf, 0x18, 0x79, 0x37, 0x83, 0xef, 0xac, 0x8d, 0xc4, 0x48,
+	0x80, 0xac, 0xa7, 0x7f, 0x19, 0xb5, 0x3b, 0xeb, 0xbe, 0x90, 0x63, 0x21, 0x7b, 0x03, 0x2a, 0x59,
+	0xef, 0xf8, 0xe1, 0x80, 0x29, 0xfa, 0xb0, 0xe7, 0x0b, 0x1e, 0x59, 0xb9, 0x33, 0x12, 0x62, 0x14,
+	0xb2, 0x1e, 0xac, 0x06, 0x93, 0x61, 0x4f, 0xf1, 0x31, 0x93, 0x8a, 0x8e, 0x63, 0xa3, 0xe0, 0xfe,
+	0xa6, 0x82, 0x56, 0xb7, 0x45, 0xc2, 0x76, 0x8e, 0x69, 0xb8, 0x97, 0x88, 0x58, 0x48, 0x1a, 0xe2,
+	0x35, 0x54, 0x57, 0x5c, 0x85, 0xac, 0x53, 0xd9, 0xa8, 0xdc, 0x6f, 0x10, 0xb3, 0xc0, 0x1b, 0xa8,
+	0x19, 0x30, 0xe9, 0x27, 0x3c, 0x56, 0x5c, 0x44, 0x9d, 0x79, 0x90, 0x95, 0x21, 0xfc, 0x03, 0x54,
+	0x67, 0xc7, 0x34, 0x94, 0x9d, 0xea, 0x46, 0xf5, 0x7e, 0xf3, 0xfb, 0x6f, 0x74, 0x2f, 0x1d, 0xa2,
+	0x9b, 0x59, 0xea, 0xd7, 0xbe, 0x4a, 0x9d, 0x39, 0x62, 0xb4, 0x37, 0x6b, 0xbf, 0x7d, 0xe1, 0xcc,
+	0xb9, 0x12, 0x2d, 0x65, 0x62, 0xbc, 0x89, 0x5a, 0xcf, 0xa5, 0x88, 0xbc, 0x98, 0x25, 0x63, 0xae,
+	0xa4, 0xf1, 0xa3, 0x7f, 0x7b, 0x96, 0x3a, 0xaf, 0x9d, 0xd1, 0x71, 0xb8, 0xe9, 0x96, 0xa5, 0x2e,
+	0x69, 0xea, 0xe5, 0x9e, 0x59, 0xe1, 0x07, 0x68, 0xf1, 0xb9, 0xf4, 0x7c, 0x11, 0x30, 0xe3, 0x62,
+	0x1f, 0xcf, 0x52, 0x67, 0x39, 0xdb, 0x06, 0x02, 0x97, 0x2c, 0x3c, 0x97, 0xdb, 0xfa, 0xc7, 0xbf,
+	0x6a, 0x68, 0x61, 0x8f, 0x26, 0x74, 0x2c, 0xf1, 0x2e, 0x5a, 0x1e, 0x30, 0x1a, 0x49, 0x4d, 0xeb,
+	0x4d, 0x22, 0xae, 0x3a, 0x15, 0x38, 0xc5, 0x77, 0xae, 0x9c, 0x62, 0x5f, 0x25, 0x3c, 0x1a, 0xf5,
+	0xb5, 0xb2, 0x3d, 0x48, 0x0b, 0x76, 0xee, 0xb1, 0xe4, 0x20, 0xe2, 0x0a, 0x7f, 0x8e, 0x96, 0x87,
+	0x8c, 0x01, 0x87, 0x17, 0x27, 0xdc, 0xd7, 0x8e, 0x98, 0x78, 0x98, 0x6c, 0x75, 0x75, 0xb6, 0xba,
+	0x36, 0x5b, 0xdd, 0x6d, 0xc1, 0xa3, 0xfe, 0x7b, 0x9a, 0xe6, 0x8f, 0xdf, 0x38, 0xf7, 0x47, 0x5c,
+	0x1d, 0x4e, 0x06, 0x5d, 0x5f, 0x8c, 0x7b, 0x36, 0xb5, 0xe6, 0xcf, 0xbb, 0x32, 0x38, 0xea, 0xa9,
+	0xb3, 0x98, 0x49, 0xd8, 0x20, 0x49, 0x6b, 0xc8, 0x98, 0xb6, 0xb6, 0xa7, 0x0d, 0xe0, 0xf7, 0xd0,
+	0xda, 0x40, 0x08, 0x25, 0x55, 0x42, 0x63, 0xef, 0x98, 0x2a, 0xcf, 0x17, 0xd1, 0x90, 0x8f, 0x3a,
+	0x55, 0x48, 0x12, 0xce, 0x65, 0x3f, 0xa5, 0x6a, 0x1b, 0x24, 0xf8, 0x47, 0x68, 0x25, 0x16, 0x27,
+	0x2c, 0xf1, 0x86, 0x21, 0x1d, 0x79, 0x43, 0xc6, 0x64, 0xa7, 0x06, 0x5e, 0xde, 0xbd, 0x72, 0xde,
+	0x3d, 0xad, 0xf7, 0x38, 0xa4, 0xa3, 0xc7, 0x8c, 0xd9, 0x03, 0xb7, 0xe3, 0x12, 0x26, 0xf1, 0x47,
+	0xa8, 0xf1, 0xf9, 0x84, 0x4d, 0x98, 0x37, 0xa6, 0xa7, 0x9d, 0x3a, 0xd0, 0xdc, 0xb9, 0x42, 0xf3,
+	0x13, 0xad, 0xb1, 0xcf, 0xbf, 0xc8, 0x38, 0x96, 0x60, 0xcb, 0x33, 0x7a, 0x8a, 0x3f, 0x46, 0x48,
+	0x07, 0x6c, 0x4c, 0x93, 0x23, 0xa6, 0x3a, 0x0b, 0x1b, 0x95, 0x57, 0xee, 0x7f, 0xcc, 0xd8, 0x33,
+	0xd0, 0xb0, 0xfb, 0x1b, 0xc3, 0x0c, 0xc0, 0x07, 0x08, 0xf3, 0x68, 0x20, 0x26, 0x51, 0xe0, 0x25,
+	0x54, 0x31, 0x2f, 0xe4, 0x63, 0xae, 0x3a, 0x8b, 0x40, 0x74, 0xef, 0x0a, 0xd1, 0x13, 0xa3, 0x4a,
+	0xa8, 0x62, 0x4f, 0xb5, 0xa2, 0xe5, 0x5b, 0xe5, 0x97, 0x70, 0xfc, 0x11, 0x7a, 0x73, 0x30, 0x89,
+	0x82, 0x90, 0x79, 0x93, 0x38, 0x14, 0x34, 0xf0, 0xd8, 0x69, 0xcc, 0x93, 0x33, 0x6f, 0x10, 0x0a,
+	0xff, 0x48, 0x76, 0x96, 0x36, 0x2a, 0xf7, 0xdb, 0xa4, 0x63, 0x54, 0x0e, 0x40, 0x63, 0x07, 0x14,
+	0xfa, 0x20, 0xdf, 0x5c, 0xfa, 0xc3, 0x0b, 0x67, 0xee, 0xdf, 0x2f, 0x9c, 0x8a, 0xfb, 0xa7, 0x2a,
+	0x6a, 0xe4, 0xee, 0xe3, 0x0e, 0x5a, 0x64, 0x11, 0x1d, 0x84, 0x2c, 0x80, 0xc2, 0x5e, 0x22, 0xd9,
+	0x12, 0xbf, 0x85, 0xda, 0x27, 0x3c, 0x0a, 0xc4, 0x49, 0x66, 0x62, 0x1e, 0x4c, 0xb4, 0x0c, 0x68,
+	0x68, 0xf1, 0x2f, 0xd0, 0xaa, 0xa2, 0xc9, 0x88, 0x29, 0x4f, 0xf8, 0xfe, 0x24, 0xa6, 0x91, 0x7f,
+	0x66, 0xf2, 0xdc, 0xef, 0xea, 0x73, 0xfc, 0x23, 0x75, 0xbe, 0x77, 0x8d, 0x2a, 0x7a, 0xc4, 0x7c,
+	0xb2, 0x62, 0x78, 0x7e, 0x9c, 0xd1, 0xe0, 0x9f, 0xa1, 0x15, 0x1a, 0x3c, 0x9f, 0x48, 0x35, 0x66,
+	0x91, 0x82, 0x50, 0x76, 0x6a, 0x37, 0x62, 0x5e, 0x2e, 0x68, 0x74, 0x38, 0xf1, 0x01, 0x5a, 0x1e,
+	0xf3, 0xc8, 0x1b, 0x4f, 0x42, 0xc5, 0xe3, 0x90, 0xb3, 0xa4, 0x53, 0xbf, 0x11, 0x6f, 0x7b, 0xcc,
+	0xa3, 0x67, 0x39, 0x09, 0xd0, 0xd2, 0xd3, 0x32, 0xed, 0xc2, 0x0d, 0x69, 0xe9, 0x69, 0x41, 0xbb,
+	0x59, 0x83, 0xa4, 0xfd, 0x1a, 0xad, 0x5e, 0xae, 0x94, 0xab, 0x09, 0xaa, 0xbc, 0x22, 0x41, 0xf7,
+	0x50, 0x0b, 0xbc, 0x62, 0x52, 0xd2, 0x11, 0xcb, 0x92, 0xd8, 0xd4, 0x36, 0x2c, 0x64, 0x2d, 0xfc,
+	0x6e, 0x1e, 0xd5, 0xf7, 0x95, 0x8e, 0xcf, 0x0e, 0x6a, 0x9b, 0x06, 0xa2, 0x61, 0x28, 0x4e, 0xa0,
+	0x30, 0xae, 0xd7, 0x44, 0x2d, 0xd8, 0xb6, 0x65, 0x76, 0xe9, 0x78, 0x40, 0x23, 0x15, 0xf1, 0x98,
+	0xbf, 0x59, 0x3c, 0x74, 0x6b, 0x15, 0x61, 0x1e, 0xa2, 0xdb, 0x59, 0x7b, 0x19, 0x2f, 0xcb, 0x85,
+	0x57, 0xbd, 0x01, 0xff, 0xeb, 0x96, 0x0e, 0x4e, 0x93, 0x97, 0x9f, 0xfb, 0x73, 0xb4, 0x66, 0x23,
+	0xbe, 0xcf, 0xa2, 0x80, 0x25, 0xbb, 0x5c, 0x2a, 0x91, 0x9c, 0xe1, 0x4f, 0xd0, 0x82, 0x2f, 0x26,
+	0x91, 0x92, 0x36, 0x2c, 0xee, 0xff, 0x6b, 0x69, 0x48, 0xc0, 0xb6, 0x56, 0xb5, 0xe1, 0xb1, 0xfb,
+	0xdc, 0xa7, 0xe8, 0xd6, 0x15, 0x15, 0x9d, 0x27, 0xc8, 0xa2, 0x77, 0xc8, 0xf8, 0xe8, 0x50, 0x41,
+	0x2e, 0xab, 0xa4, 0x09, 0xd8, 0x2e, 0x40, 0xfa, 0x4b, 0x08, 0x0c, 0x36, 0x87, 0x66, 0xe1, 0xfe,
+	0x75, 0x1e, 0xe1, 0x5d, 0x3e, 0x3a, 0xdc, 0x4b, 0xb8, 0x48, 0xb8, 0x3a, 0x33, 0xde, 0xe2, 0x0f,
+	0xd0, 0x22, 0x0d, 0x82, 0x84, 0xc9, 0xec, 0x83, 0x75, 0x77, 0x9a, 0x3a, 0x19, 0x54, 0x7c, 0x84,
+	0x2c, 0xe0, 0x92, 0x4c, 0x84, 0x3f, 0x46, 0x8d, 0x88, 0x8e, 0x99, 0x8c, 0xa9, 0x9f, 0x7d, 0xb4,
+	0xee, 0x4d, 0x53, 0xa7, 0x00, 0x67, 0xa9, 0xb3, 0x6a, 0x36, 0xe7, 0x90, 0x4b, 0x0a, 0x31, 0xde,
+	0x45, 0x2d, 0x1a, 0x04, 0x2c, 0xc8, 0x4e, 0xa2, 0xaf, 0x83, 0x6a, 0xff, 0xbb, 0xd3, 0xd4, 0x69,
+	0x02, 0x6e, 0x4e, 0x33, 0x4b, 0x1d, 0x9c, 0xbb, 0x90, 0x81, 0x2e, 0x29, 0xab, 0xe0, 0x00, 0x21,
+	0xc3, 0xa4, 0x07, 0x05, 0x68, 0x7e, 0x5d, 0x85, 0x66, 0x8a, 0xe8, 0x66, 0x53, 0x44, 0xf7, 0xd3,
+	0x6c, 0x8a, 0xe8, 0xbf, 0xa3, 0xc3, 0xac, 0x7d, 0x85, 0x5d, 0x1a, 0x2f, 0x7c, 0xcd, 0x21, 0xf7,
+	0xcb, 0x6f, 0x9c, 0x0a, 0x29, 0x54, 0x6c, 0xf9, 0xff, 0xa5, 0x8e, 0x5a, 0xfd, 0xd2, 0xe5, 0xa9,
+	0xe3, 0x20, 0x27, 0x83, 0x31, 0x57, 0x8a, 0x25, 0x9d, 0x4a, 0x11, 0x87, 0x1c, 0x2c, 0xb8, 0x73,
+	0xc8, 0x25, 0x85, 0x58, 0xc7, 0x21, 0xa6, 0x67, 0x70, 0x55, 0x1f, 0x52, 0x79, 0x68, 0x63, 0x09,
+	0x71, 0xb0, 0xf8, 0x2e, 0x95, 0x87, 0x45, 0x1c, 0x4a, 0xa0, 0x4b, 0xca, 0x2a, 0xf8, 0x13, 0x84,
+	0x94, 0x50, 0x34, 0xf4, 0x24, 0xff, 0x82, 0xd9, 0x78, 0x82, 0x2f, 0x80, 0xea, 0x06, 0x2c, 0x7c,
+	0xc9, 0x21, 0x97, 0x14, 0x62, 0xfc, 0x08, 0x35, 0xfd, 0xc3, 0x49, 0x74, 0xe4, 0x99, 0x02, 0xd2,
+	0xa1, 0x6c, 0xf7, 0xdf, 0x9a, 0xa6, 0x0e, 0x02, 0x18, 0x4a, 0x70, 0x96, 0x3a, 0xb7, 0x0c, 0x47,
+	0x81, 0xb9, 0xa4, 0xa4, 0x80, 0x3f, 0x43, 0xb7, 0x26, 0x91, 0x2f, 0xc6, 0xb1, 0x2e, 0x14, 0x16,
+	0x18, 0x77, 0xea, 0xe0, 0x4e, 0x6f, 0x9a, 0x3a, 0xab, 0x65, 0xa1, 0xf5, 0xea, 0xb6, 0x61, 0xbc,
+	0x2c, 0x71, 0xc9, 0x15, 0x65, 0xfc, 0x29, 0x5a, 0x49, 0x98, 0xcf, 0xf8, 0x31, 0x0b, 0x3c, 0x30,
+	0x2a, 0xe1, 0x02, 0x6d, 0xf7, 0x1f, 0x4c, 0x53, 0x67, 0x39, 0x13, 0x6d, 0x83, 0x64, 0x96, 0x3a,
+	0xaf, 0x1b, 0xe6, 0x8b, 0xb8, 0x4b, 0x2e, 0x29, 0xe2, 0xa7, 0xa8, 0x9d, 0xb3, 0x82, 0xbf, 0x8b,
+	0xe0, 0xef, 0xdb, 0xd3, 0xd4, 0x69, 0x65, 0x02, 0xeb, 0xeb, 0x6b, 0x17, 0x19, 0x8d, 0x9f, 0x17,
+	0x94, 0x74, 0x4e, 0xa5, 0xa2, 0x89, 0xca, 0x6a, 0x7b, 0xa9, 0xa8, 0x6d, 0xc0, 0x2f, 0xd7, 0x76,
+	0x09, 0x74, 0x49, 0x59, 0x45, 0xfb, 0x65, 0x3f, 0xe0, 0x96, 0xaa, 0x51, 0xf8, 0x65, 0x04, 0x39,
+	0x97, 0xf5, 0xab, 0x8c, 0xba, 0xe4, 0x82, 0x92, 0xad, 0xe1, 0x3f, 0xcf, 0xa3, 0x95, 0x7d, 0x5b,
+	0x7f, 0x81, 0x29, 0x66, 0xbc, 0x8b, 0x1a, 0x76, 0x6c, 0xe0, 0x81, 0x2d, 0xe3, 0x07, 0xe7, 0xa9,
+	0xb3, 0x64, 0xc4, 0x4f, 0x1e, 0x4d, 0x53, 0x67, 0xc9, 0x28, 0x3c, 0x09, 0x66, 0xa9, 0xb3, 0x62,
+	0x6c, 0x65, 0x88, 0x4b, 0x72, 0xe1, 0xc5, 0x86, 0x98, 0xbf, 0x41, 0x43, 0xbc, 0x8f, 0x16, 0x2e,
+	0x5c, 0x09, 0x6f, 0x4e, 0x53, 0xc7, 0x22, 0xb3, 0xd4, 0x69, 0x9b, 0xad, 0x87, 0xf6, 0x7c, 0x56,
+	0x80, 0x1f, 0xa0, 0x1a, 0xa4, 0xad, 0x06, 0x5b, 0x6e, 0x4f, 0x53, 0x07, 0xd6, 0xb3, 0xd4, 0x69,
+	0x5a, 0x5b, 0x90, 0x26, 0x00, 0xb5, 0x05, 0xa9, 0xa8, 0x9a, 0x48, 0xfb, 0x45, 0x07, 0x0b, 0x06,
+	0x29, 0x2c, 0x98, 0xb5, 0x4b, 0xac, 0xc0, 0xc6, 0xee, 0x6f, 0x55, 0xb4, 0x9c, 0x8d, 0xfc, 0x84,
+	0xf9, 0x22, 0x09, 0x74, 0xb2, 0x7d, 0x11, 0x29, 0x3d, 0x7d, 0x40, 0x03, 0x57, 0x8a, 0x06, 0xb6,
+	0xf8, 0xc5, 0x06, 0x2e, 0x81, 0x2e, 0x29, 0xab, 0xe8, 0xf6, 0x33, 0xef, 0x83, 0xf2, 0x4d, 0x00,
+	0xed, 0x67, 0x60, 0xcb, 0x63, 0xdb, 0xaf, 0xc0, 0x5c, 0x52, 0x52, 0xc0, 0x3f, 0x44, 0x0d, 0xfd,
+	0x60, 0x30, 0x1c, 0x66, 0xc8, 0x72, 0x74, 0xfa, 0x34, 0x68, 0x19, 0x56, 0x32, 0x4f, 0x0c, 0xe2,
+	0x92, 0x5c, 0x58, 0x8a, 0x7e, 0xed, 0xfa, 0xd1, 0xbf, 0x49, 0x40, 0x71, 0x0f, 0xd5, 0x59, 0x92,
+	0x88, 0x6c, 0xfe, 0x79, 0x63, 0x9a, 0x3a, 0x06, 0x98, 0xa5, 0x4e, 0xcb, 0x6c, 0x81, 0xa5, 0x4b,
+	0x0c, 0x8c, 0xf7, 0xd0, 0xb2, 0x98, 0x28, 0x5f, 0x8c, 0x59, 0xd6, 0x0c, 0xa6, 0x49, 0xdf, 0x99,
+	0xa6, 0x4e, 0xdb, 0x4a, 0xf2, 0x6e, 0x58, 0x33, 0x0c, 0x17, 0x60, 0x97, 0x5c, 0x54, 0xb3, 0x39,
+	0x0d, 0x51, 0xb3, 0xf4, 0x3c, 0xc2, 0xab, 0xa8, 0x7a, 0xc4, 0xce, 0xec, 0x3b, 0x52, 0xff, 0xc4,
+	0x3b, 0xa8, 0x0e, 0x8f, 0x25, 0x9b, 0x91, 0x9e, 0x9d, 0x1c, 0xde, 0xbe, 0xc6, 0xe4, 0x70, 0xc0,
+	0x23, 0x45, 0xcc, 0x6e, 0x6b, 0xed, 0xf7, 0x15, 0xd4, 0x2a, 0xbf, 0x4e, 0xf0, 0x5d, 0x84, 0x8a,
+	0x57, 0x8d, 0x35, 0xdb, 0xc8, 0xdf, 0x2a, 0xf8, 0x57, 0xa8, 0x3a, 0x64, 0xdf, 0xca, 0x73, 0x4c,
+	0xf3, 0x5a, 0xa7, 0x3e, 0x40, 0x8d, 0x7c, 0x4a, 0x7b, 0x45, 0x00, 0xb0, 0xed, 0x2e, 0x7d, 0xfe,
+	0xba, 0x69, 0x22, 0xbb, 0xf1, 0xbf, 0x15, 0xb4, 0xb0, 0x33, 0x82, 0x89, 0xe0, 0x43, 0xb4, 0x14,
+	0x71, 0xff, 0x48, 0x7f, 0xe1, 0x3b, 0x95, 0xa2, 0xec, 0x32, 0xac, 0x28, 0xbb, 0x0c, 0x71, 0x49,
+	0x2e, 0xc4, 0x9f, 0xa1, 0x5a, 0xcc, 0xec, 0x85, 0xd1, 0xea, 0xef, 0xea, 0xfe, 0xd5, 0xeb, 0xa2,
+	0x7f, 0xf5, 0xca, 0xfd, 0x4f, 0xea, 0xbc, 0x7b, 0x8d, 0xe3, 0x6d, 0xf9, 0xfe, 0x96, 0x19, 0x53,
+	0x08, 0xb0, 0x60, 0x82, 0x9a, 0x45, 0x88, 0xa5, 0x1d, 0x00, 0x1f, 0x9e, 0xa7, 0x0e, 0xca, 0x33,
+	0x21, 0xa1, 0xcd, 0xf2, 0x55, 0xa9, 0xcd, 0x72, 0x4c, 0xb7, 0x59, 0xbe, 0x80, 0xf3, 0xcf, 0xb9,
+	0x0a, 0xe1, 0x7d, 0x3d, 0xd0, 0xed, 0x2b, 0x91, 0xb0, 0xad, 0x44, 0xf1, 0x21, 0xf5, 0xe1, 0x36,
+	0x2a, 0x85, 0x01, 0x6e, 0x23, 0x1b, 0x82, 0x66, 0x31, 0x12, 0xb9, 0x04, 0x40, 0xad, 0x1c, 0x50,
+	0x45, 0xed, 0xd1, 0x41, 0x59, 0xaf, 0x0b, 0x65, 0xbd, 0x72, 0x09, 0x80, 0xc6, 0x6a, 0xff, 0xe0,
+	0xab, 0xf3, 0xf5, 0xca, 0xd7, 0xe7, 0xeb, 0x95, 0x7f, 0x9e, 0xaf, 0x57, 0xbe, 0x7c, 0xb9, 0x3e,
+	0xf7, 0xf5, 0xcb, 0xf5, 0xb9, 0xbf, 0xbf, 0x5c, 0x9f, 0xfb, 0xe5, 0x87, 0xa5, 0xf0, 0x6c, 0x99,
+	0x7f, 0xd7, 0x98, 0xb9, 0x13, 0xc2, 0x33, 0x12, 0x21, 0x8d, 0x46, 0x59, 0xdc, 0x4e, 0x8b, 0xff,
+	0xe4, 0x40, 0xdc, 0x06, 0x0b, 0x30, 0x2c, 0xbd, 0xff, 0xbf, 0x01, 0x00, 0x03, 0x94, 0x7f, 0x61,
+	0xe9, 0x11, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.BeansPerUnit) > 0 {
-		for _, e := range m.BeansPerUnit {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.FeeUnitPrice) > 0 {
-		for _, e := range m.FeeUnitPrice {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
+		return false
+	}
+	for i := range this.BeansPerUnit {
+		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
+			return false
 		}
 	}
-	l = len(m.BootstrapVatConfig)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
+		return false
 	}
-	if len(m.PowerFlagFees) > 0 {
-		for _, e := range m.PowerFlagFees {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	for i := range this.FeeUnitPrice {
+		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
+			return false
 		}
 	}
-	if len(m.QueueMax) > 0 {
-		for _, e := range m.QueueMax {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
+		return false
+	}
+	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
+		return false
+	}
+	for i := range this.PowerFlagFees {
+		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *State) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.QueueMax) != len(that1.QueueMax) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.QueueAllowed) > 0 {
-		for _, e := range m.QueueAllowed {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	for i := range this.QueueMax {
+		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *StringBeans) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.FeeMarket.Equal(&that1.FeeMarket) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if !this.InboundRateLimit.Equal(&that1.InboundRateLimit) {
+		return false
 	}
-	l = m.Beans.Size()
-	n += 1 + l + sovSwingset(uint64(l))
-	return n
+	if this.BundleUploadExpiryBlocks != that1.BundleUploadExpiryBlocks {
+		return false
+	}
+	return true
 }
+func (this *FeeMarket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PowerFlagFee) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*FeeMarket)
+	if !ok {
+		that2, ok := that.(FeeMarket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.PowerFlag)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+	if this.Enabled != that1.Enabled {
+		return false
 	}
-	return n
-}
-
-func (m *QueueSize) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if !this.TargetOccupancy.Equal(that1.TargetOccupancy) {
+		return false
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovSwingset(uint64(m.Size_))
+	if !this.AdjustmentRate.Equal(that1.AdjustmentRate) {
+		return false
 	}
-	return n
+	if !this.MinMultiplier.Equal(that1.MinMultiplier) {
+		return false
+	}
+	if !this.MaxMultiplier.Equal(that1.MaxMultiplier) {
+		return false
+	}
+	return true
 }
+func (this *InboundRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Egress) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*InboundRateLimit)
+	if !ok {
+		that2, ok := that.(InboundRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Nickname)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
 	}
-	if len(m.PowerFlags) > 0 {
-		for _, s := range m.PowerFlags {
-			l = len(s)
-			n += 1 + l + sovSwingset(uint64(l))
-		}
+	if this.MaxMessages != that1.MaxMessages {
+		return false
 	}
-	return n
+	return true
 }
+func (this *HighPrioritySender) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SwingStoreArtifact) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*HighPrioritySender)
+	if !ok {
+		that2, ok := that.(HighPrioritySender)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if this.Address != that1.Address {
+		return false
 	}
-	return n
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.AddedHeight != that1.AddedHeight {
+		return false
+	}
+	if !this.AddedTime.Equal(that1.AddedTime) {
+		return false
+	}
+	return true
 }
+func (this *BundleUpload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovSwingset(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSwingset(x uint64) (n int) {
-	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	that1, ok := that.(*BundleUpload)
+	if !ok {
+		that2, ok := that.(BundleUpload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Submitter != that1.Submitter {
+		return false
+	}
+	if this.PayloadHash != that1.PayloadHash {
+		return false
+	}
+	if this.TotalSize != that1.TotalSize {
+		return false
+	}
+	if this.ChunkCount != that1.ChunkCount {
+		return false
+	}
+	if this.UncompressedSize != that1.UncompressedSize {
+		return false
+	}
+	if this.ReceivedChunks != that1.ReceivedChunks {
+		return false
+	}
+	if this.ReceivedSize != that1.ReceivedSize {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	return true
+}
+func (this *SubmittedBundle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubmittedBundle)
+	if !ok {
+		that2, ok := that.(SubmittedBundle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BundleID != that1.BundleID {
+		return false
+	}
+	if this.Submitter != that1.Submitter {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *CoreEvalRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CoreEvalRecord)
+	if !ok {
+		that2, ok := that.(CoreEvalRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContentHash != that1.ContentHash {
+		return false
+	}
+	if this.PermitHash != that1.PermitHash {
+		return false
+	}
+	if this.CodeHash != that1.CodeHash {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.OutcomeHeight != that1.OutcomeHeight {
+		return false
+	}
+	return true
+}
+func (this *StringBeans) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StringBeans)
+	if !ok {
+		that2, ok := that.(StringBeans)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if !this.Beans.Equal(that1.Beans) {
+		return false
+	}
+	return true
+}
+func (this *PowerFlagFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PowerFlagFee)
+	if !ok {
+		that2, ok := that.(PowerFlagFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PowerFlag != that1.PowerFlag {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *QueueSize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueueSize)
+	if !ok {
+		that2, ok := that.(QueueSize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	return true
+}
+func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreEvalProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreEvalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evals) > 0 {
+		for iNdEx := len(m.Evals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoreEval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreEval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsCode) > 0 {
+		i -= len(m.JsCode)
+		copy(dAtA[i:], m.JsCode)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JsonPermits) > 0 {
+		i -= len(m.JsonPermits)
+		copy(dAtA[i:], m.JsonPermits)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsonPermits)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BundleUploadExpiryBlocks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BundleUploadExpiryBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.InboundRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.QueueMax) > 0 {
+		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PowerFlagFees) > 0 {
+		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BootstrapVatConfig) > 0 {
+		i -= len(m.BootstrapVatConfig)
+		copy(dAtA[i:], m.BootstrapVatConfig)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeeUnitPrice) > 0 {
+		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BeansPerUnit) > 0 {
+		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxMultiplier.Size()
+		i -= size
+		if _, err := m.MaxMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinMultiplier.Size()
+		i -= size
+		if _, err := m.MinMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AdjustmentRate.Size()
+		i -= size
+		if _, err := m.AdjustmentRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetOccupancy.Size()
+		i -= size
+		if _, err := m.TargetOccupancy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMessages != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundQueueOccupancy) > 0 {
+		for iNdEx := len(m.InboundQueueOccupancy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.InboundQueueOccupancy[iNdEx].Size()
+				i -= size
+				if _, err := m.InboundQueueOccupancy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.FeeMultiplier.Size()
+		i -= size
+		if _, err := m.FeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.QueueAllowed) > 0 {
+		for iNdEx := len(m.QueueAllowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueAllowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundSenderHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundSenderHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundSenderHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundBlockCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundBlockCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundBlockCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighPrioritySender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighPrioritySender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighPrioritySender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSwingset(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.AddedHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.AddedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReceivedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ReceivedSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReceivedChunks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ReceivedChunks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UncompressedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmittedBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmittedBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmittedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BundleID) > 0 {
+		i -= len(m.BundleID)
+		copy(dAtA[i:], m.BundleID)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BundleID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoreEvalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreEvalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreEvalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutcomeHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.OutcomeHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PermitHash) > 0 {
+		i -= len(m.PermitHash)
+		copy(dAtA[i:], m.PermitHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PermitHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringBeans) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringBeans) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StringBeans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Beans.Size()
+		i -= size
+		if _, err := m.Beans.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerFlagFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerFlagFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerFlagFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PowerFlag) > 0 {
+		i -= len(m.PowerFlag)
+		copy(dAtA[i:], m.PowerFlag)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Egress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwingset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CoreEvalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Evals) > 0 {
+		for _, e := range m.Evals {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoreEval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPermits)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.JsCode)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BeansPerUnit) > 0 {
+		for _, e := range m.BeansPerUnit {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.FeeUnitPrice) > 0 {
+		for _, e := range m.FeeUnitPrice {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	l = len(m.BootstrapVatConfig)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.PowerFlagFees) > 0 {
+		for _, e := range m.PowerFlagFees {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.QueueMax) > 0 {
+		for _, e := range m.QueueMax {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	l = m.FeeMarket.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	l = m.InboundRateLimit.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if m.BundleUploadExpiryBlocks != 0 {
+		n += 1 + sovSwingset(uint64(m.BundleUploadExpiryBlocks))
+	}
+	return n
+}
+
+func (m *FeeMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovSwingset(uint64(m.WindowBlocks))
+	}
+	l = m.TargetOccupancy.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	l = m.AdjustmentRate.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	l = m.MinMultiplier.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	l = m.MaxMultiplier.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *InboundRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		n += 1 + sovSwingset(uint64(m.WindowBlocks))
+	}
+	if m.MaxMessages != 0 {
+		n += 1 + sovSwingset(uint64(m.MaxMessages))
+	}
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueueAllowed) > 0 {
+		for _, e := range m.QueueAllowed {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	l = m.FeeMultiplier.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if len(m.InboundQueueOccupancy) > 0 {
+		for _, e := range m.InboundQueueOccupancy {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InboundSenderHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InboundBlockCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSwingset(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *HighPrioritySender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.AddedHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.AddedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedTime)
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *BundleUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovSwingset(uint64(m.TotalSize))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovSwingset(uint64(m.ChunkCount))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.UncompressedSize))
+	}
+	if m.ReceivedChunks != 0 {
+		n += 1 + sovSwingset(uint64(m.ReceivedChunks))
+	}
+	if m.ReceivedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.ReceivedSize))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.StartHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *SubmittedBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BundleID)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSwingset(uint64(m.Height))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSwingset(uint64(m.Size_))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *CoreEvalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.PermitHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSwingset(uint64(m.Height))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.OutcomeHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.OutcomeHeight))
+	}
+	return n
+}
+
+func (m *StringBeans) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Beans.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *PowerFlagFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PowerFlag)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSwingset(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *Egress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.PowerFlags) > 0 {
+		for _, s := range m.PowerFlags {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwingStoreArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func sovSwingset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwingset(x uint64) (n int) {
+	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evals = append(m.Evals, CoreEval{})
+			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreEval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
+			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
+			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
+			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueMax = append(m.QueueMax, QueueSize{})
+			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleUploadExpiryBlocks", wireType)
+			}
+			m.BundleUploadExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleUploadExpiryBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetOccupancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetOccupancy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustmentRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
+			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundQueueOccupancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.InboundQueueOccupancy = append(m.InboundQueueOccupancy, v)
+			if err := m.InboundQueueOccupancy[len(m.InboundQueueOccupancy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundSenderHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundSenderHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundSenderHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counts = append(m.Counts, InboundBlockCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundBlockCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundBlockCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundBlockCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighPrioritySender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighPrioritySender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighPrioritySender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedHeight", wireType)
+			}
+			m.AddedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AddedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedChunks", wireType)
+			}
+			m.ReceivedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedChunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+			}
+			m.ReceivedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+func (m *SubmittedBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1399,15 +4662,15 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmittedBundle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmittedBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,11 +4698,11 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.BundleID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1467,13 +4730,13 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1483,81 +4746,16 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Evals = append(m.Evals, CoreEval{})
-			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwingset(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CoreEval) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwingset
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var stringLen uint64
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1567,27 +4765,14 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Size_ |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JsonPermits = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1615,7 +4800,7 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsCode = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1638,7 +4823,7 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CoreEvalRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,17 +4846,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoreEvalRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoreEvalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1681,31 +4866,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
-			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1715,29 +4898,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
-			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PermitHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1765,13 +4946,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1781,31 +4962,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
-			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1815,81 +4981,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueMax = append(m.QueueMax, QueueSize{})
-			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwingset(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *State) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSwingset
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1899,26 +5013,43 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
-			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutcomeHeight", wireType)
+			}
+			m.OutcomeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutcomeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
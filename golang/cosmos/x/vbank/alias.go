@@ -9,14 +9,17 @@ const (
 	ModuleName = types.ModuleName
 	RouterKey  = types.RouterKey
 	StoreKey   = types.StoreKey
+	TStoreKey  = types.TStoreKey
 )
 
 var (
-	NewKeeper     = keeper.NewKeeper
-	ModuleCdc     = types.ModuleCdc
-	RegisterCodec = types.RegisterCodec
+	NewKeeper             = keeper.NewKeeper
+	NewTrackingBankKeeper = keeper.NewTrackingBankKeeper
+	ModuleCdc             = types.ModuleCdc
+	RegisterCodec         = types.RegisterCodec
 )
 
 type (
-	Keeper = keeper.Keeper
+	Keeper             = keeper.Keeper
+	TrackingBankKeeper = keeper.TrackingBankKeeper
 )
@@ -0,0 +1,43 @@
+package vbank
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TrackingBankAppModule is the x/bank AppModule using a TrackingBankKeeper, so
+// that transfers by bank messages are also recorded.  The x/bank AppModule
+// only accepts a BaseKeeper for its migrations, which are therefore registered
+// here with the wrapped keeper.
+type TrackingBankAppModule struct {
+	bank.AppModule
+	keeper TrackingBankKeeper
+}
+
+// NewTrackingBankAppModule returns the x/bank AppModule for the given keeper.
+func NewTrackingBankAppModule(cdc codec.Codec, keeper TrackingBankKeeper, accountKeeper banktypes.AccountKeeper) TrackingBankAppModule {
+	return TrackingBankAppModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers the x/bank services, as the x/bank AppModule
+// does.
+func (am TrackingBankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+}
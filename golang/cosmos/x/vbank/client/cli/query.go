@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
@@ -21,6 +23,10 @@ func GetQueryCmd() *cobra.Command {
 	vbankQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryState(),
+		GetCmdQueryWatchedAddresses(),
+		GetCmdQueryRewardSchedule(),
+		GetCmdQueryRewardPoolReconciliation(),
+		GetCmdQueryTransferPolicy(),
 	)
 
 	return vbankQueryCmd
@@ -77,3 +83,133 @@ func GetCmdQueryState() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryWatchedAddresses implements the query watched-addresses command.
+func GetCmdQueryWatchedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watched-addresses",
+		Args:  cobra.NoArgs,
+		Short: "Query the addresses whose balance updates are sent to the controller",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WatchedAddresses(cmd.Context(), &types.QueryWatchedAddressesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "watched-addresses")
+	return cmd
+}
+
+const flagBlocks = "blocks"
+
+// GetCmdQueryRewardSchedule implements the query reward-schedule command.
+func GetCmdQueryRewardSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-schedule",
+		Args:  cobra.NoArgs,
+		Short: "Query the projected distribution of the reward pool",
+		Long: `Query the current reward epoch and the projected per-block distribution of
+the reward pool to the fee collector, assuming that no more rewards are added
+and the params do not change.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blocks, err := cmd.Flags().GetInt64(flagBlocks)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardSchedule(cmd.Context(), &types.QueryRewardScheduleRequest{
+				Blocks: blocks,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int64(flagBlocks, 0, fmt.Sprintf("number of blocks to project (default %d)", types.DefaultRewardScheduleBlocks))
+	return cmd
+}
+
+// GetCmdQueryRewardPoolReconciliation implements the query
+// reward-pool-reconciliation command.
+func GetCmdQueryRewardPoolReconciliation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool-reconciliation",
+		Args:  cobra.NoArgs,
+		Short: "Compare the recorded reward pool with the vbank module balance",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPoolReconciliation(cmd.Context(), &types.QueryRewardPoolReconciliationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTransferPolicy implements the query transfer-policy command.
+func GetCmdQueryTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-policy [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the effective policy for VBANK_GRAB and VBANK_GIVE",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTransferPolicyRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+			res, err := queryClient.TransferPolicy(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -21,6 +21,16 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	seen := make(map[string]bool, len(data.WatchedAddresses))
+	for _, address := range data.WatchedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid watched address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate watched address %s", address)
+		}
+		seen[address] = true
+	}
 	return nil
 }
 
@@ -34,6 +44,9 @@ func DefaultGenesisState() *types.GenesisState {
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
 	keeper.SetParams(ctx, data.GetParams())
 	keeper.SetState(ctx, data.GetState())
+	for _, address := range data.GetWatchedAddresses() {
+		keeper.WatchAddress(ctx, sdk.MustAccAddressFromBech32(address))
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -41,5 +54,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	var gs types.GenesisState
 	gs.Params = k.GetParams(ctx)
 	gs.State = k.GetState(ctx)
+	gs.WatchedAddresses = k.GetAllWatchedAddresses(ctx)
 	return &gs
 }
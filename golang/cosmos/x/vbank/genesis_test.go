@@ -1,7 +1,10 @@
 package vbank
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 )
 
 func TestDefaultGenesis(t *testing.T) {
@@ -10,3 +13,26 @@ func TestDefaultGenesis(t *testing.T) {
 		t.Errorf("DefaultGenesisState did not validate %v: %e", defaultGenesisState, err)
 	}
 }
+
+func TestWatchedAddressesGenesis(t *testing.T) {
+	keeper, ctx := makeTestKit(nil, nil)
+	gs := DefaultGenesisState()
+	gs.WatchedAddresses = []string{addr1, addr2}
+	if err := ValidateGenesis(gs); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	InitGenesis(ctx, keeper, gs)
+
+	got := ExportGenesis(ctx, keeper).WatchedAddresses
+	want := keeper.GetAllWatchedAddresses(ctx)
+	if len(got) != 2 || !reflect.DeepEqual(got, want) {
+		t.Errorf("got exported watched addresses %v, want %v", got, want)
+	}
+
+	for _, addresses := range [][]string{{addr1, addr1}, {"nonsense"}} {
+		invalid := &types.GenesisState{Params: types.DefaultParams(), WatchedAddresses: addresses}
+		if err := ValidateGenesis(invalid); err == nil {
+			t.Errorf("got no error validating watched addresses %v", addresses)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package vbank
+
+import (
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_RewardPoolInvariant(t *testing.T) {
+	k, bank, ctx := makeTrackingTestKit()
+	ch := NewPortHandler(AppModule{}, k)
+	invariant := keeper.RewardPoolInvariant(k)
+
+	_, err := ch.Receive(sdk.WrapSDKContext(ctx), `{
+		"type": "VBANK_GIVE_TO_REWARD_DISTRIBUTOR",
+		"denom": "ubld",
+		"amount": "100"
+	}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if msg, broken := invariant(ctx); broken {
+		t.Errorf("got broken invariant: %s", msg)
+	}
+	res, err := k.RewardPoolReconciliation(sdk.WrapSDKContext(ctx), &types.QueryRewardPoolReconciliationRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.Reconciled || res.RewardPool.String() != "100ubld" || res.ModuleBalance.String() != "100ubld" {
+		t.Errorf("got reconciliation %v, want 100ubld reconciled", res)
+	}
+
+	// Coins in the module account beyond the pool are a surplus, which does not
+	// break the invariant.
+	if err := bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("urun", 5))); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if msg, broken := invariant(ctx); broken {
+		t.Errorf("got broken invariant: %s", msg)
+	}
+	res, _ = k.RewardPoolReconciliation(sdk.WrapSDKContext(ctx), &types.QueryRewardPoolReconciliationRequest{})
+	if res.Reconciled || res.Surplus.String() != "5urun" || !res.Shortfall.IsZero() {
+		t.Errorf("got reconciliation %v, want a 5urun surplus", res)
+	}
+
+	// A pool that the module account cannot cover is a shortfall.
+	state := k.GetState(ctx)
+	state.RewardPool = state.RewardPool.Add(sdk.NewInt64Coin("ubld", 20))
+	k.SetState(ctx, state)
+	if _, broken := invariant(ctx); !broken {
+		t.Errorf("got unbroken invariant with a shortfall")
+	}
+	res, _ = k.RewardPoolReconciliation(sdk.WrapSDKContext(ctx), &types.QueryRewardPoolReconciliationRequest{})
+	if res.Reconciled || res.Surplus.String() != "5urun" || res.Shortfall.String() != "20ubld" {
+		t.Errorf("got reconciliation %v, want a 5urun surplus and a 20ubld shortfall", res)
+	}
+}
+
+func Test_LastSequenceMonotonic(t *testing.T) {
+	k, _, ctx := makeTrackingTestKit()
+
+	k.GetNextSequence(ctx)
+	k.GetNextSequence(ctx)
+	state := k.GetState(ctx)
+	k.SetState(ctx, state)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("got no panic after the sequence decreased")
+		}
+		if got := k.GetState(ctx).LastSequence; got != 2 {
+			t.Errorf("got last sequence %d, want 2", got)
+		}
+	}()
+	state.LastSequence = 1
+	k.SetState(ctx, state)
+}
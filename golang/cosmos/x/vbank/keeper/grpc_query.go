@@ -5,6 +5,8 @@ import (
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -12,8 +14,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -25,3 +26,75 @@ func (k Keeper) State(c context.Context, req *types.QueryStateRequest) (*types.Q
 
 	return &types.QueryStateResponse{State: state}, nil
 }
+
+// WatchedAddresses queries the addresses whose balance updates are sent to the
+// controller, in addition to those of module accounts.
+func (k Keeper) WatchedAddresses(c context.Context, req *types.QueryWatchedAddressesRequest) (*types.QueryWatchedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addresses, pageRes, err := k.PaginateWatchedAddresses(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryWatchedAddressesResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RewardSchedule projects the distribution of the reward pool to the fee
+// collector.
+func (k Keeper) RewardSchedule(c context.Context, req *types.QueryRewardScheduleRequest) (*types.QueryRewardScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = types.DefaultRewardScheduleBlocks
+	}
+	if blocks < 0 || blocks > types.MaxRewardScheduleBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "blocks must be between 1 and %d", types.MaxRewardScheduleBlocks)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule := k.ProjectRewardSchedule(ctx, blocks)
+	return &schedule, nil
+}
+
+// RewardPoolReconciliation reports any drift between the recorded reward pool
+// and the vbank module account balance.
+func (k Keeper) RewardPoolReconciliation(c context.Context, req *types.QueryRewardPoolReconciliationRequest) (*types.QueryRewardPoolReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reconciliation := k.ReconcileRewardPool(ctx)
+	return &reconciliation, nil
+}
+
+// TransferPolicy queries the effective policy for VBANK_GRAB and VBANK_GIVE,
+// either of every listed denom or of the requested one.
+func (k Keeper) TransferPolicy(c context.Context, req *types.QueryTransferPolicyRequest) (*types.QueryTransferPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	res := &types.QueryTransferPolicyResponse{
+		Restricted: len(params.TransferPolicies) > 0,
+		Policies:   params.TransferPolicies,
+	}
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		res.Policies = []types.DenomTransferPolicy{params.GetTransferPolicy(req.Denom)}
+	}
+	return res, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// RegisterInvariants registers all vbank invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reward-pool", RewardPoolInvariant(k))
+}
+
+// AllInvariants runs all vbank invariants.
+func AllInvariants(k Keeper) sdk.Invariant {
+	invariants := []sdk.Invariant{
+		RewardPoolInvariant(k),
+	}
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range invariants {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// RewardPoolInvariant checks that the vbank module account balance covers the
+// recorded reward pool.
+func RewardPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		reconciliation := k.ReconcileRewardPool(ctx)
+		broken := !reconciliation.Shortfall.IsZero()
+		return sdk.FormatInvariant(types.ModuleName, "reward-pool", fmt.Sprintf(
+			"\treward pool: %s\n\tmodule balance: %s\n\tshortfall: %s\n",
+			reconciliation.RewardPool, reconciliation.ModuleBalance, reconciliation.Shortfall,
+		)), broken
+	}
+}
+
+// ReconcileRewardPool compares the recorded reward pool with the balance of
+// the vbank module account, which holds the pool.
+func (k Keeper) ReconcileRewardPool(ctx sdk.Context) types.QueryRewardPoolReconciliationResponse {
+	pool := k.GetState(ctx).RewardPool
+	balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+	surplus := sdk.NewCoins()
+	shortfall := sdk.NewCoins()
+	for _, coin := range balance.Add(pool...) {
+		diff := balance.AmountOf(coin.Denom).Sub(pool.AmountOf(coin.Denom))
+		switch {
+		case diff.IsPositive():
+			surplus = surplus.Add(sdk.NewCoin(coin.Denom, diff))
+		case diff.IsNegative():
+			shortfall = shortfall.Add(sdk.NewCoin(coin.Denom, diff.Neg()))
+		}
+	}
+
+	return types.QueryRewardPoolReconciliationResponse{
+		RewardPool:    pool,
+		ModuleBalance: balance,
+		Surplus:       surplus,
+		Shortfall:     shortfall,
+		Reconciled:    surplus.IsZero() && shortfall.IsZero(),
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,13 +14,23 @@ import (
 	vm "github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 )
 
-const stateKey string = "state"
+const (
+	stateKey  string = "state"
+	paramsKey string = "params"
+)
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	cdc        codec.Codec
+	storeKey storetypes.StoreKey
+	// tStoreKey is the transient store in which a TrackingBankKeeper records
+	// the balances changed in the current block.
+	tStoreKey storetypes.StoreKey
+	cdc       codec.Codec
+	// paramSpace is the legacy x/params subspace, only consulted to migrate the
+	// params into the module store.
 	paramSpace paramtypes.Subspace
+	// authority is the address allowed to update the params, usually x/gov.
+	authority string
 
 	accountKeeper         types.AccountKeeper
 	bankKeeper            types.BankKeeper
@@ -28,10 +40,11 @@ type Keeper struct {
 
 // NewKeeper creates a new vbank Keeper instance
 func NewKeeper(
-	cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.Codec, key, tkey storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
 	rewardDistributorName string,
 	pushAction vm.ActionPusher,
+	authority string,
 ) Keeper {
 
 	// set KeyTable if it has not already been set
@@ -41,8 +54,10 @@ func NewKeeper(
 
 	return Keeper{
 		storeKey:              key,
+		tStoreKey:             tkey,
 		cdc:                   cdc,
 		paramSpace:            paramSpace,
+		authority:             authority,
 		accountKeeper:         accountKeeper,
 		bankKeeper:            bankKeeper,
 		rewardDistributorName: rewardDistributorName,
@@ -97,13 +112,22 @@ func (k Keeper) IsModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
 	return ok
 }
 
+// GetAuthority returns the address allowed to update the params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(paramsKey))
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(paramsKey), bz)
 }
 
 func (k Keeper) GetState(ctx sdk.Context) types.State {
@@ -114,8 +138,17 @@ func (k Keeper) GetState(ctx sdk.Context) types.State {
 	return state
 }
 
+// SetState stores the module state.  It panics if the sequence of balance
+// updates would decrease, since the VM relies on it to order the updates.
 func (k Keeper) SetState(ctx sdk.Context, state types.State) {
 	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get([]byte(stateKey)); bz != nil {
+		prior := types.State{}
+		k.cdc.MustUnmarshal(bz, &prior)
+		if state.LastSequence < prior.LastSequence {
+			panic(fmt.Sprintf("vbank last sequence cannot decrease from %d to %d", prior.LastSequence, state.LastSequence))
+		}
+	}
 	bz := k.cdc.MustMarshal(&state)
 	store.Set([]byte(stateKey), bz)
 }
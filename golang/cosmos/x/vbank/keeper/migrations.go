@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// Migrator handles in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new migrator based on the keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.MigrateParamsToStore(ctx)
+}
+
+// MigrateParamsToStore moves the params out of the legacy x/params subspace
+// and into the module store.
+func (m Migrator) MigrateParamsToStore(ctx sdk.Context) error {
+	var params types.Params
+	m.keeper.paramSpace.GetParamSet(ctx, &params)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 )
 
 // minCoins returns the minimum of each denomination.
@@ -43,39 +45,116 @@ func mulCoins(a sdk.Coins, b sdk.Dec) sdk.Coins {
 	return sdk.NewCoins(coins...)
 }
 
-// DistributeRewards drives the rewards state machine.
-func (k Keeper) DistributeRewards(ctx sdk.Context) error {
-	// Distribute rewards.
-	state := k.GetState(ctx)
-	params := k.GetParams(ctx)
+// rewardStep is the transition of the rewards state machine in one block.
+type rewardStep struct {
+	state types.State
+	// newEpoch is whether the block starts a new epoch.
+	newEpoch bool
+	// distributing is whether the block distributes rewards.
+	distributing bool
+	// xfer is the amount to send to the reward distributor, if distributing.
+	xfer sdk.Coins
+}
 
+// nextRewardStep returns the transition of the rewards state machine in the
+// block at the given height.  The reward pool of the returned state does not
+// yet reflect the transfer.
+func nextRewardStep(params types.Params, state types.State, height int64) rewardStep {
 	smoothingBlocks := params.GetSmoothingBlocks()
-	thisBlock := ctx.BlockHeight()
-	cycleIndex := thisBlock - state.LastRewardDistributionBlock
+	cycleIndex := height - state.LastRewardDistributionBlock
+	step := rewardStep{}
 
 	// Check if we're at the end of the last cycle.
 	if cycleIndex >= params.RewardEpochDurationBlocks {
 		// Get more rewards to distribute.
 		toDistribute := mulCoins(state.RewardPool, params.PerEpochRewardFraction)
-		state.LastRewardDistributionBlock = thisBlock
+		state.LastRewardDistributionBlock = height
 		state.RewardBlockAmount = params.RewardRate(toDistribute, smoothingBlocks)
-		k.SetState(ctx, state)
+		step.newEpoch = true
 	}
+	step.state = state
 
 	if cycleIndex >= smoothingBlocks {
 		// No more distribution to do until the next cycle.
+		return step
+	}
+
+	// We're currently within the smoothing period.
+	step.distributing = true
+	step.xfer = minCoins(state.RewardBlockAmount, state.RewardPool)
+	return step
+}
+
+// DistributeRewards drives the rewards state machine.
+func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+	// Distribute rewards.
+	step := nextRewardStep(k.GetParams(ctx), k.GetState(ctx), ctx.BlockHeight())
+	state := step.state
+	if step.newEpoch {
+		k.SetState(ctx, state)
+	}
+	if !step.distributing {
 		return nil
 	}
 
-	// We're currently within the smoothing period, send the amount to distribute.
-	xfer := minCoins(state.RewardBlockAmount, state.RewardPool)
-	if !xfer.IsZero() {
-		if err := k.SendCoinsToRewardDistributor(ctx, xfer); err != nil {
+	// Send the amount to distribute.
+	if !step.xfer.IsZero() {
+		if err := k.SendCoinsToRewardDistributor(ctx, step.xfer); err != nil {
 			return err
 		}
 	}
 
-	state.RewardPool = state.RewardPool.Sub(xfer...)
+	state.RewardPool = state.RewardPool.Sub(step.xfer...)
 	k.SetState(ctx, state)
 	return nil
 }
+
+// ProjectRewardSchedule projects the distribution of the reward pool over the given
+// number of blocks after the current one, assuming that no more rewards are
+// added and the params do not change.
+func (k Keeper) ProjectRewardSchedule(ctx sdk.Context, blocks int64) types.QueryRewardScheduleResponse {
+	params := k.GetParams(ctx)
+	state := k.GetState(ctx)
+	height := ctx.BlockHeight()
+
+	// The next epoch starts in the first block that is at least an epoch after
+	// the start of the last one.
+	nextEpochHeight := state.LastRewardDistributionBlock + params.RewardEpochDurationBlocks
+	if nextEpochHeight <= height {
+		nextEpochHeight = height + 1
+	}
+	schedule := types.QueryRewardScheduleResponse{
+		Height:               height,
+		EpochStartHeight:     state.LastRewardDistributionBlock,
+		NextEpochHeight:      nextEpochHeight,
+		BlocksUntilNextEpoch: nextEpochHeight - height,
+		RewardBlockAmount:    state.RewardBlockAmount,
+		RewardPool:           state.RewardPool,
+		Projection:           []types.RewardPayout{},
+	}
+
+	// Run the state machine, merging consecutive blocks with the same payout.
+	for h := height + 1; h <= height+blocks && !state.RewardPool.IsZero(); h++ {
+		step := nextRewardStep(params, state, h)
+		state = step.state
+		if !step.distributing || step.xfer.IsZero() {
+			continue
+		}
+		state.RewardPool = state.RewardPool.Sub(step.xfer...)
+		if state.RewardPool.IsZero() {
+			schedule.PoolExhaustedHeight = h
+		}
+
+		last := len(schedule.Projection) - 1
+		if last >= 0 && schedule.Projection[last].EndHeight == h-1 && schedule.Projection[last].Amount.IsEqual(step.xfer) {
+			schedule.Projection[last].EndHeight = h
+			continue
+		}
+		schedule.Projection = append(schedule.Projection, types.RewardPayout{
+			StartHeight: h,
+			EndHeight:   h,
+			Amount:      step.xfer,
+		})
+	}
+	return schedule
+}
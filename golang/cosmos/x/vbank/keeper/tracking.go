@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// The (address, denom) pairs whose balances have changed in the current block
+// are kept in the transient store, which is discarded at commit along with the
+// pairs of any failed transaction.
+const touchedBalanceKeyPrefix = "touched."
+
+var touchedValue = []byte{1}
+
+func touchedBalanceStore(ctx sdk.Context, tkey storetypes.StoreKey) prefix.Store {
+	return prefix.NewStore(ctx.TransientStore(tkey), []byte(touchedBalanceKeyPrefix))
+}
+
+func touchedBalanceKey(addr sdk.AccAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(addr), denom...)
+}
+
+func recordTouchedBalances(ctx sdk.Context, tkey storetypes.StoreKey, addr sdk.AccAddress, amt sdk.Coins) {
+	store := touchedBalanceStore(ctx, tkey)
+	for _, coin := range amt {
+		store.Set(touchedBalanceKey(addr, coin.Denom), touchedValue)
+	}
+}
+
+// RecordTouchedBalances records that the balances of addr in the denoms of amt
+// have changed in the current block.
+func (k Keeper) RecordTouchedBalances(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	recordTouchedBalances(ctx, k.tStoreKey, addr, amt)
+}
+
+// GetTouchedBalances returns the denoms of each address whose balance has
+// changed in the current block, as recorded by a TrackingBankKeeper.  Coins are
+// used only to track the set of denoms, not for the amounts.
+func (k Keeper) GetTouchedBalances(ctx sdk.Context) map[string]sdk.Coins {
+	addressToUpdate := map[string]sdk.Coins{}
+	iterator := touchedBalanceStore(ctx, k.tStoreKey).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		addrLen := int(key[0])
+		addr := sdk.AccAddress(key[1 : 1+addrLen]).String()
+		denom := string(key[1+addrLen:])
+		addressToUpdate[addr] = addressToUpdate[addr].Add(sdk.NewInt64Coin(denom, 1))
+	}
+	return addressToUpdate
+}
+
+// TrackingBankKeeper is a bank keeper that records the (address, denom) pairs
+// of every balance it changes, so that vbank can send balance updates to the
+// controller at the end of the block.  It must be used in place of the
+// BaseKeeper by every module that moves coins.
+//
+// A pair is recorded even if the operation fails, since some balances may have
+// changed before the failure.
+type TrackingBankKeeper struct {
+	bankkeeper.BaseKeeper
+	tStoreKey storetypes.StoreKey
+}
+
+var _ bankkeeper.Keeper = TrackingBankKeeper{}
+
+// NewTrackingBankKeeper wraps a BaseKeeper to record the balances it changes
+// in the vbank transient store.
+func NewTrackingBankKeeper(base bankkeeper.BaseKeeper, tStoreKey storetypes.StoreKey) TrackingBankKeeper {
+	return TrackingBankKeeper{BaseKeeper: base, tStoreKey: tStoreKey}
+}
+
+func (k TrackingBankKeeper) record(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	recordTouchedBalances(ctx, k.tStoreKey, addr, amt)
+}
+
+func (k TrackingBankKeeper) recordModule(ctx sdk.Context, moduleName string, amt sdk.Coins) {
+	k.record(ctx, authtypes.NewModuleAddress(moduleName), amt)
+}
+
+func (k TrackingBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	k.record(ctx, fromAddr, amt)
+	k.record(ctx, toAddr, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	err := k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+	for _, in := range inputs {
+		if addr, err := sdk.AccAddressFromBech32(in.Address); err == nil {
+			k.record(ctx, addr, in.Coins)
+		}
+	}
+	for _, out := range outputs {
+		if addr, err := sdk.AccAddressFromBech32(out.Address); err == nil {
+			k.record(ctx, addr, out.Coins)
+		}
+	}
+	return err
+}
+
+func (k TrackingBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	k.recordModule(ctx, senderModule, amt)
+	k.record(ctx, recipientAddr, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	err := k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	k.recordModule(ctx, senderModule, amt)
+	k.recordModule(ctx, recipientModule, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	err := k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	k.record(ctx, senderAddr, amt)
+	k.recordModule(ctx, recipientModule, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	err := k.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	k.record(ctx, senderAddr, amt)
+	k.recordModule(ctx, recipientModule, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.BaseKeeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	k.recordModule(ctx, senderModule, amt)
+	k.record(ctx, recipientAddr, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.BaseKeeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+	k.record(ctx, delegatorAddr, amt)
+	k.record(ctx, moduleAccAddr, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+	k.record(ctx, moduleAccAddr, amt)
+	k.record(ctx, delegatorAddr, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	err := k.BaseKeeper.MintCoins(ctx, moduleName, amt)
+	k.recordModule(ctx, moduleName, amt)
+	return err
+}
+
+func (k TrackingBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	err := k.BaseKeeper.BurnCoins(ctx, moduleName, amt)
+	k.recordModule(ctx, moduleName, amt)
+	return err
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// The amounts grabbed from and given to each address in the current block are
+// kept in the transient store, to enforce the per-block caps of the transfer
+// policies.
+const transferredKeyPrefix = "transferred."
+
+func (k Keeper) transferredStore(ctx sdk.Context, operation string) prefix.Store {
+	return prefix.NewStore(ctx.TransientStore(k.tStoreKey), []byte(transferredKeyPrefix+operation+"."))
+}
+
+func transferredKey(addr sdk.AccAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(addr), denom...)
+}
+
+// GetTransferred returns the amount of the denom that the operation has
+// transferred for addr in the current block.
+func (k Keeper) GetTransferred(ctx sdk.Context, operation string, addr sdk.AccAddress, denom string) sdk.Int {
+	bz := k.transferredStore(ctx, operation).Get(transferredKey(addr, denom))
+	amount := sdk.ZeroInt()
+	if bz != nil {
+		if err := amount.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	return amount
+}
+
+// CheckTransfer returns a *types.TransferDeniedError if the transfer policies
+// do not permit the operation to transfer amt for addr in the current block.
+func (k Keeper) CheckTransfer(ctx sdk.Context, operation string, addr sdk.AccAddress, amt sdk.Coin) error {
+	policy := k.GetParams(ctx).GetTransferPolicy(amt.Denom)
+	denied := func(reason string) error {
+		return &types.TransferDeniedError{
+			Operation: operation,
+			Address:   addr.String(),
+			Amount:    amt,
+			Reason:    reason,
+		}
+	}
+	if !policy.Allows(operation) {
+		return denied(fmt.Sprintf("denom %s may not be %s", amt.Denom, transferredVerb(operation)))
+	}
+	limit := policy.GetCapPerBlock(operation)
+	if limit.IsZero() {
+		return nil
+	}
+	total := k.GetTransferred(ctx, operation, addr, amt.Denom).Add(amt.Amount)
+	if total.GT(limit) {
+		return denied(fmt.Sprintf("exceeds the per-block cap of %s%s", limit, amt.Denom))
+	}
+	return nil
+}
+
+// RecordTransfer adds amt to the amount that the operation has transferred for
+// addr in the current block, if the transfer policy of the denom has a cap.
+func (k Keeper) RecordTransfer(ctx sdk.Context, operation string, addr sdk.AccAddress, amt sdk.Coin) {
+	policy := k.GetParams(ctx).GetTransferPolicy(amt.Denom)
+	if policy.GetCapPerBlock(operation).IsZero() {
+		return
+	}
+	total := k.GetTransferred(ctx, operation, addr, amt.Denom).Add(amt.Amount)
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	k.transferredStore(ctx, operation).Set(transferredKey(addr, amt.Denom), bz)
+}
+
+func transferredVerb(operation string) string {
+	if operation == types.TransferGrab {
+		return "grabbed"
+	}
+	return "given"
+}
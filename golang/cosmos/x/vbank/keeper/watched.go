@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Balance updates for watched addresses are sent to the controller along with
+// those of module accounts, so that it can follow accounts it does not own
+// without polling.
+const watchedAddressKeyPrefix = "watched."
+
+var watchedValue = []byte{1}
+
+func (k Keeper) watchedAddressStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(watchedAddressKeyPrefix))
+}
+
+// WatchAddress adds an address to the watched set.
+func (k Keeper) WatchAddress(ctx sdk.Context, addr sdk.AccAddress) {
+	k.watchedAddressStore(ctx).Set(addr, watchedValue)
+}
+
+// UnwatchAddress removes an address from the watched set.
+func (k Keeper) UnwatchAddress(ctx sdk.Context, addr sdk.AccAddress) {
+	k.watchedAddressStore(ctx).Delete(addr)
+}
+
+// IsWatchedAddress returns whether an address is in the watched set.
+func (k Keeper) IsWatchedAddress(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.watchedAddressStore(ctx).Has(addr)
+}
+
+// GetAllWatchedAddresses returns every watched address, ordered by address
+// bytes.
+func (k Keeper) GetAllWatchedAddresses(ctx sdk.Context) []string {
+	addresses := []string{}
+	iterator := k.watchedAddressStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Key()).String())
+	}
+	return addresses
+}
+
+// PaginateWatchedAddresses returns a page of watched addresses.
+func (k Keeper) PaginateWatchedAddresses(ctx sdk.Context, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	addresses := []string{}
+	pageRes, err := query.Paginate(k.watchedAddressStore(ctx), pageReq, func(key []byte, value []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	return addresses, pageRes, err
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -91,7 +90,7 @@ func (AppModule) Name() string {
 	return ModuleName
 }
 
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
@@ -99,66 +98,17 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock implements the AppModule interface
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-	events := ctx.EventManager().GetABCIEventHistory()
-	addressToUpdate := make(map[string]sdk.Coins, len(events)*2)
-
-	// records that we want to emit an balance update for the address
-	// for the given denoms. We use the Coins only to track the set of
-	// denoms, not for the amounts.
-	ensureAddressUpdate := func(address string, denoms sdk.Coins) {
-		if denoms.IsZero() {
-			return
-		}
-		currentDenoms := sdk.NewCoins()
-		if coins, ok := addressToUpdate[address]; ok {
-			currentDenoms = coins
-		}
-		addressToUpdate[address] = currentDenoms.Add(denoms...)
-	}
+	addressToUpdate := am.keeper.GetTouchedBalances(ctx)
 
-	/* Scan for all the events matching (taken from cosmos-sdk/x/bank/spec/04_events.md):
-
-	type: "coin_received"
-	  "receiver": {recipient address}
-	  "amount": {Coins string}
-	type: "coin_spent"
-	  "spender": {spender address}
-	  "amount": {Coins string}
-	*/
-NextEvent:
-	for _, event := range events {
-		switch event.Type {
-		case banktypes.EventTypeCoinReceived, banktypes.EventTypeCoinSpent:
-			var addr string
-			denoms := sdk.NewCoins()
-			for _, attr := range event.GetAttributes() {
-				switch string(attr.GetKey()) {
-				case banktypes.AttributeKeyReceiver, banktypes.AttributeKeySpender:
-					addr = string(attr.GetValue())
-				case sdk.AttributeKeyAmount:
-					coins, err := sdk.ParseCoinsNormalized(string(attr.GetValue()))
-					if err != nil {
-						stdlog.Println("Cannot ensure vbank balance for", addr, err)
-						break NextEvent
-					}
-					denoms = coins
-				}
-			}
-			if addr != "" && !denoms.IsZero() {
-				ensureAddressUpdate(addr, denoms)
-			}
-		}
-	}
-
-	// Prune the addressToUpdate map to only include module accounts.  We prune
-	// only after recording and consolidating all account updates to minimize the
-	// number of account keeper queries.
+	// Prune the addressToUpdate map to only include module accounts and watched
+	// addresses.  We prune only after recording and consolidating all account
+	// updates to minimize the number of store queries.
 	unfilteredAddresses := addressToUpdate
 	addressToUpdate = make(map[string]sdk.Coins, len(addressToUpdate))
 	for addr, denoms := range unfilteredAddresses {
 		accAddr, err := sdk.AccAddressFromBech32(addr)
-		if err == nil && am.keeper.IsModuleAccount(ctx, accAddr) {
-			// Pass through the module account.
+		if err == nil && (am.keeper.IsWatchedAddress(ctx, accAddr) || am.keeper.IsModuleAccount(ctx, accAddr)) {
+			// Pass through the module account or watched address.
 			addressToUpdate[addr] = denoms
 		}
 	}
@@ -180,8 +130,8 @@ NextEvent:
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // Route implements the AppModule interface
@@ -201,9 +151,13 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	tx := &types.UnimplementedMsgServer{}
-	types.RegisterMsgServer(cfg.MsgServer(), tx)
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	m := keeper.NewMigrator(am.keeper)
+	err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-transfer module. It returns
@@ -0,0 +1,212 @@
+package vbank
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// makeTrackingTestKit creates a vbank Keeper over real auth and bank keepers,
+// with the bank keeper wrapped by a TrackingBankKeeper.
+func makeTrackingTestKit() (Keeper, keeper.TrackingBankKeeper, sdk.Context) {
+	encodingConfig := params.MakeEncodingConfig()
+	authtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cdc := encodingConfig.Marshaler
+
+	authStoreKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	pk := paramskeeper.NewKeeper(cdc, encodingConfig.Amino, paramsStoreKey, paramsTStoreKey)
+
+	maccPerms := map[string][]string{
+		types.ModuleName:            {authtypes.Minter, authtypes.Burner},
+		stakingtypes.BondedPoolName: {authtypes.Burner, authtypes.Staking},
+	}
+	ak := authkeeper.NewAccountKeeper(cdc, authStoreKey, pk.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms, "agoric")
+	bank := NewTrackingBankKeeper(
+		bankkeeper.NewBaseKeeper(cdc, bankStoreKey, ak, pk.Subspace(banktypes.ModuleName), nil),
+		vbankTStoreKey,
+	)
+	pushAction := func(ctx sdk.Context, action vm.Action) error {
+		return nil
+	}
+	keeper := NewKeeper(cdc, vbankStoreKey, vbankTStoreKey, pk.Subspace(types.ModuleName), ak, bank, "feeCollectorName", pushAction, govAuthority)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range []storetypes.StoreKey{vbankStoreKey, authStoreKey, bankStoreKey, paramsStoreKey} {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	for _, key := range []storetypes.StoreKey{vbankTStoreKey, paramsTStoreKey} {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeTransient, db)
+	}
+	if err := ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	ak.SetParams(ctx, authtypes.DefaultParams())
+	bank.SetParams(ctx, banktypes.DefaultParams())
+	// Turn off rewards.
+	keeper.SetParams(ctx, types.Params{PerEpochRewardFraction: sdk.ZeroDec()})
+	keeper.SetState(ctx, types.State{})
+	return keeper, bank, ctx
+}
+
+// scanBalanceEvents finds the balances changed by the coin_received and
+// coin_spent events, as vbank's EndBlock used to.  Only the denoms are kept.
+func scanBalanceEvents(t *testing.T, events []abci.Event) map[string]sdk.Coins {
+	addressToUpdate := map[string]sdk.Coins{}
+	for _, event := range events {
+		if event.Type != banktypes.EventTypeCoinReceived && event.Type != banktypes.EventTypeCoinSpent {
+			continue
+		}
+		var addr string
+		var denoms sdk.Coins
+		for _, attr := range event.GetAttributes() {
+			switch string(attr.GetKey()) {
+			case banktypes.AttributeKeyReceiver, banktypes.AttributeKeySpender:
+				addr = string(attr.GetValue())
+			case sdk.AttributeKeyAmount:
+				coins, err := sdk.ParseCoinsNormalized(string(attr.GetValue()))
+				if err != nil {
+					t.Fatalf("cannot parse event amount: %v", err)
+				}
+				for _, coin := range coins {
+					denoms = denoms.Add(sdk.NewInt64Coin(coin.Denom, 1))
+				}
+			}
+		}
+		for _, coin := range denoms {
+			if addressToUpdate[addr].AmountOf(coin.Denom).IsZero() {
+				addressToUpdate[addr] = addressToUpdate[addr].Add(coin)
+			}
+		}
+	}
+	return addressToUpdate
+}
+
+func Test_TrackingBankKeeper_Parity(t *testing.T) {
+	keeper, bank, ctx := makeTrackingTestKit()
+	em := sdk.NewEventManager()
+	ctx = ctx.WithEventManager(em)
+
+	acc1 := sdk.MustAccAddressFromBech32(addr1)
+	acc2 := sdk.MustAccAddressFromBech32(addr2)
+	ubld := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("ubld", amount))
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"MintCoins", func() error {
+			return bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000), sdk.NewInt64Coin("urun", 500)))
+		}},
+		{"SendCoinsFromModuleToAccount", func() error {
+			return bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, acc1, ubld(300))
+		}},
+		{"SendCoins", func() error {
+			return bank.SendCoins(ctx, acc1, acc2, ubld(100))
+		}},
+		{"InputOutputCoins", func() error {
+			return bank.InputOutputCoins(ctx,
+				[]banktypes.Input{banktypes.NewInput(acc1, ubld(50))},
+				[]banktypes.Output{
+					banktypes.NewOutput(sdk.MustAccAddressFromBech32(addr3), ubld(20)),
+					banktypes.NewOutput(sdk.MustAccAddressFromBech32(addr4), ubld(30)),
+				})
+		}},
+		{"SendCoinsFromAccountToModule", func() error {
+			return bank.SendCoinsFromAccountToModule(ctx, acc2, types.ModuleName, ubld(10))
+		}},
+		{"SendCoinsFromModuleToModule", func() error {
+			return bank.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewInt64Coin("urun", 100)))
+		}},
+		{"DelegateCoinsFromAccountToModule", func() error {
+			return bank.DelegateCoinsFromAccountToModule(ctx, acc1, stakingtypes.BondedPoolName, ubld(40))
+		}},
+		{"UndelegateCoinsFromModuleToAccount", func() error {
+			return bank.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.BondedPoolName, acc1, ubld(15))
+		}},
+		{"BurnCoins", func() error {
+			return bank.BurnCoins(ctx, types.ModuleName, ubld(10))
+		}},
+	}
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+	}
+
+	got := keeper.GetTouchedBalances(ctx)
+	want := scanBalanceEvents(t, em.ABCIEvents())
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got touched balances %v, want those of the events %v", got, want)
+	}
+}
+
+func Test_EndBlock_MalformedEvents(t *testing.T) {
+	keeper, bank, ctx := makeTrackingTestKit()
+	msgsSent := []string{}
+	keeper.PushAction = func(ctx sdk.Context, action vm.Action) error {
+		bz, err := json.Marshal(action)
+		if err != nil {
+			return err
+		}
+		msgsSent = append(msgsSent, string(bz))
+		return nil
+	}
+	am := NewAppModule(keeper)
+
+	// An event with an unparseable amount no longer hides the transfers that
+	// follow it.
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	events := []abci.Event{
+		{
+			Type: "coin_received",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("receiver"), Value: []byte(moduleAddr)},
+				{Key: []byte("amount"), Value: []byte("12 bogus coins")},
+			},
+		},
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManagerWithHistory(events))
+	if err := bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000))); err != nil {
+		t.Fatal(err)
+	}
+	am.EndBlock(ctx, abci.RequestEndBlock{})
+
+	if len(msgsSent) != 1 {
+		t.Fatalf("got msgs = %v, want one message", msgsSent)
+	}
+	gotMsg, _, err := decodeBalances([]byte(msgsSent[0]))
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	wantMsg := newBalances(account(moduleAddr, coin("ubld", "1000")))
+	if !reflect.DeepEqual(gotMsg, wantMsg) {
+		t.Errorf("got sent message %v, want %v", gotMsg, wantMsg)
+	}
+}
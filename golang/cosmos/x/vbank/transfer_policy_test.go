@@ -0,0 +1,120 @@
+package vbank
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func countTransferDenied(ctx sdk.Context) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeTransferDenied {
+			count++
+		}
+	}
+	return count
+}
+
+func Test_Receive_TransferPolicy(t *testing.T) {
+	keeper, _, ctx := makeTrackingTestKit()
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	params := keeper.GetParams(ctx)
+	params.TransferPolicies = []types.DenomTransferPolicy{
+		{Denom: "ubld", AllowGrab: true, AllowGive: true, GrabCapPerBlock: sdk.ZeroInt(), GiveCapPerBlock: sdk.NewInt(100)},
+		{Denom: "urun", AllowGrab: false, AllowGive: true, GrabCapPerBlock: sdk.ZeroInt(), GiveCapPerBlock: sdk.ZeroInt()},
+	}
+	if err := params.ValidateBasic(); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	keeper.SetParams(ctx, params)
+
+	transfer := func(typ, addressKey, address, denom, amount string) string {
+		return `{"type": "` + typ + `", "` + addressKey + `": "` + address + `", "denom": "` + denom + `", "amount": "` + amount + `"}`
+	}
+	give := func(denom, amount string) string { return transfer("VBANK_GIVE", "recipient", addr1, denom, amount) }
+	grab := func(denom, amount string) string { return transfer("VBANK_GRAB", "sender", addr1, denom, amount) }
+
+	for _, msg := range []string{give("ubld", "60"), give("urun", "10"), grab("ubld", "20")} {
+		if _, err := ch.Receive(ctlCtx, msg); err != nil {
+			t.Fatalf("got error = %v for %s", err, msg)
+		}
+	}
+
+	denials := []struct {
+		msg    string
+		reason string
+	}{
+		{give("ubld", "50"), "exceeds the per-block cap of 100ubld"},
+		{grab("urun", "1"), "denom urun may not be grabbed"},
+		{give("uist", "1"), "denom uist may not be given"},
+	}
+	for i, tt := range denials {
+		_, err := ch.Receive(ctlCtx, tt.msg)
+		if err == nil || !strings.Contains(err.Error(), tt.reason) {
+			t.Errorf("got error %v for %s, want %q", err, tt.msg, tt.reason)
+		}
+		if got := countTransferDenied(ctx); got != i+1 {
+			t.Errorf("got %d transfer_denied events, want %d", got, i+1)
+		}
+	}
+
+	// The cap is per address, and grabbing does not count against giving.
+	for _, msg := range []string{transfer("VBANK_GIVE", "recipient", addr2, "ubld", "100"), give("ubld", "40")} {
+		if _, err := ch.Receive(ctlCtx, msg); err != nil {
+			t.Fatalf("got error = %v for %s", err, msg)
+		}
+	}
+
+	// A denied operation in a batch is reported despite the rollback.
+	_, err := ch.Receive(ctlCtx, `{"type": "VBANK_BATCH", "operations": [`+give("urun", "5")+`, `+grab("urun", "5")+`]}`)
+	if err == nil || !strings.Contains(err.Error(), "operation 1: cannot grab 5urun") {
+		t.Errorf("got error %v, want a denial of operation 1", err)
+	}
+	if got := countTransferDenied(ctx); got != len(denials)+1 {
+		t.Errorf("got %d transfer_denied events, want %d", got, len(denials)+1)
+	}
+
+	res, err := keeper.TransferPolicy(ctlCtx, &types.QueryTransferPolicyRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.Restricted || len(res.Policies) != 2 {
+		t.Errorf("got transfer policy %v, want the two restricted policies", res)
+	}
+	res, err = keeper.TransferPolicy(ctlCtx, &types.QueryTransferPolicyRequest{Denom: "uist"})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(res.Policies) != 1 || res.Policies[0].AllowGrab || res.Policies[0].AllowGive {
+		t.Errorf("got transfer policy %v, want uist denied", res)
+	}
+}
+
+func Test_TransferPolicy_Unrestricted(t *testing.T) {
+	keeper, ctx := makeTestKit(nil, nil)
+	res, err := keeper.TransferPolicy(sdk.WrapSDKContext(ctx), &types.QueryTransferPolicyRequest{Denom: "ubld"})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	policy := res.Policies[0]
+	if res.Restricted || !policy.AllowGrab || !policy.AllowGive || !policy.GrabCapPerBlock.IsZero() || !policy.GiveCapPerBlock.IsZero() {
+		t.Errorf("got transfer policy %v, want unrestricted", res)
+	}
+
+	for _, policies := range [][]types.DenomTransferPolicy{
+		{{Denom: "ubld"}, {Denom: "ubld"}},
+		{{Denom: "!"}},
+		{{Denom: "ubld", GrabCapPerBlock: sdk.NewInt(-1)}},
+	} {
+		params := types.DefaultParams()
+		params.TransferPolicies = policies
+		if err := params.ValidateBasic(); err == nil {
+			t.Errorf("got no error validating transfer policies %v", policies)
+		}
+	}
+}
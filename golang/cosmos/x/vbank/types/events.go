@@ -0,0 +1,10 @@
+package types
+
+// Event types and attribute keys emitted by the vbank module.
+const (
+	EventTypeTransferDenied = "transfer_denied"
+
+	AttributeKeyOperation = "operation"
+	AttributeKeyAddress   = "address"
+	AttributeKeyReason    = "reason"
+)
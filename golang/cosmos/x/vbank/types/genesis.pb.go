@@ -29,6 +29,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// state is the current operation state.
 	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// watched_addresses are the addresses whose balance updates are sent to
+	// the controller, in addition to those of module accounts.
+	WatchedAddresses []string `protobuf:"bytes,3,rep,name=watched_addresses,json=watchedAddresses,proto3" json:"watched_addresses,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetState() State {
 	return State{}
 }
 
+func (m *GenesisState) GetWatchedAddresses() []string {
+	if m != nil {
+		return m.WatchedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.vbank.GenesisState")
 }
@@ -85,22 +95,24 @@ func init() {
 func init() { proto.RegisterFile("agoric/vbank/genesis.proto", fileDescriptor_8aaac686f3bede01) }
 
 var fileDescriptor_8aaac686f3bede01 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
+	// 262 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x4c, 0xcf, 0x2f,
 	0xca, 0x4c, 0xd6, 0x2f, 0x4b, 0x4a, 0xcc, 0xcb, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
 	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xc8, 0xe9, 0x81, 0xe5, 0xa4, 0x44, 0xd2,
 	0xf3, 0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x94, 0x04, 0x8a, 0x7e, 0x30, 0x09,
-	0x91, 0x51, 0xaa, 0xe4, 0xe2, 0x71, 0x87, 0x18, 0x17, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc4,
-	0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x87, 0x6c, 0xbc, 0x5e, 0x00, 0x58, 0xce, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x4a,
-	0x21, 0x7d, 0x2e, 0xd6, 0x62, 0x90, 0x66, 0x09, 0x26, 0xb0, 0x16, 0x61, 0x54, 0x2d, 0x60, 0x73,
-	0xa1, 0x3a, 0x20, 0xea, 0xac, 0x58, 0x5e, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3a, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0x47, 0x88, 0xcb, 0x21, 0x46, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0xa7, 0xe7, 0xe7,
-	0x24, 0xe6, 0xa5, 0xeb, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x57, 0x40, 0x3d, 0x55, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x95, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x20, 0xd8,
-	0x19, 0xc8, 0x31, 0x01, 0x00, 0x00,
+	0x91, 0x51, 0x5a, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x2f, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8,
+	0x88, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
+	0x44, 0x0f, 0xd9, 0x7c, 0xbd, 0x00, 0xb0, 0x9c, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50,
+	0x95, 0x42, 0xfa, 0x5c, 0xac, 0xc5, 0x20, 0xcd, 0x12, 0x4c, 0x60, 0x2d, 0xc2, 0xa8, 0x5a, 0xc0,
+	0xe6, 0x42, 0x75, 0x40, 0xd4, 0x09, 0x69, 0x73, 0x09, 0x96, 0x27, 0x96, 0x24, 0x67, 0xa4, 0xa6,
+	0xc4, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0xa7, 0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6b, 0x70,
+	0x06, 0x09, 0x40, 0x25, 0x1c, 0x61, 0xe2, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x38, 0x05, 0x9d,
+	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
+	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69,
+	0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x23, 0xc4, 0x9f, 0x10, 0xfb, 0x75, 0x8b, 0x53, 0xb2, 0xf5,
+	0xd3, 0xf3, 0x73, 0x12, 0xf3, 0xd2, 0xf5, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x2b, 0xa0,
+	0x41, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x03, 0x63, 0xc0, 0x00, 0xae, 0xae,
+	0x4e, 0x74, 0x5f, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +135,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WatchedAddresses) > 0 {
+		for iNdEx := len(m.WatchedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WatchedAddresses[iNdEx])
+			copy(dAtA[i:], m.WatchedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WatchedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -167,6 +188,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WatchedAddresses) > 0 {
+		for _, s := range m.WatchedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -271,6 +298,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WatchedAddresses = append(m.WatchedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,17 @@
 package types
 
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 const RouterKey = ModuleName // this was defined in your key.go file
 
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
 type VbankSingleBalanceUpdate struct {
 	Address string `json:"address"`
 	Denom   string `json:"denom"`
@@ -13,3 +23,41 @@ type VbankBalanceUpdate struct {
 	Type    string                     `json:"type"`
 	Updated []VbankSingleBalanceUpdate `json:"updated"`
 }
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
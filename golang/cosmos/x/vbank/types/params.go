@@ -67,7 +67,8 @@ func (p Params) RewardRate(pool sdk.Coins, blocks int64) sdk.Coins {
 	return sdk.NewCoins(coins...)
 }
 
-// ParamSetPairs returns the parameter set pairs.
+// ParamSetPairs returns the parameter set pairs of the legacy x/params
+// subspace, which predates the transfer policies.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardEpochDurationBlocks, &p.RewardEpochDurationBlocks, validateRewardEpochDurationBlocks),
@@ -84,6 +85,12 @@ func (p Params) ValidateBasic() error {
 	if err := validatePerEpochRewardFraction(p.PerEpochRewardFraction); err != nil {
 		return err
 	}
+	if err := validateRewardSmoothingBlocks(p.RewardSmoothingBlocks); err != nil {
+		return err
+	}
+	if err := validateTransferPolicies(p.TransferPolicies); err != nil {
+		return err
+	}
 
 	return nil
 }
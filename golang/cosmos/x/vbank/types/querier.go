@@ -5,3 +5,12 @@ const (
 	QueryParams = "params"
 	QueryState  = "state"
 )
+
+const (
+	// DefaultRewardScheduleBlocks is the number of blocks projected by the
+	// reward schedule query when the request does not specify it.
+	DefaultRewardScheduleBlocks = 10_000
+
+	// MaxRewardScheduleBlocks bounds the work of the reward schedule query.
+	MaxRewardScheduleBlocks = 100_000
+)
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -195,331 +198,2611 @@ func (m *QueryStateResponse) GetState() State {
 	return State{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vbank.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vbank.QueryParamsResponse")
-	proto.RegisterType((*QueryStateRequest)(nil), "agoric.vbank.QueryStateRequest")
-	proto.RegisterType((*QueryStateResponse)(nil), "agoric.vbank.QueryStateResponse")
+// QueryWatchedAddressesRequest is the request type for the
+// Query/WatchedAddresses RPC method.
+type QueryWatchedAddressesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("agoric/vbank/query.proto", fileDescriptor_f70e65583c8f2384) }
-
-var fileDescriptor_f70e65583c8f2384 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0x4f, 0x5f, 0x3b, 0x18, 0x16, 0x9c, 0x80, 0xaa, 0x50, 0xb9, 0x25, 0x13, 0x0b,
-	0xb1, 0x54, 0x16, 0x56, 0x2a, 0x31, 0xb0, 0x41, 0xd9, 0xd8, 0xdc, 0x62, 0x99, 0xa8, 0xad, 0x6f,
-	0x1a, 0xbb, 0x88, 0xae, 0x3c, 0x01, 0x12, 0x2f, 0xd5, 0xb1, 0x12, 0x0b, 0x0b, 0x08, 0xb5, 0x3c,
-	0x08, 0xaa, 0x6d, 0xa4, 0x5a, 0xfc, 0x59, 0xa2, 0xe8, 0x9e, 0x73, 0x7f, 0xe7, 0x9e, 0x04, 0x37,
-	0xb8, 0x84, 0xaa, 0x18, 0xb0, 0xbb, 0x3e, 0x57, 0x43, 0x36, 0x99, 0x8a, 0x6a, 0x96, 0x97, 0x15,
-	0x18, 0x20, 0xdb, 0x4e, 0xc9, 0xad, 0x92, 0x26, 0x12, 0x24, 0x58, 0x81, 0xad, 0xdf, 0x9c, 0x27,
-	0x6d, 0x4a, 0x00, 0x39, 0x12, 0x8c, 0x97, 0x05, 0xe3, 0x4a, 0x81, 0xe1, 0xa6, 0x00, 0xa5, 0xbd,
-	0x1a, 0xb2, 0xed, 0xd3, 0x29, 0x59, 0x82, 0xc9, 0xe5, 0x3a, 0xea, 0x82, 0x57, 0x7c, 0xac, 0x7b,
-	0x62, 0x32, 0x15, 0xda, 0x64, 0xe7, 0x38, 0x0e, 0xa6, 0xba, 0x04, 0xa5, 0x05, 0xe9, 0xe0, 0x7a,
-	0x69, 0x27, 0x0d, 0xd4, 0x46, 0x87, 0x5b, 0x9d, 0x24, 0xdf, 0xbc, 0x2c, 0x77, 0xee, 0xee, 0xff,
-	0xf9, 0x5b, 0x2b, 0xea, 0x79, 0x67, 0x16, 0xe3, 0x1d, 0x8b, 0xba, 0x32, 0xdc, 0x88, 0x2f, 0xfe,
-	0x99, 0x4f, 0xf5, 0x43, 0x8f, 0x67, 0xb8, 0xa6, 0xd7, 0x03, 0x4f, 0x8f, 0x43, 0xba, 0xf5, 0x7a,
-	0xb8, 0xf3, 0x75, 0x5e, 0x11, 0xae, 0x59, 0x0e, 0x19, 0xe2, 0xba, 0x4b, 0x27, 0xed, 0x70, 0xeb,
-	0x7b, 0xb9, 0xf4, 0xe0, 0x0f, 0x87, 0xbb, 0x24, 0x6b, 0x3e, 0x3c, 0x7f, 0x3c, 0xfd, 0xdb, 0x23,
-	0x09, 0x0b, 0x3e, 0x9c, 0xab, 0x44, 0x24, 0xae, 0xd9, 0x63, 0x48, 0xeb, 0x07, 0xd2, 0x66, 0xcf,
-	0xb4, 0xfd, 0xbb, 0xc1, 0x27, 0xed, 0xdb, 0xa4, 0x5d, 0x12, 0x87, 0x49, 0xb6, 0x5f, 0xb7, 0x37,
-	0x5f, 0x52, 0xb4, 0x58, 0x52, 0xf4, 0xbe, 0xa4, 0xe8, 0x71, 0x45, 0xa3, 0xc5, 0x8a, 0x46, 0x2f,
-	0x2b, 0x1a, 0x5d, 0x9f, 0xc8, 0xc2, 0xdc, 0x4e, 0xfb, 0xf9, 0x00, 0xc6, 0xec, 0xd4, 0x2d, 0xba,
-	0xfd, 0x23, 0x7d, 0x33, 0x64, 0x12, 0x46, 0x5c, 0x49, 0x36, 0x00, 0x3d, 0x06, 0xcd, 0xee, 0x3d,
-	0xd3, 0xcc, 0x4a, 0xa1, 0xfb, 0x75, 0xfb, 0xdf, 0x8f, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x35, 0x34, 0x38, 0x6f, 0x02, 0x00, 0x00,
+func (m *QueryWatchedAddressesRequest) Reset()         { *m = QueryWatchedAddressesRequest{} }
+func (m *QueryWatchedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchedAddressesRequest) ProtoMessage()    {}
+func (*QueryWatchedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{4}
+}
+func (m *QueryWatchedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWatchedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchedAddressesRequest.Merge(m, src)
+}
+func (m *QueryWatchedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchedAddressesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryWatchedAddressesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the vbank module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// State queries current state of the vbank module.
-	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+func (m *QueryWatchedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryWatchedAddressesResponse is the response type for the
+// Query/WatchedAddresses RPC method.
+type QueryWatchedAddressesResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryWatchedAddressesResponse) Reset()         { *m = QueryWatchedAddressesResponse{} }
+func (m *QueryWatchedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchedAddressesResponse) ProtoMessage()    {}
+func (*QueryWatchedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{5}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWatchedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryWatchedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchedAddressesResponse.Merge(m, src)
+}
+func (m *QueryWatchedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchedAddressesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
-	out := new(QueryStateResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/State", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWatchedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryWatchedAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the vbank module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// State queries current state of the vbank module.
-	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+func (m *QueryWatchedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryRewardScheduleRequest is the request type for the Query/RewardSchedule
+// RPC method.
+type QueryRewardScheduleRequest struct {
+	// blocks is the number of blocks to project, or zero for the default.
+	Blocks int64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+func (m *QueryRewardScheduleRequest) Reset()         { *m = QueryRewardScheduleRequest{} }
+func (m *QueryRewardScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardScheduleRequest) ProtoMessage()    {}
+func (*QueryRewardScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{6}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRewardScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vbank.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryRewardScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRewardScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardScheduleRequest.Merge(m, src)
+}
+func (m *QueryRewardScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardScheduleRequest.DiscardUnknown(m)
 }
 
-func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).State(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vbank.Query/State",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).State(ctx, req.(*QueryStateRequest))
+var xxx_messageInfo_QueryRewardScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryRewardScheduleRequest) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.vbank.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "State",
-			Handler:    _Query_State_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/vbank/query.proto",
+// RewardPayout is the amount distributed in each of a range of blocks.
+type RewardPayout struct {
+	StartHeight int64                                    `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64                                    `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardPayout) Reset()         { *m = RewardPayout{} }
+func (m *RewardPayout) String() string { return proto.CompactTextString(m) }
+func (*RewardPayout) ProtoMessage()    {}
+func (*RewardPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{7}
+}
+func (m *RewardPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RewardPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPayout.Merge(m, src)
+}
+func (m *RewardPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPayout.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RewardPayout proto.InternalMessageInfo
+
+func (m *RewardPayout) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RewardPayout) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardPayout) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardScheduleResponse is the response type for the
+// Query/RewardSchedule RPC method.
+type QueryRewardScheduleResponse struct {
+	// height is the height of the state from which the schedule is projected.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// epoch_start_height is the height at which the current epoch started.
+	EpochStartHeight int64 `protobuf:"varint,2,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
+	// next_epoch_height is the height at which the next epoch will start.
+	NextEpochHeight      int64 `protobuf:"varint,3,opt,name=next_epoch_height,json=nextEpochHeight,proto3" json:"next_epoch_height,omitempty"`
+	BlocksUntilNextEpoch int64 `protobuf:"varint,4,opt,name=blocks_until_next_epoch,json=blocksUntilNextEpoch,proto3" json:"blocks_until_next_epoch,omitempty"`
+	// reward_block_amount is the amount to distribute in each block of the
+	// smoothing period of the current epoch.
+	RewardBlockAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reward_block_amount,json=rewardBlockAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_block_amount"`
+	RewardPool        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+	// projection is the amount distributed in each of the projected blocks,
+	// omitting blocks without distribution.
+	Projection []RewardPayout `protobuf:"bytes,7,rep,name=projection,proto3" json:"projection"`
+	// pool_exhausted_height is the height of the block that is projected to
+	// empty the reward pool, or zero if it is not emptied in the projection.
+	PoolExhaustedHeight int64 `protobuf:"varint,8,opt,name=pool_exhausted_height,json=poolExhaustedHeight,proto3" json:"pool_exhausted_height,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardScheduleResponse) Reset()         { *m = QueryRewardScheduleResponse{} }
+func (m *QueryRewardScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardScheduleResponse) ProtoMessage()    {}
+func (*QueryRewardScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{8}
+}
+func (m *QueryRewardScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardScheduleResponse.Merge(m, src)
+}
+func (m *QueryRewardScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryRewardScheduleResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardScheduleResponse) GetEpochStartHeight() int64 {
+	if m != nil {
+		return m.EpochStartHeight
+	}
+	return 0
 }
 
-func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRewardScheduleResponse) GetNextEpochHeight() int64 {
+	if m != nil {
+		return m.NextEpochHeight
+	}
+	return 0
 }
 
-func (m *QueryStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardScheduleResponse) GetBlocksUntilNextEpoch() int64 {
+	if m != nil {
+		return m.BlocksUntilNextEpoch
+	}
+	return 0
+}
+
+func (m *QueryRewardScheduleResponse) GetRewardBlockAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardBlockAmount
+	}
+	return nil
+}
+
+func (m *QueryRewardScheduleResponse) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *QueryRewardScheduleResponse) GetProjection() []RewardPayout {
+	if m != nil {
+		return m.Projection
+	}
+	return nil
+}
+
+func (m *QueryRewardScheduleResponse) GetPoolExhaustedHeight() int64 {
+	if m != nil {
+		return m.PoolExhaustedHeight
+	}
+	return 0
+}
+
+// QueryRewardPoolReconciliationRequest is the request type for the
+// Query/RewardPoolReconciliation RPC method.
+type QueryRewardPoolReconciliationRequest struct {
+}
+
+func (m *QueryRewardPoolReconciliationRequest) Reset()         { *m = QueryRewardPoolReconciliationRequest{} }
+func (m *QueryRewardPoolReconciliationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolReconciliationRequest) ProtoMessage()    {}
+func (*QueryRewardPoolReconciliationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{9}
+}
+func (m *QueryRewardPoolReconciliationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolReconciliationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolReconciliationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolReconciliationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolReconciliationRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolReconciliationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolReconciliationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolReconciliationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolReconciliationRequest proto.InternalMessageInfo
+
+// QueryRewardPoolReconciliationResponse is the response type for the
+// Query/RewardPoolReconciliation RPC method.
+type QueryRewardPoolReconciliationResponse struct {
+	// reward_pool is the pool recorded in the vbank state.
+	RewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+	// module_balance is the balance of the vbank module account.
+	ModuleBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=module_balance,json=moduleBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"module_balance"`
+	// surplus is the part of the module balance not recorded in the pool.
+	Surplus github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=surplus,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"surplus"`
+	// shortfall is the part of the pool not covered by the module balance.
+	Shortfall github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=shortfall,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shortfall"`
+	// reconciled is whether the pool and the module balance are equal.
+	Reconciled bool `protobuf:"varint,5,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+}
+
+func (m *QueryRewardPoolReconciliationResponse) Reset()         { *m = QueryRewardPoolReconciliationResponse{} }
+func (m *QueryRewardPoolReconciliationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolReconciliationResponse) ProtoMessage()    {}
+func (*QueryRewardPoolReconciliationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{10}
+}
+func (m *QueryRewardPoolReconciliationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolReconciliationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolReconciliationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolReconciliationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolReconciliationResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolReconciliationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolReconciliationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolReconciliationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolReconciliationResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolReconciliationResponse) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *QueryRewardPoolReconciliationResponse) GetModuleBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ModuleBalance
+	}
+	return nil
+}
+
+func (m *QueryRewardPoolReconciliationResponse) GetSurplus() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Surplus
+	}
+	return nil
+}
+
+func (m *QueryRewardPoolReconciliationResponse) GetShortfall() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shortfall
+	}
+	return nil
+}
+
+func (m *QueryRewardPoolReconciliationResponse) GetReconciled() bool {
+	if m != nil {
+		return m.Reconciled
+	}
+	return false
+}
+
+// QueryTransferPolicyRequest is the request type for the Query/TransferPolicy
+// RPC method.
+type QueryTransferPolicyRequest struct {
+	// denom restricts the response to the effective policy of a single denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTransferPolicyRequest) Reset()         { *m = QueryTransferPolicyRequest{} }
+func (m *QueryTransferPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferPolicyRequest) ProtoMessage()    {}
+func (*QueryTransferPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{11}
+}
+func (m *QueryTransferPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferPolicyRequest.Merge(m, src)
+}
+func (m *QueryTransferPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryTransferPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryTransferPolicyResponse is the response type for the Query/TransferPolicy
+// RPC method.
+type QueryTransferPolicyResponse struct {
+	// restricted is whether VBANK_GRAB and VBANK_GIVE are limited to the denoms
+	// of the policies.  If not, any denom may be transferred without limit.
+	Restricted bool                  `protobuf:"varint,1,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	Policies   []DenomTransferPolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies"`
+}
+
+func (m *QueryTransferPolicyResponse) Reset()         { *m = QueryTransferPolicyResponse{} }
+func (m *QueryTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferPolicyResponse) ProtoMessage()    {}
+func (*QueryTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{12}
+}
+func (m *QueryTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferPolicyResponse.Merge(m, src)
+}
+func (m *QueryTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryTransferPolicyResponse) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *QueryTransferPolicyResponse) GetPolicies() []DenomTransferPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vbank.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vbank.QueryParamsResponse")
+	proto.RegisterType((*QueryStateRequest)(nil), "agoric.vbank.QueryStateRequest")
+	proto.RegisterType((*QueryStateResponse)(nil), "agoric.vbank.QueryStateResponse")
+	proto.RegisterType((*QueryWatchedAddressesRequest)(nil), "agoric.vbank.QueryWatchedAddressesRequest")
+	proto.RegisterType((*QueryWatchedAddressesResponse)(nil), "agoric.vbank.QueryWatchedAddressesResponse")
+	proto.RegisterType((*QueryRewardScheduleRequest)(nil), "agoric.vbank.QueryRewardScheduleRequest")
+	proto.RegisterType((*RewardPayout)(nil), "agoric.vbank.RewardPayout")
+	proto.RegisterType((*QueryRewardScheduleResponse)(nil), "agoric.vbank.QueryRewardScheduleResponse")
+	proto.RegisterType((*QueryRewardPoolReconciliationRequest)(nil), "agoric.vbank.QueryRewardPoolReconciliationRequest")
+	proto.RegisterType((*QueryRewardPoolReconciliationResponse)(nil), "agoric.vbank.QueryRewardPoolReconciliationResponse")
+	proto.RegisterType((*QueryTransferPolicyRequest)(nil), "agoric.vbank.QueryTransferPolicyRequest")
+	proto.RegisterType((*QueryTransferPolicyResponse)(nil), "agoric.vbank.QueryTransferPolicyResponse")
+}
+
+func init() { proto.RegisterFile("agoric/vbank/query.proto", fileDescriptor_f70e65583c8f2384) }
+
+var fileDescriptor_f70e65583c8f2384 = []byte{
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xb3, 0xd9, 0x6d, 0xf2, 0x12, 0x4a, 0x33, 0xbb, 0x0d, 0xc6, 0x49, 0x36, 0x1b, 0x8b,
+	0xb6, 0xdb, 0x00, 0xeb, 0x76, 0x0b, 0x12, 0x47, 0x92, 0x12, 0xfe, 0x5c, 0xd0, 0xe2, 0x80, 0x90,
+	0xb8, 0x58, 0xb3, 0xf6, 0xc4, 0x6b, 0xe2, 0xf5, 0xb8, 0x9e, 0x71, 0x9b, 0x88, 0x5b, 0x0f, 0x9c,
+	0x38, 0x20, 0xc1, 0x77, 0x40, 0xe2, 0xde, 0xef, 0x50, 0x71, 0xaa, 0xc4, 0x85, 0x13, 0xa0, 0x84,
+	0x6f, 0xc1, 0x05, 0x79, 0x66, 0xdc, 0xf5, 0x94, 0xdd, 0x36, 0x95, 0xd2, 0x4b, 0x1b, 0xbf, 0xf7,
+	0x7b, 0xbf, 0xf7, 0x7b, 0x6f, 0x66, 0xde, 0x5b, 0x30, 0x71, 0x48, 0xb3, 0xc8, 0x77, 0xee, 0x0f,
+	0x71, 0x72, 0xe4, 0xdc, 0xcb, 0x49, 0x76, 0xd2, 0x4b, 0x33, 0xca, 0x29, 0x5a, 0x91, 0x9e, 0x9e,
+	0xf0, 0x58, 0xad, 0x90, 0x86, 0x54, 0x38, 0x9c, 0xe2, 0x2f, 0x89, 0xb1, 0x36, 0x42, 0x4a, 0xc3,
+	0x98, 0x38, 0x38, 0x8d, 0x1c, 0x9c, 0x24, 0x94, 0x63, 0x1e, 0xd1, 0x84, 0x29, 0xef, 0x8e, 0x4f,
+	0xd9, 0x98, 0x32, 0x67, 0x88, 0x19, 0x91, 0xd4, 0xce, 0xfd, 0xdb, 0x43, 0xc2, 0xf1, 0x6d, 0x27,
+	0xc5, 0x61, 0x94, 0x08, 0xb0, 0xc2, 0xb6, 0xab, 0xd8, 0x12, 0xe5, 0xd3, 0xa8, 0xf4, 0xeb, 0x3a,
+	0xc5, 0xbf, 0xd2, 0x63, 0xb7, 0x00, 0x7d, 0x51, 0x70, 0x0f, 0x70, 0x86, 0xc7, 0xcc, 0x25, 0xf7,
+	0x72, 0xc2, 0xb8, 0xfd, 0x19, 0x34, 0x35, 0x2b, 0x4b, 0x69, 0xc2, 0x08, 0xea, 0x43, 0x23, 0x15,
+	0x16, 0xd3, 0xe8, 0x18, 0xdd, 0xe5, 0x7e, 0xab, 0x57, 0xad, 0xb2, 0x27, 0xd1, 0x7b, 0x0b, 0x8f,
+	0xff, 0xdc, 0x9a, 0x73, 0x15, 0xd2, 0x6e, 0xc2, 0xaa, 0xa0, 0x3a, 0xe0, 0x98, 0x93, 0x92, 0x7f,
+	0x1f, 0x50, 0xd5, 0xa8, 0xe8, 0x1d, 0xa8, 0xb3, 0xc2, 0xa0, 0xd8, 0x9b, 0x3a, 0xbb, 0xc0, 0x2a,
+	0x72, 0x89, 0xb3, 0x0f, 0x61, 0x43, 0xd0, 0x7c, 0x8d, 0xb9, 0x3f, 0x22, 0xc1, 0x6e, 0x10, 0x64,
+	0x84, 0x31, 0x52, 0x96, 0x81, 0x3e, 0x06, 0x98, 0xb4, 0x4a, 0xb1, 0x5e, 0xef, 0xc9, 0x5e, 0xf5,
+	0x8a, 0x5e, 0xf5, 0xe4, 0x91, 0xa9, 0x8e, 0xf5, 0x06, 0x38, 0x2c, 0x25, 0xba, 0x95, 0x48, 0xfb,
+	0x7b, 0x03, 0x36, 0x67, 0x24, 0x52, 0xd2, 0x37, 0x60, 0x09, 0x97, 0x46, 0xd3, 0xe8, 0xd4, 0xba,
+	0x4b, 0xee, 0xc4, 0x80, 0x3e, 0xd1, 0x74, 0xcc, 0x0b, 0x1d, 0x37, 0x5e, 0xa8, 0x43, 0x52, 0x6b,
+	0x42, 0xde, 0x03, 0x4b, 0xe8, 0x70, 0xc9, 0x03, 0x9c, 0x05, 0x07, 0x85, 0x96, 0x3c, 0x2e, 0x25,
+	0xa3, 0x35, 0x68, 0x0c, 0x63, 0xea, 0x1f, 0xc9, 0xe3, 0xa9, 0xb9, 0xea, 0xcb, 0x7e, 0x64, 0xc0,
+	0x8a, 0x8c, 0x18, 0xe0, 0x13, 0x9a, 0x73, 0xb4, 0x0d, 0x2b, 0x8c, 0xe3, 0x8c, 0x7b, 0x23, 0x12,
+	0x85, 0x23, 0xae, 0xe0, 0xcb, 0xc2, 0xf6, 0xa9, 0x30, 0xa1, 0x4d, 0x00, 0x92, 0x04, 0x25, 0x60,
+	0x5e, 0x00, 0x96, 0x48, 0x12, 0x28, 0xb7, 0x0f, 0x0d, 0x3c, 0xa6, 0x79, 0xc2, 0xcd, 0x5a, 0xa7,
+	0xd6, 0x5d, 0xee, 0xbf, 0xa9, 0x55, 0x53, 0xd6, 0x71, 0x97, 0x46, 0xc9, 0xde, 0xad, 0xe2, 0xc4,
+	0x7e, 0xfd, 0x6b, 0xab, 0x1b, 0x46, 0x7c, 0x94, 0x0f, 0x7b, 0x3e, 0x1d, 0x3b, 0xea, 0xba, 0xca,
+	0xff, 0xde, 0x65, 0xc1, 0x91, 0xc3, 0x4f, 0x52, 0xc2, 0x44, 0x00, 0x73, 0x15, 0xb5, 0xfd, 0xcb,
+	0x02, 0xac, 0x4f, 0x2d, 0x57, 0x35, 0x7d, 0x0d, 0x1a, 0x5a, 0x01, 0xea, 0x0b, 0xbd, 0x03, 0x88,
+	0xa4, 0xd4, 0x1f, 0x79, 0x5a, 0x91, 0xb2, 0x86, 0x2b, 0xc2, 0x73, 0x50, 0xa9, 0x74, 0x07, 0x56,
+	0x13, 0x72, 0xcc, 0x3d, 0x19, 0xa2, 0xc0, 0x35, 0x01, 0x7e, 0xbd, 0x70, 0xec, 0x17, 0x76, 0x85,
+	0x7d, 0x1f, 0xde, 0x90, 0x3d, 0xf5, 0xf2, 0x84, 0x47, 0xb1, 0x37, 0x09, 0x34, 0x17, 0x44, 0x44,
+	0x4b, 0xba, 0xbf, 0x2a, 0xbc, 0x9f, 0x97, 0xc1, 0xe8, 0x3b, 0x68, 0x66, 0xa2, 0x04, 0x4f, 0xb8,
+	0x3d, 0xd5, 0xba, 0xfa, 0xc5, 0xb7, 0x6e, 0x55, 0xe6, 0xd9, 0x2b, 0xd2, 0xec, 0x8a, 0x2c, 0x28,
+	0x86, 0x65, 0x95, 0x3c, 0xa5, 0x34, 0x36, 0x1b, 0x17, 0x9f, 0x14, 0x24, 0xff, 0x80, 0xd2, 0x18,
+	0x7d, 0x08, 0x90, 0x66, 0xf4, 0x5b, 0xe2, 0x8b, 0xab, 0x7e, 0x49, 0x24, 0xb3, 0xf4, 0x87, 0x5c,
+	0xbd, 0x8a, 0xea, 0x3d, 0x57, 0x62, 0x50, 0x1f, 0xae, 0x16, 0x42, 0x3d, 0x72, 0x3c, 0xc2, 0x39,
+	0xe3, 0xe4, 0xe9, 0x25, 0x5c, 0x14, 0x1d, 0x6e, 0x16, 0xce, 0xfd, 0xd2, 0x27, 0xcf, 0xc5, 0xbe,
+	0x0e, 0x6f, 0x55, 0x2e, 0x4a, 0x21, 0xc4, 0x25, 0x3e, 0x4d, 0xfc, 0x28, 0x8e, 0xc4, 0xc3, 0x29,
+	0xe7, 0xce, 0xbf, 0x35, 0xb8, 0xf6, 0x02, 0xa0, 0xba, 0x5b, 0xcf, 0x74, 0xcd, 0x78, 0xb5, 0x5d,
+	0xcb, 0xe0, 0xf2, 0x98, 0x16, 0x77, 0xdb, 0x1b, 0xe2, 0x18, 0x27, 0x3e, 0x31, 0xe7, 0x2f, 0x3e,
+	0xe1, 0x6b, 0x32, 0xc5, 0x9e, 0xcc, 0x80, 0x08, 0x5c, 0x62, 0x79, 0x96, 0xc6, 0x39, 0x7b, 0x15,
+	0x6f, 0xb8, 0xe4, 0x46, 0x11, 0x2c, 0xb1, 0x11, 0xcd, 0xf8, 0x21, 0x8e, 0x63, 0x73, 0xe1, 0xe2,
+	0x13, 0x4d, 0xd8, 0x51, 0x1b, 0x20, 0x53, 0xa7, 0x49, 0x02, 0xb3, 0xde, 0x31, 0xba, 0x8b, 0x6e,
+	0xc5, 0x62, 0xf7, 0xd5, 0xf4, 0xfc, 0x32, 0xc3, 0x09, 0x3b, 0x24, 0xd9, 0x80, 0xc6, 0x91, 0x7f,
+	0x52, 0x4e, 0xcf, 0x16, 0xd4, 0x03, 0x92, 0xd0, 0xb1, 0x18, 0x26, 0x4b, 0xae, 0xfc, 0xb0, 0x1f,
+	0x1a, 0xb0, 0x3e, 0x35, 0x48, 0xdd, 0x13, 0x91, 0x93, 0xf1, 0x2c, 0xf2, 0x39, 0x09, 0x4c, 0xa3,
+	0xcc, 0x59, 0x5a, 0xd0, 0x5d, 0x58, 0x4c, 0x8b, 0x88, 0x88, 0x30, 0x75, 0xa6, 0xdb, 0xfa, 0x6b,
+	0xf8, 0xa8, 0x48, 0xa3, 0x93, 0xab, 0x47, 0xf1, 0x34, 0xb0, 0xff, 0x5b, 0x03, 0xea, 0x42, 0x04,
+	0x3a, 0x82, 0x86, 0xdc, 0xb2, 0xa8, 0xa3, 0xd3, 0xfc, 0x7f, 0x89, 0x5b, 0xdb, 0xcf, 0x41, 0x48,
+	0xf5, 0xf6, 0xc6, 0xc3, 0xdf, 0xff, 0xf9, 0x69, 0x7e, 0x0d, 0xb5, 0x1c, 0xed, 0x07, 0x82, 0x5c,
+	0xdd, 0x28, 0x84, 0xba, 0x58, 0xba, 0x68, 0x6b, 0x0a, 0x53, 0x75, 0x9f, 0x5b, 0x9d, 0xd9, 0x00,
+	0x95, 0x69, 0x5d, 0x64, 0xba, 0x8a, 0x9a, 0x7a, 0x26, 0xb1, 0xc7, 0xd1, 0xcf, 0x06, 0x5c, 0x79,
+	0x76, 0xb5, 0xa2, 0x9d, 0x29, 0x9c, 0x33, 0x16, 0xbd, 0xf5, 0xf6, 0xb9, 0xb0, 0x4a, 0xca, 0x0d,
+	0x21, 0x65, 0x1b, 0x6d, 0xe9, 0x52, 0x1e, 0x48, 0xbc, 0x37, 0x59, 0xdb, 0x3f, 0x18, 0x70, 0x59,
+	0x5f, 0x3d, 0xa8, 0x3b, 0x25, 0xd1, 0xd4, 0x65, 0x6c, 0xdd, 0x3c, 0x07, 0x52, 0x09, 0xba, 0x26,
+	0x04, 0x6d, 0xa1, 0x4d, 0x5d, 0x90, 0x9a, 0x3f, 0xac, 0xcc, 0xfd, 0xc8, 0x00, 0x73, 0xd6, 0xdc,
+	0x42, 0xfd, 0x99, 0xe9, 0x66, 0x4e, 0x43, 0xeb, 0xce, 0x4b, 0xc5, 0x28, 0xb1, 0xb7, 0x84, 0xd8,
+	0x1d, 0xd4, 0x9d, 0x2a, 0x56, 0x4c, 0xee, 0x4c, 0x97, 0x56, 0xb4, 0x51, 0xbf, 0xe0, 0x53, 0xdb,
+	0x38, 0xf5, 0x55, 0x5a, 0x37, 0xcf, 0x81, 0x7c, 0x7e, 0x1b, 0xb9, 0x42, 0x7b, 0xa9, 0x7c, 0x5c,
+	0xee, 0xe3, 0xd3, 0xb6, 0xf1, 0xe4, 0xb4, 0x6d, 0xfc, 0x7d, 0xda, 0x36, 0x7e, 0x3c, 0x6b, 0xcf,
+	0x3d, 0x39, 0x6b, 0xcf, 0xfd, 0x71, 0xd6, 0x9e, 0xfb, 0xe6, 0x83, 0xca, 0xd0, 0xd9, 0x95, 0x14,
+	0x92, 0x49, 0x0c, 0x9d, 0x90, 0xc6, 0x38, 0x09, 0xcb, 0x69, 0x74, 0x5c, 0xb2, 0x17, 0xa3, 0x68,
+	0xd8, 0x10, 0x3f, 0xa6, 0xef, 0xfc, 0x37, 0x00, 0xae, 0x4b, 0x3a, 0x04, 0x10, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the vbank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// State queries current state of the vbank module.
+	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// WatchedAddresses lists the addresses whose balance updates are sent to
+	// the controller, in addition to those of module accounts.
+	WatchedAddresses(ctx context.Context, in *QueryWatchedAddressesRequest, opts ...grpc.CallOption) (*QueryWatchedAddressesResponse, error)
+	// RewardSchedule projects the distribution of the reward pool to the fee
+	// collector, assuming that no more rewards are added and the params do not
+	// change.
+	RewardSchedule(ctx context.Context, in *QueryRewardScheduleRequest, opts ...grpc.CallOption) (*QueryRewardScheduleResponse, error)
+	// RewardPoolReconciliation compares the recorded reward pool with the
+	// balance of the vbank module account that holds it.
+	RewardPoolReconciliation(ctx context.Context, in *QueryRewardPoolReconciliationRequest, opts ...grpc.CallOption) (*QueryRewardPoolReconciliationResponse, error)
+	// TransferPolicy queries the effective policy for VBANK_GRAB and VBANK_GIVE.
+	TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
+	out := new(QueryStateResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (c *queryClient) WatchedAddresses(ctx context.Context, in *QueryWatchedAddressesRequest, opts ...grpc.CallOption) (*QueryWatchedAddressesResponse, error) {
+	out := new(QueryWatchedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/WatchedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardSchedule(ctx context.Context, in *QueryRewardScheduleRequest, opts ...grpc.CallOption) (*QueryRewardScheduleResponse, error) {
+	out := new(QueryRewardScheduleResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/RewardSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPoolReconciliation(ctx context.Context, in *QueryRewardPoolReconciliationRequest, opts ...grpc.CallOption) (*QueryRewardPoolReconciliationResponse, error) {
+	out := new(QueryRewardPoolReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/RewardPoolReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error) {
+	out := new(QueryTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/TransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the vbank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// State queries current state of the vbank module.
+	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// WatchedAddresses lists the addresses whose balance updates are sent to
+	// the controller, in addition to those of module accounts.
+	WatchedAddresses(context.Context, *QueryWatchedAddressesRequest) (*QueryWatchedAddressesResponse, error)
+	// RewardSchedule projects the distribution of the reward pool to the fee
+	// collector, assuming that no more rewards are added and the params do not
+	// change.
+	RewardSchedule(context.Context, *QueryRewardScheduleRequest) (*QueryRewardScheduleResponse, error)
+	// RewardPoolReconciliation compares the recorded reward pool with the
+	// balance of the vbank module account that holds it.
+	RewardPoolReconciliation(context.Context, *QueryRewardPoolReconciliationRequest) (*QueryRewardPoolReconciliationResponse, error)
+	// TransferPolicy queries the effective policy for VBANK_GRAB and VBANK_GIVE.
+	TransferPolicy(context.Context, *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (*UnimplementedQueryServer) WatchedAddresses(ctx context.Context, req *QueryWatchedAddressesRequest) (*QueryWatchedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) RewardSchedule(ctx context.Context, req *QueryRewardScheduleRequest) (*QueryRewardScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardSchedule not implemented")
+}
+func (*UnimplementedQueryServer) RewardPoolReconciliation(ctx context.Context, req *QueryRewardPoolReconciliationRequest) (*QueryRewardPoolReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPoolReconciliation not implemented")
+}
+func (*UnimplementedQueryServer) TransferPolicy(ctx context.Context, req *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicy not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).State(ctx, req.(*QueryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWatchedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WatchedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/WatchedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WatchedAddresses(ctx, req.(*QueryWatchedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/RewardSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardSchedule(ctx, req.(*QueryRewardScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPoolReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPoolReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/RewardPoolReconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPoolReconciliation(ctx, req.(*QueryRewardPoolReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/TransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPolicy(ctx, req.(*QueryTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.vbank.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _Query_State_Handler,
+		},
+		{
+			MethodName: "WatchedAddresses",
+			Handler:    _Query_WatchedAddresses_Handler,
+		},
+		{
+			MethodName: "RewardSchedule",
+			Handler:    _Query_RewardSchedule_Handler,
+		},
+		{
+			MethodName: "RewardPoolReconciliation",
+			Handler:    _Query_RewardPoolReconciliation_Handler,
+		},
+		{
+			MethodName: "TransferPolicy",
+			Handler:    _Query_TransferPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/vbank/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolExhaustedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolExhaustedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Projection) > 0 {
+		for iNdEx := len(m.Projection) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projection[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for iNdEx := len(m.RewardBlockAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardBlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlocksUntilNextEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextEpochHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpochHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolReconciliationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolReconciliationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolReconciliationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolReconciliationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolReconciliationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolReconciliationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reconciled {
+		i--
+		if m.Reconciled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Shortfall) > 0 {
+		for iNdEx := len(m.Shortfall) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfall[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Surplus) > 0 {
+		for iNdEx := len(m.Surplus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Surplus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ModuleBalance) > 0 {
+		for iNdEx := len(m.ModuleBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWatchedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWatchedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *RewardPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EpochStartHeight))
+	}
+	if m.NextEpochHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpochHeight))
+	}
+	if m.BlocksUntilNextEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for _, e := range m.RewardBlockAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Projection) > 0 {
+		for _, e := range m.Projection {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PoolExhaustedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.PoolExhaustedHeight))
+	}
+	return n
+}
+
+func (m *QueryRewardPoolReconciliationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolReconciliationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ModuleBalance) > 0 {
+		for _, e := range m.ModuleBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Surplus) > 0 {
+		for _, e := range m.Surplus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Shortfall) > 0 {
+		for _, e := range m.Shortfall {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Reconciled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTransferPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Restricted {
+		n += 2
+	}
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochHeight", wireType)
+			}
+			m.NextEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
+			}
+			m.BlocksUntilNextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUntilNextEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBlockAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardBlockAmount = append(m.RewardBlockAmount, types.Coin{})
+			if err := m.RewardBlockAmount[len(m.RewardBlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projection = append(m.Projection, RewardPayout{})
+			if err := m.Projection[len(m.Projection)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolExhaustedHeight", wireType)
+			}
+			m.PoolExhaustedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolExhaustedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.State.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolReconciliationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -542,10 +2825,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolReconciliationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolReconciliationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -569,7 +2852,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolReconciliationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,15 +2875,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolReconciliationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolReconciliationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -627,10 +2910,133 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleBalance = append(m.ModuleBalance, types.Coin{})
+			if err := m.ModuleBalance[len(m.ModuleBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Surplus = append(m.Surplus, types.Coin{})
+			if err := m.Surplus[len(m.Surplus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfall = append(m.Shortfall, types.Coin{})
+			if err := m.Shortfall[len(m.Shortfall)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reconciled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -652,7 +3058,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -675,12 +3081,44 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -702,7 +3140,7 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -725,15 +3163,35 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -760,7 +3218,8 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policies = append(m.Policies, DenomTransferPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The operations limited by the transfer policies.
+const (
+	TransferGrab = "grab"
+	TransferGive = "give"
+)
+
+// GetTransferPolicy returns the effective policy for VBANK_GRAB and VBANK_GIVE
+// of the denom.  Without any transfer policies, every denom may be grabbed and
+// given without limit, and with them, unlisted denoms may not be transferred.
+func (p Params) GetTransferPolicy(denom string) DenomTransferPolicy {
+	policy := DenomTransferPolicy{
+		Denom:           denom,
+		AllowGrab:       len(p.TransferPolicies) == 0,
+		AllowGive:       len(p.TransferPolicies) == 0,
+		GrabCapPerBlock: sdk.ZeroInt(),
+		GiveCapPerBlock: sdk.ZeroInt(),
+	}
+	for _, candidate := range p.TransferPolicies {
+		if candidate.Denom == denom {
+			policy.AllowGrab = candidate.AllowGrab
+			policy.AllowGive = candidate.AllowGive
+			policy.GrabCapPerBlock = candidate.GetCapPerBlock(TransferGrab)
+			policy.GiveCapPerBlock = candidate.GetCapPerBlock(TransferGive)
+			break
+		}
+	}
+	return policy
+}
+
+// Allows returns whether the policy permits the operation.
+func (p DenomTransferPolicy) Allows(operation string) bool {
+	switch operation {
+	case TransferGrab:
+		return p.AllowGrab
+	case TransferGive:
+		return p.AllowGive
+	}
+	return false
+}
+
+// GetCapPerBlock returns the most of the denom that the operation may transfer
+// for each address in a block, or zero if there is no cap.
+func (p DenomTransferPolicy) GetCapPerBlock(operation string) sdk.Int {
+	limit := p.GiveCapPerBlock
+	if operation == TransferGrab {
+		limit = p.GrabCapPerBlock
+	}
+	if limit.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return limit
+}
+
+func validateTransferPolicies(policies []DenomTransferPolicy) error {
+	seen := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if err := sdk.ValidateDenom(policy.Denom); err != nil {
+			return fmt.Errorf("invalid transfer policy denom %s: %s", policy.Denom, err)
+		}
+		if seen[policy.Denom] {
+			return fmt.Errorf("duplicate transfer policy for denom %s", policy.Denom)
+		}
+		seen[policy.Denom] = true
+		for _, operation := range []string{TransferGrab, TransferGive} {
+			if policy.GetCapPerBlock(operation).IsNegative() {
+				return fmt.Errorf("transfer policy %s cap for denom %s must be nonnegative", operation, policy.Denom)
+			}
+		}
+	}
+	return nil
+}
+
+// TransferDeniedError is the error of a VBANK_GRAB or VBANK_GIVE that the
+// transfer policies do not permit.
+type TransferDeniedError struct {
+	Operation string
+	Address   string
+	Amount    sdk.Coin
+	Reason    string
+}
+
+func (e *TransferDeniedError) Error() string {
+	return fmt.Sprintf("cannot %s %s for %s: %s", e.Operation, e.Amount, e.Address, e.Reason)
+}
+
+// Event returns the event reporting the denied transfer.
+func (e *TransferDeniedError) Event() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeTransferDenied,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyOperation, e.Operation),
+		sdk.NewAttribute(AttributeKeyAddress, e.Address),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, e.Amount.String()),
+		sdk.NewAttribute(AttributeKeyReason, e.Reason),
+	)
+}
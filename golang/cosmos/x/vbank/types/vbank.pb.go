@@ -39,6 +39,9 @@ type Params struct {
 	// an epoch's rewards.  If zero, use the same value as
 	// reward_epoch_duration_blocks.
 	RewardSmoothingBlocks int64 `protobuf:"varint,3,opt,name=reward_smoothing_blocks,json=rewardSmoothingBlocks,proto3" json:"reward_smoothing_blocks,omitempty" yaml:"reward_smoothing_blocks"`
+	// transfer_policies restricts VBANK_GRAB and VBANK_GIVE to the listed
+	// denoms.  If empty, any denom may be grabbed or given without limit.
+	TransferPolicies []DenomTransferPolicy `protobuf:"bytes,4,rep,name=transfer_policies,json=transferPolicies,proto3" json:"transfer_policies" yaml:"transfer_policies"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -87,6 +90,82 @@ func (m *Params) GetRewardSmoothingBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetTransferPolicies() []DenomTransferPolicy {
+	if m != nil {
+		return m.TransferPolicies
+	}
+	return nil
+}
+
+// DenomTransferPolicy is the policy for VBANK_GRAB and VBANK_GIVE of a denom.
+type DenomTransferPolicy struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// allow_grab is whether the denom may be grabbed from accounts.
+	AllowGrab bool `protobuf:"varint,2,opt,name=allow_grab,json=allowGrab,proto3" json:"allow_grab,omitempty" yaml:"allow_grab"`
+	// allow_give is whether the denom may be given to accounts.
+	AllowGive bool `protobuf:"varint,3,opt,name=allow_give,json=allowGive,proto3" json:"allow_give,omitempty" yaml:"allow_give"`
+	// grab_cap_per_block is the most that may be grabbed from each address in
+	// a block.  If zero, there is no cap.
+	GrabCapPerBlock github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=grab_cap_per_block,json=grabCapPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"grab_cap_per_block" yaml:"grab_cap_per_block"`
+	// give_cap_per_block is the most that may be given to each address in a
+	// block.  If zero, there is no cap.
+	GiveCapPerBlock github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=give_cap_per_block,json=giveCapPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"give_cap_per_block" yaml:"give_cap_per_block"`
+}
+
+func (m *DenomTransferPolicy) Reset()         { *m = DenomTransferPolicy{} }
+func (m *DenomTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferPolicy) ProtoMessage()    {}
+func (*DenomTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{1}
+}
+func (m *DenomTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferPolicy.Merge(m, src)
+}
+func (m *DenomTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTransferPolicy proto.InternalMessageInfo
+
+func (m *DenomTransferPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTransferPolicy) GetAllowGrab() bool {
+	if m != nil {
+		return m.AllowGrab
+	}
+	return false
+}
+
+func (m *DenomTransferPolicy) GetAllowGive() bool {
+	if m != nil {
+		return m.AllowGive
+	}
+	return false
+}
+
 // The current state of the module.
 type State struct {
 	// rewardPool is the current balance of rewards in the module account.
@@ -105,7 +184,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e89b3b9e5e671b4, []int{1}
+	return fileDescriptor_5e89b3b9e5e671b4, []int{2}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,48 +243,60 @@ func (m *State) GetLastRewardDistributionBlock() int64 {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "agoric.vbank.Params")
+	proto.RegisterType((*DenomTransferPolicy)(nil), "agoric.vbank.DenomTransferPolicy")
 	proto.RegisterType((*State)(nil), "agoric.vbank.State")
 }
 
 func init() { proto.RegisterFile("agoric/vbank/vbank.proto", fileDescriptor_5e89b3b9e5e671b4) }
 
 var fileDescriptor_5e89b3b9e5e671b4 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x49, 0xa8, 0xe0, 0x5a, 0x06, 0x4c, 0x01, 0x27, 0x20, 0x3b, 0x32, 0x02, 0xc2,
-	0x80, 0xad, 0xc2, 0x82, 0x22, 0x31, 0xd4, 0x84, 0x8e, 0xa8, 0x72, 0x06, 0xa4, 0x2e, 0xd1, 0xd9,
-	0x39, 0x1c, 0x2b, 0xb6, 0x9f, 0xb9, 0xbb, 0x14, 0xba, 0xf2, 0x09, 0x10, 0x13, 0x6c, 0x9d, 0xf9,
-	0x24, 0x1d, 0x3b, 0x22, 0x24, 0x0c, 0x4a, 0x16, 0x16, 0x96, 0x7c, 0x02, 0xe4, 0xbb, 0x8b, 0x9a,
-	0x20, 0x54, 0x60, 0x49, 0x7c, 0xfe, 0xbd, 0xf7, 0xfc, 0x7f, 0xff, 0x77, 0x0f, 0x9b, 0x24, 0x06,
-	0x96, 0x44, 0xde, 0x61, 0x48, 0xf2, 0x89, 0xfa, 0x75, 0x0b, 0x06, 0x02, 0x8c, 0x2d, 0x45, 0x5c,
-	0xf9, 0xae, 0xbd, 0x1d, 0x43, 0x0c, 0x12, 0x78, 0xd5, 0x93, 0x8a, 0x69, 0x5b, 0x11, 0xf0, 0x0c,
-	0xb8, 0x17, 0x12, 0x4e, 0xbd, 0xc3, 0x9d, 0x90, 0x0a, 0xb2, 0xe3, 0x45, 0x90, 0xe4, 0x8a, 0x3b,
-	0x3f, 0xeb, 0x78, 0x63, 0x9f, 0x30, 0x92, 0x71, 0x63, 0x8c, 0x6f, 0x31, 0xfa, 0x9a, 0xb0, 0xd1,
-	0x90, 0x16, 0x10, 0x8d, 0x87, 0xa3, 0x29, 0x23, 0x22, 0x81, 0x7c, 0x18, 0xa6, 0x10, 0x4d, 0xb8,
-	0x89, 0x3a, 0xa8, 0xdb, 0xf0, 0xef, 0x2d, 0x4a, 0xfb, 0xf6, 0x11, 0xc9, 0xd2, 0x9e, 0x73, 0x5e,
-	0xb4, 0x13, 0xb4, 0x14, 0x7e, 0x56, 0xd1, 0xbe, 0x86, 0xbe, 0x64, 0xc6, 0x7b, 0x84, 0x5b, 0x05,
-	0x65, 0x3a, 0x53, 0x97, 0x79, 0xc9, 0x48, 0x54, 0xc5, 0x98, 0xf5, 0x0e, 0xea, 0x5e, 0xf2, 0x5f,
-	0x9c, 0x94, 0x76, 0xed, 0x4b, 0x69, 0xdf, 0x8d, 0x13, 0x31, 0x9e, 0x86, 0x6e, 0x04, 0x99, 0xa7,
-	0x7b, 0x51, 0x7f, 0x0f, 0xf8, 0x68, 0xe2, 0x89, 0xa3, 0x82, 0x72, 0xb7, 0x4f, 0xa3, 0x45, 0x69,
-	0xdf, 0x51, 0xaa, 0x46, 0x09, 0x8f, 0x18, 0x15, 0xf4, 0xcf, 0xd5, 0x9d, 0xe0, 0x7a, 0x41, 0x99,
-	0x14, 0x15, 0x48, 0xb2, 0xa7, 0x81, 0x71, 0x80, 0x6f, 0xe8, 0x58, 0x9e, 0x01, 0x88, 0x71, 0x92,
-	0xc7, 0xcb, 0xce, 0x1b, 0xb2, 0x73, 0x67, 0x51, 0xda, 0xd6, 0x5a, 0xe7, 0xbf, 0x07, 0x3a, 0xc1,
-	0x35, 0x45, 0x06, 0x4b, 0xa0, 0x1a, 0xee, 0x5d, 0xfc, 0x70, 0x6c, 0xd7, 0x7e, 0x1c, 0xdb, 0xc8,
-	0xf9, 0xda, 0xc0, 0x17, 0x06, 0x82, 0x08, 0x6a, 0xbc, 0x45, 0x78, 0x53, 0xd7, 0x29, 0x00, 0x52,
-	0x13, 0x75, 0x1a, 0xdd, 0xcd, 0x87, 0x2d, 0x57, 0x75, 0xe7, 0x56, 0x03, 0x73, 0xf5, 0xc0, 0xdc,
-	0xa7, 0x90, 0xe4, 0xfe, 0x5e, 0xe5, 0xc8, 0xa2, 0xb4, 0x8d, 0x35, 0x0d, 0x55, 0xae, 0xf3, 0xe9,
-	0x9b, 0xdd, 0xfd, 0x07, 0x9f, 0xaa, 0x32, 0x3c, 0xc0, 0x2a, 0x73, 0x1f, 0x20, 0x35, 0x3e, 0x22,
-	0x7c, 0x55, 0x17, 0x92, 0x2d, 0x0c, 0x49, 0x06, 0xd3, 0x5c, 0x98, 0xf5, 0xbf, 0x89, 0x79, 0xae,
-	0xc5, 0xb4, 0xd7, 0xc4, 0xac, 0xd6, 0xf8, 0x3f, 0x51, 0x57, 0x54, 0x05, 0xe9, 0xd7, 0xae, 0xcc,
-	0x37, 0x9e, 0xe0, 0xcb, 0x29, 0xe1, 0x62, 0xc8, 0xe9, 0xab, 0x29, 0xcd, 0x23, 0x2a, 0xc7, 0xd0,
-	0xf4, 0xcd, 0x45, 0x69, 0x6f, 0xab, 0xaf, 0xae, 0x61, 0x27, 0xd8, 0xaa, 0xce, 0x03, 0x7d, 0x34,
-	0x72, 0x6c, 0x49, 0xae, 0xa5, 0x8d, 0x12, 0x2e, 0x58, 0x12, 0x4e, 0xcf, 0xee, 0xa8, 0xd9, 0x94,
-	0x63, 0xbd, 0x7f, 0x76, 0x75, 0xce, 0x8f, 0x77, 0x82, 0x9b, 0x55, 0x80, 0xba, 0x36, 0xfd, 0x15,
-	0x2c, 0x45, 0xf7, 0x9a, 0xd5, 0x7c, 0xfd, 0xe0, 0x64, 0x66, 0xa1, 0xd3, 0x99, 0x85, 0xbe, 0xcf,
-	0x2c, 0xf4, 0x6e, 0x6e, 0xd5, 0x4e, 0xe7, 0x56, 0xed, 0xf3, 0xdc, 0xaa, 0x1d, 0x3c, 0x5e, 0xf1,
-	0x62, 0x57, 0xad, 0xb4, 0xda, 0x5f, 0xe9, 0x45, 0x0c, 0x29, 0xc9, 0xe3, 0xa5, 0x49, 0x6f, 0xf4,
-	0xb6, 0x4b, 0x87, 0xc2, 0x0d, 0xb9, 0xaa, 0x8f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x95,
-	0x56, 0xb1, 0x0a, 0x04, 0x00, 0x00,
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x4f, 0xd4, 0x4c,
+	0x18, 0xdf, 0xd2, 0x85, 0xc0, 0xc0, 0x9b, 0xf7, 0xa5, 0xc0, 0xfb, 0x76, 0x79, 0x4d, 0xbb, 0xd6,
+	0xa8, 0xeb, 0xc1, 0x6e, 0x50, 0x0f, 0x86, 0xc4, 0x03, 0x65, 0xc5, 0x18, 0x13, 0xb3, 0x29, 0x26,
+	0x26, 0x5c, 0x9a, 0x69, 0x77, 0xe8, 0x4e, 0xe8, 0x76, 0xea, 0x4c, 0x77, 0x81, 0xab, 0x9f, 0x40,
+	0x3d, 0xe9, 0x8d, 0xb3, 0x9f, 0x84, 0x23, 0x47, 0x63, 0x62, 0x31, 0x70, 0xf1, 0xbc, 0x9f, 0xc0,
+	0xcc, 0x1f, 0xa4, 0x0b, 0x88, 0x12, 0x2f, 0xbb, 0x9d, 0xf9, 0x3d, 0xcf, 0xef, 0xf7, 0xfc, 0x9b,
+	0x07, 0x98, 0x30, 0x26, 0x14, 0x47, 0xcd, 0x41, 0x08, 0xd3, 0x2d, 0xf9, 0xeb, 0x66, 0x94, 0xe4,
+	0xc4, 0x98, 0x91, 0x88, 0x2b, 0xee, 0x16, 0xe7, 0x63, 0x12, 0x13, 0x01, 0x34, 0xf9, 0x97, 0xb4,
+	0x59, 0xb4, 0x22, 0xc2, 0x7a, 0x84, 0x35, 0x43, 0xc8, 0x50, 0x73, 0xb0, 0x14, 0xa2, 0x1c, 0x2e,
+	0x35, 0x23, 0x82, 0x53, 0x89, 0x3b, 0x87, 0x3a, 0x98, 0x68, 0x43, 0x0a, 0x7b, 0xcc, 0xe8, 0x82,
+	0x6b, 0x14, 0x6d, 0x43, 0xda, 0x09, 0x50, 0x46, 0xa2, 0x6e, 0xd0, 0xe9, 0x53, 0x98, 0x63, 0x92,
+	0x06, 0x61, 0x42, 0xa2, 0x2d, 0x66, 0x6a, 0x75, 0xad, 0xa1, 0x7b, 0xb7, 0x87, 0x85, 0x7d, 0x63,
+	0x17, 0xf6, 0x92, 0x65, 0xe7, 0x32, 0x6b, 0xc7, 0xaf, 0x49, 0xf8, 0x31, 0x47, 0x5b, 0x0a, 0xf4,
+	0x04, 0x66, 0xbc, 0xd3, 0x40, 0x2d, 0x43, 0x54, 0x79, 0x2a, 0x9a, 0x4d, 0x0a, 0x23, 0x6e, 0x63,
+	0x8e, 0xd5, 0xb5, 0xc6, 0x94, 0xf7, 0x72, 0xbf, 0xb0, 0x2b, 0x9f, 0x0b, 0xfb, 0x56, 0x8c, 0xf3,
+	0x6e, 0x3f, 0x74, 0x23, 0xd2, 0x6b, 0xaa, 0x5c, 0xe4, 0xdf, 0x5d, 0xd6, 0xd9, 0x6a, 0xe6, 0xbb,
+	0x19, 0x62, 0x6e, 0x0b, 0x45, 0xc3, 0xc2, 0xbe, 0x29, 0xa3, 0xea, 0x60, 0x16, 0x51, 0x94, 0xa3,
+	0x8b, 0xd9, 0x1d, 0xff, 0xdf, 0x0c, 0x51, 0x11, 0x94, 0x2f, 0x90, 0x35, 0x05, 0x18, 0x1b, 0xe0,
+	0x3f, 0x65, 0xcb, 0x7a, 0x84, 0xe4, 0x5d, 0x9c, 0xc6, 0x27, 0x99, 0xeb, 0x22, 0x73, 0x67, 0x58,
+	0xd8, 0xd6, 0x48, 0xe6, 0x67, 0x0d, 0x1d, 0x7f, 0x41, 0x22, 0xeb, 0x27, 0x80, 0x4a, 0x38, 0x03,
+	0xb3, 0x39, 0x85, 0x29, 0xdb, 0x44, 0x34, 0xc8, 0x48, 0x82, 0x23, 0x8c, 0x98, 0x59, 0xad, 0xeb,
+	0x8d, 0xe9, 0x7b, 0xd7, 0xdd, 0x72, 0x17, 0xdd, 0x16, 0x4a, 0x49, 0xef, 0x85, 0xb2, 0x6d, 0x73,
+	0xd3, 0x5d, 0xaf, 0xce, 0x4b, 0x31, 0x2c, 0x6c, 0x53, 0x8a, 0x9f, 0x63, 0x72, 0xfc, 0x7f, 0xf2,
+	0xb2, 0x07, 0x46, 0x6c, 0x79, 0xf2, 0xfd, 0x9e, 0x5d, 0xf9, 0xb6, 0x67, 0x6b, 0xce, 0x5b, 0x1d,
+	0xcc, 0x5d, 0xc0, 0x6a, 0xcc, 0x83, 0xf1, 0x0e, 0xbf, 0x16, 0x7d, 0x9d, 0xf2, 0xe5, 0xc1, 0x78,
+	0x00, 0x00, 0x4c, 0x12, 0xb2, 0x1d, 0xc4, 0x14, 0x86, 0xa2, 0x15, 0x93, 0xde, 0xc2, 0xb0, 0xb0,
+	0x67, 0xa5, 0xf6, 0x29, 0xe6, 0xf8, 0x53, 0xe2, 0xf0, 0x84, 0xc2, 0xb0, 0xe4, 0x85, 0x07, 0xc8,
+	0xd4, 0x7f, 0xe2, 0x85, 0x07, 0xe8, 0x87, 0x17, 0x1e, 0x20, 0x63, 0x07, 0x18, 0x9c, 0x29, 0x88,
+	0x60, 0x16, 0xf0, 0x71, 0x10, 0x45, 0x34, 0xab, 0xa2, 0xfd, 0xcf, 0xae, 0xd0, 0xfe, 0xa7, 0x69,
+	0x3e, 0x2c, 0xec, 0x9a, 0xd4, 0x3a, 0xcf, 0xe8, 0xf8, 0x7f, 0xf3, 0xcb, 0x55, 0x98, 0xb5, 0x11,
+	0x15, 0x0d, 0x11, 0xca, 0x78, 0x80, 0xce, 0x28, 0x8f, 0xff, 0xa1, 0xf2, 0x39, 0x46, 0xae, 0x8c,
+	0x07, 0xa8, 0xa4, 0xbc, 0x5c, 0x15, 0x3d, 0xf9, 0xa2, 0x83, 0xf1, 0xf5, 0x1c, 0xe6, 0xc8, 0x78,
+	0xad, 0x81, 0x69, 0x35, 0x4d, 0x19, 0x21, 0x89, 0xa9, 0x89, 0xa1, 0xa8, 0xb9, 0x52, 0xca, 0xe5,
+	0xcf, 0xd6, 0x55, 0xcf, 0xd6, 0x5d, 0x25, 0x38, 0xf5, 0xd6, 0xd4, 0x30, 0x18, 0x23, 0x93, 0xc8,
+	0x7d, 0x9d, 0x8f, 0x87, 0x76, 0xe3, 0x37, 0x82, 0xe6, 0x34, 0xcc, 0x07, 0xd2, 0xb3, 0x4d, 0x48,
+	0x62, 0x7c, 0xd0, 0xc0, 0x9c, 0x22, 0x12, 0x71, 0x07, 0xb0, 0x47, 0xfa, 0x69, 0x6e, 0x8e, 0xfd,
+	0x2a, 0x98, 0xe7, 0x2a, 0x98, 0xc5, 0x91, 0x60, 0xca, 0x1c, 0x57, 0x0b, 0x6a, 0x56, 0x32, 0x88,
+	0x52, 0xad, 0x08, 0x7f, 0xe3, 0x11, 0xf8, 0x2b, 0x81, 0x2c, 0x0f, 0x18, 0x7a, 0xd5, 0x47, 0x69,
+	0x24, 0xa7, 0xab, 0xea, 0x99, 0xc3, 0xc2, 0x9e, 0x97, 0xaa, 0x23, 0xb0, 0xe3, 0xcf, 0xf0, 0xf3,
+	0xba, 0x3a, 0x1a, 0x29, 0xb0, 0x04, 0xae, 0x42, 0xeb, 0x60, 0x96, 0x53, 0x1c, 0xf6, 0x4f, 0x37,
+	0x95, 0x98, 0x37, 0xdd, 0xbb, 0x73, 0xba, 0x40, 0x2e, 0xb7, 0x77, 0xfc, 0xff, 0xb9, 0x81, 0x5c,
+	0x1e, 0xad, 0x12, 0x5c, 0xea, 0xaf, 0xe7, 0xef, 0x1f, 0x59, 0xda, 0xc1, 0x91, 0xa5, 0x7d, 0x3d,
+	0xb2, 0xb4, 0x37, 0xc7, 0x56, 0xe5, 0xe0, 0xd8, 0xaa, 0x7c, 0x3a, 0xb6, 0x2a, 0x1b, 0x0f, 0x4b,
+	0xb5, 0x58, 0x91, 0x8b, 0x5d, 0xbe, 0x7f, 0x51, 0x8b, 0x98, 0x24, 0x30, 0x8d, 0x4f, 0x8a, 0xb4,
+	0xa3, 0x76, 0xbe, 0xa8, 0x50, 0x38, 0x21, 0x16, 0xf6, 0xfd, 0xef, 0x03, 0x00, 0x98, 0x9f, 0x66,
+	0xa3, 0x10, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -236,6 +327,50 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.RewardSmoothingBlocks != that1.RewardSmoothingBlocks {
 		return false
 	}
+	if len(this.TransferPolicies) != len(that1.TransferPolicies) {
+		return false
+	}
+	for i := range this.TransferPolicies {
+		if !this.TransferPolicies[i].Equal(&that1.TransferPolicies[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomTransferPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTransferPolicy)
+	if !ok {
+		that2, ok := that.(DenomTransferPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.AllowGrab != that1.AllowGrab {
+		return false
+	}
+	if this.AllowGive != that1.AllowGive {
+		return false
+	}
+	if !this.GrabCapPerBlock.Equal(that1.GrabCapPerBlock) {
+		return false
+	}
+	if !this.GiveCapPerBlock.Equal(that1.GiveCapPerBlock) {
+		return false
+	}
 	return true
 }
 func (this *State) Equal(that interface{}) bool {
@@ -301,6 +436,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferPolicies) > 0 {
+		for iNdEx := len(m.TransferPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.RewardSmoothingBlocks != 0 {
 		i = encodeVarintVbank(dAtA, i, uint64(m.RewardSmoothingBlocks))
 		i--
@@ -324,6 +473,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GiveCapPerBlock.Size()
+		i -= size
+		if _, err := m.GiveCapPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.GrabCapPerBlock.Size()
+		i -= size
+		if _, err := m.GrabCapPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AllowGive {
+		i--
+		if m.AllowGive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AllowGrab {
+		i--
+		if m.AllowGrab {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *State) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -410,6 +629,35 @@ func (m *Params) Size() (n int) {
 	if m.RewardSmoothingBlocks != 0 {
 		n += 1 + sovVbank(uint64(m.RewardSmoothingBlocks))
 	}
+	if len(m.TransferPolicies) > 0 {
+		for _, e := range m.TransferPolicies {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if m.AllowGrab {
+		n += 2
+	}
+	if m.AllowGive {
+		n += 2
+	}
+	l = m.GrabCapPerBlock.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	l = m.GiveCapPerBlock.Size()
+	n += 1 + l + sovVbank(uint64(l))
 	return n
 }
 
@@ -547,6 +795,230 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPolicies = append(m.TransferPolicies, DenomTransferPolicy{})
+			if err := m.TransferPolicies[len(m.TransferPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTransferPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGrab", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGrab = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrabCapPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GrabCapPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GiveCapPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GiveCapPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVbank(dAtA[iNdEx:])
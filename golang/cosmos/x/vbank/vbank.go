@@ -3,11 +3,13 @@ package vbank
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	stdlog "log"
 	"sort"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +27,8 @@ type portMessage struct { // comes from swingset's vat-bank
 	ModuleName string `json:"moduleName"`
 	Denom      string `json:"denom"`
 	Amount     string `json:"amount"`
+	// Operations are the VBANK_GRAB and VBANK_GIVE messages of a VBANK_BATCH.
+	Operations []portMessage `json:"operations"`
 }
 
 func NewPortHandler(am AppModule, keeper Keeper) portHandler {
@@ -115,6 +119,67 @@ func getBalanceUpdate(ctx sdk.Context, keeper Keeper, addressToUpdate map[string
 	return vm.PopulateAction(ctx, event)
 }
 
+// applyTransfer applies a VBANK_GRAB or VBANK_GIVE message, returning the
+// address whose balance it changed.
+func applyTransfer(ctx sdk.Context, keeper Keeper, msg portMessage) (string, error) {
+	address := msg.Sender
+	if msg.Type == "VBANK_GIVE" {
+		address = msg.Recipient
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return "", fmt.Errorf("cannot convert %s to address: %s", address, err)
+	}
+	if err = sdk.ValidateDenom(msg.Denom); err != nil {
+		return "", fmt.Errorf("invalid denom %s: %s", msg.Denom, err)
+	}
+	value, ok := sdk.NewIntFromString(msg.Amount)
+	if !ok {
+		return "", fmt.Errorf("cannot convert %s to int", msg.Amount)
+	}
+	coin := sdk.NewCoin(msg.Denom, value)
+	coins := sdk.NewCoins(coin)
+	operation := types.TransferGrab
+	if msg.Type == "VBANK_GIVE" {
+		operation = types.TransferGive
+	}
+	if err := keeper.CheckTransfer(ctx, operation, addr, coin); err != nil {
+		return "", err
+	}
+	if msg.Type == "VBANK_GIVE" {
+		if err := keeper.SendCoins(ctx, addr, coins); err != nil {
+			return "", fmt.Errorf("cannot give %s coins: %s", coins.Sort().String(), err)
+		}
+	} else {
+		if err := keeper.GrabCoins(ctx, addr, coins); err != nil {
+			return "", fmt.Errorf("cannot grab %s coins: %s", coins.Sort().String(), err)
+		}
+	}
+	keeper.RecordTransfer(ctx, operation, addr, coin)
+	return address, nil
+}
+
+// emitTransferDenied emits an event if err is due to the transfer policies.
+func emitTransferDenied(ctx sdk.Context, err error) {
+	var denied *types.TransferDeniedError
+	if errors.As(err, &denied) {
+		ctx.EventManager().EmitEvent(denied.Event())
+	}
+}
+
+// balanceUpdateReply returns the reply to a downcall that changed the given
+// balances, which is their VBANK_BALANCE_UPDATE, or true if there are none.
+func balanceUpdateReply(ctx sdk.Context, keeper Keeper, addressToBalances map[string]sdk.Coins) (string, error) {
+	bz, err := marshal(getBalanceUpdate(ctx, keeper, addressToBalances))
+	if err != nil {
+		return "", err
+	}
+	if bz == nil {
+		return "true", nil
+	}
+	return string(bz), nil
+}
+
 func marshal(event vm.Jsonable) ([]byte, error) {
 	if event == nil {
 		return nil, nil
@@ -151,61 +216,45 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 			}
 		}
 
-	case "VBANK_GRAB":
-		addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	case "VBANK_GRAB", "VBANK_GIVE":
+		address, err := applyTransfer(ctx, keeper, msg)
 		if err != nil {
-			return "", fmt.Errorf("cannot convert %s to address: %s", msg.Sender, err)
-		}
-		if err = sdk.ValidateDenom(msg.Denom); err != nil {
-			return "", fmt.Errorf("invalid denom %s: %s", msg.Denom, err)
-		}
-		value, ok := sdk.NewIntFromString(msg.Amount)
-		if !ok {
-			return "", fmt.Errorf("cannot convert %s to int", msg.Amount)
-		}
-		coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, value))
-		if err := keeper.GrabCoins(ctx, addr, coins); err != nil {
-			return "", fmt.Errorf("cannot grab %s coins: %s", coins.Sort().String(), err)
+			emitTransferDenied(ctx, err)
+			return "", err
 		}
 		addressToBalances := make(map[string]sdk.Coins, 1)
-		addressToBalances[msg.Sender] = sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, 1))
-		bz, err := marshal(getBalanceUpdate(ctx, keeper, addressToBalances))
+		addressToBalances[address] = sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, 1))
+		ret, err = balanceUpdateReply(ctx, keeper, addressToBalances)
 		if err != nil {
 			return "", err
 		}
-		if bz == nil {
-			ret = "true"
-		} else {
-			ret = string(bz)
-		}
 
-	case "VBANK_GIVE":
-		addr, err := sdk.AccAddressFromBech32(msg.Recipient)
-		if err != nil {
-			return "", fmt.Errorf("cannot convert %s to address: %s", msg.Recipient, err)
-		}
-		if err = sdk.ValidateDenom(msg.Denom); err != nil {
-			return "", fmt.Errorf("invalid denom %s: %s", msg.Denom, err)
-		}
-		value, ok := sdk.NewIntFromString(msg.Amount)
-		if !ok {
-			return "", fmt.Errorf("cannot convert %s to int", msg.Amount)
+	case "VBANK_BATCH":
+		if len(msg.Operations) == 0 {
+			return "", fmt.Errorf("empty %s", msg.Type)
 		}
-		coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, value))
-		if err := keeper.SendCoins(ctx, addr, coins); err != nil {
-			return "", fmt.Errorf("cannot give %s coins: %s", coins.Sort().String(), err)
+		// Apply the operations in order to a branch of the state, which is only
+		// written if they all succeed.
+		cacheCtx, write := ctx.CacheContext()
+		addressToBalances := make(map[string]sdk.Coins, len(msg.Operations))
+		for i, op := range msg.Operations {
+			if op.Type != "VBANK_GRAB" && op.Type != "VBANK_GIVE" {
+				return "", fmt.Errorf("%s operation %d: unsupported type %s", msg.Type, i, op.Type)
+			}
+			address, err := applyTransfer(cacheCtx, keeper, op)
+			if err != nil {
+				// The branch is discarded, so report the denial in the outer context.
+				emitTransferDenied(ctx, err)
+				return "", fmt.Errorf("%s operation %d: %w", msg.Type, i, err)
+			}
+			addressToBalances[address] = addressToBalances[address].Add(sdk.NewInt64Coin(op.Denom, 1))
 		}
-		addressToBalances := make(map[string]sdk.Coins, 1)
-		addressToBalances[msg.Recipient] = sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, 1))
-		bz, err := marshal(getBalanceUpdate(ctx, keeper, addressToBalances))
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ret, err = balanceUpdateReply(ctx, keeper, addressToBalances)
 		if err != nil {
 			return "", err
 		}
-		if bz == nil {
-			ret = "true"
-		} else {
-			ret = string(bz)
-		}
 
 	case "VBANK_GIVE_TO_REWARD_DISTRIBUTOR":
 		value, ok := sdk.NewIntFromString(msg.Amount)
@@ -225,6 +274,22 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		// We don't supply the module balance, since the controller shouldn't know.
 		ret = "true"
 
+	case "VBANK_WATCH_ADDRESS":
+		addr, err := sdk.AccAddressFromBech32(msg.Address)
+		if err != nil {
+			return "", fmt.Errorf("cannot convert %s to address: %s", msg.Address, err)
+		}
+		keeper.WatchAddress(ctx, addr)
+		ret = "true"
+
+	case "VBANK_UNWATCH_ADDRESS":
+		addr, err := sdk.AccAddressFromBech32(msg.Address)
+		if err != nil {
+			return "", fmt.Errorf("cannot convert %s to address: %s", msg.Address, err)
+		}
+		keeper.UnwatchAddress(ctx, addr)
+		ret = "true"
+
 	case "VBANK_GET_MODULE_ACCOUNT_ADDRESS":
 		addr := keeper.GetModuleAccountAddress(ctx, msg.ModuleName).String()
 		if len(addr) == 0 {
@@ -5,15 +5,18 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/keeper"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -24,15 +27,17 @@ import (
 )
 
 var (
-	vbankStoreKey = storetypes.NewKVStoreKey(StoreKey)
-	priv1         = secp256k1.GenPrivKey()
-	priv2         = secp256k1.GenPrivKey()
-	priv3         = secp256k1.GenPrivKey()
-	priv4         = secp256k1.GenPrivKey()
-	addr1         = sdk.AccAddress(priv1.PubKey().Address()).String()
-	addr2         = sdk.AccAddress(priv2.PubKey().Address()).String()
-	addr3         = sdk.AccAddress(priv3.PubKey().Address()).String()
-	addr4         = sdk.AccAddress(priv4.PubKey().Address()).String()
+	vbankStoreKey  = storetypes.NewKVStoreKey(StoreKey)
+	vbankTStoreKey = storetypes.NewTransientStoreKey(TStoreKey)
+	priv1          = secp256k1.GenPrivKey()
+	priv2          = secp256k1.GenPrivKey()
+	priv3          = secp256k1.GenPrivKey()
+	priv4          = secp256k1.GenPrivKey()
+	addr1          = sdk.AccAddress(priv1.PubKey().Address()).String()
+	addr2          = sdk.AccAddress(priv2.PubKey().Address()).String()
+	addr3          = sdk.AccAddress(priv3.PubKey().Address()).String()
+	addr4          = sdk.AccAddress(priv4.PubKey().Address()).String()
+	govAuthority   = authtypes.NewModuleAddress(govtypes.ModuleName).String()
 )
 
 // Normalized balance updates for order-insensitive comparisons.
@@ -248,6 +253,13 @@ func (b *mockBank) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, re
 
 // makeTestKit creates a minimal Keeper and Context for use in testing.
 func makeTestKit(account types.AccountKeeper, bank types.BankKeeper) (Keeper, sdk.Context) {
+	keeper, _, ctx := makeTestKitWithSubspace(account, bank)
+	return keeper, ctx
+}
+
+// makeTestKitWithSubspace is like makeTestKit, but also returns the legacy
+// params subspace.
+func makeTestKitWithSubspace(account types.AccountKeeper, bank types.BankKeeper) (Keeper, paramstypes.Subspace, sdk.Context) {
 	encodingConfig := params.MakeEncodingConfig()
 	cdc := encodingConfig.Marshaler
 	pushAction := func(ctx sdk.Context, action vm.Action) error {
@@ -259,11 +271,12 @@ func makeTestKit(account types.AccountKeeper, bank types.BankKeeper) (Keeper, sd
 	pk := paramskeeper.NewKeeper(cdc, encodingConfig.Amino, paramsStoreKey, paramsTStoreKey)
 
 	subspace := pk.Subspace(types.ModuleName)
-	keeper := NewKeeper(cdc, vbankStoreKey, subspace, account, bank, "feeCollectorName", pushAction)
+	keeper := NewKeeper(cdc, vbankStoreKey, vbankTStoreKey, subspace, account, bank, "feeCollectorName", pushAction, govAuthority)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(vbankStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(vbankTStoreKey, storetypes.StoreTypeTransient, db)
 	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
@@ -275,7 +288,7 @@ func makeTestKit(account types.AccountKeeper, bank types.BankKeeper) (Keeper, sd
 
 	keeper.SetParams(ctx, types.DefaultParams())
 	keeper.SetState(ctx, types.State{})
-	return keeper, ctx
+	return keeper, subspace, ctx
 }
 
 func Test_Receive_GetBalance(t *testing.T) {
@@ -345,6 +358,65 @@ func Test_Receive_Give(t *testing.T) {
 	}
 }
 
+func Test_Receive_Batch(t *testing.T) {
+	keeper, bank, ctx := makeTrackingTestKit()
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	ret, err := ch.Receive(ctlCtx, `{
+		"type": "VBANK_BATCH",
+		"operations": [
+			{"type": "VBANK_GIVE", "recipient": "`+addr1+`", "denom": "ubld", "amount": "100"},
+			{"type": "VBANK_GIVE", "recipient": "`+addr2+`", "denom": "urun", "amount": "50"},
+			{"type": "VBANK_GRAB", "sender": "`+addr1+`", "denom": "ubld", "amount": "30"}
+		]
+	}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	gotMsg, gotNonce, err := decodeBalances([]byte(ret))
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	wantMsg := newBalances(
+		account(addr1, coin("ubld", "70")),
+		account(addr2, coin("urun", "50")),
+	)
+	if !reflect.DeepEqual(gotMsg, wantMsg) {
+		t.Errorf("got balance update %v, want %v", gotMsg, wantMsg)
+	}
+	if gotNonce != 1 {
+		t.Errorf("got nonce %d, want one consolidated update", gotNonce)
+	}
+
+	// A failing operation leaves every balance unchanged.
+	_, err = ch.Receive(ctlCtx, `{
+		"type": "VBANK_BATCH",
+		"operations": [
+			{"type": "VBANK_GIVE", "recipient": "`+addr3+`", "denom": "ubld", "amount": "10"},
+			{"type": "VBANK_GRAB", "sender": "`+addr2+`", "denom": "urun", "amount": "1000"}
+		]
+	}`)
+	if err == nil || !strings.Contains(err.Error(), "operation 1: cannot grab 1000urun coins") {
+		t.Errorf("got error %v, want a failure of operation 1", err)
+	}
+	if balance := bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(addr3), "ubld"); !balance.IsZero() {
+		t.Errorf("got balance %s after failed batch, want none", balance)
+	}
+	if balance := bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(addr2), "urun"); balance.Amount.Int64() != 50 {
+		t.Errorf("got balance %s after failed batch, want 50urun", balance)
+	}
+
+	for _, batch := range []string{
+		`{"type": "VBANK_BATCH", "operations": []}`,
+		`{"type": "VBANK_BATCH", "operations": [{"type": "VBANK_GET_BALANCE", "address": "` + addr1 + `", "denom": "ubld"}]}`,
+	} {
+		if _, err := ch.Receive(ctlCtx, batch); err == nil {
+			t.Errorf("got no error for %s", batch)
+		}
+	}
+}
+
 func Test_Receive_GiveToRewardDistributor(t *testing.T) {
 	bank := &mockBank{}
 	keeper, ctx := makeTestKit(nil, bank)
@@ -536,41 +608,16 @@ func Test_EndBlock_Events(t *testing.T) {
 	}
 	am := NewAppModule(keeper)
 
-	events := []abci.Event{
-		{
-			Type: "coin_received",
-			Attributes: []abci.EventAttribute{
-				{Key: []byte("receiver"), Value: []byte(addr1)},
-				{Key: []byte("amount"), Value: []byte("500ubld,600urun,700ushmoo")},
-			},
-		},
-		{
-			Type: "coin_spent",
-			Attributes: []abci.EventAttribute{
-				{Key: []byte("spender"), Value: []byte(addr2)},
-				{Key: []byte("amount"), Value: []byte("500ubld,600urun,700ushmoo")},
-				{Key: []byte("other"), Value: []byte(addr3)},
-			},
-		},
-		{
-			Type: "something_else",
-			Attributes: []abci.EventAttribute{
-				{Key: []byte("receiver"), Value: []byte(addr4)},
-				{Key: []byte("spender"), Value: []byte(addr4)},
-				{Key: []byte("amount"), Value: []byte("500ubld,600urun,700ushmoo")},
-			},
-		},
-		{
-			Type: "non_modaccount",
-			Attributes: []abci.EventAttribute{
-				{Key: []byte("receiver"), Value: []byte(addr3)},
-				{Key: []byte("spender"), Value: []byte(addr4)},
-				{Key: []byte("amount"), Value: []byte("100ubld")},
-			},
-		},
-	}
-	em := sdk.NewEventManagerWithHistory(events)
-	ctx = ctx.WithEventManager(em)
+	// The transfers of the block, as recorded by the TrackingBankKeeper.
+	transferred := sdk.NewCoins(
+		sdk.NewInt64Coin("ubld", 500),
+		sdk.NewInt64Coin("urun", 600),
+		sdk.NewInt64Coin("ushmoo", 700),
+	)
+	keeper.RecordTouchedBalances(ctx, sdk.MustAccAddressFromBech32(addr1), transferred)
+	keeper.RecordTouchedBalances(ctx, sdk.MustAccAddressFromBech32(addr2), transferred)
+	// Not a module account.
+	keeper.RecordTouchedBalances(ctx, sdk.MustAccAddressFromBech32(addr3), sdk.NewCoins(sdk.NewInt64Coin("ubld", 100)))
 
 	updates := am.EndBlock(ctx, abci.RequestEndBlock{})
 	if len(updates) != 0 {
@@ -618,6 +665,89 @@ func Test_EndBlock_Events(t *testing.T) {
 	}
 }
 
+func Test_Receive_WatchAddress(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr3: sdk.NewCoins(sdk.NewInt64Coin("ubld", 300)),
+	}}
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{
+			addr3: &authtypes.BaseAccount{Address: addr3},
+		},
+	}
+	keeper, ctx := makeTestKit(acct, bank)
+	// Turn off rewards.
+	keeper.SetParams(ctx, types.Params{PerEpochRewardFraction: sdk.ZeroDec()})
+	msgsSent := []string{}
+	keeper.PushAction = func(ctx sdk.Context, action vm.Action) error {
+		bz, err := json.Marshal(action)
+		if err != nil {
+			return err
+		}
+		msgsSent = append(msgsSent, string(bz))
+		return nil
+	}
+	am := NewAppModule(keeper)
+	ch := NewPortHandler(am, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	endBlock := func() {
+		keeper.RecordTouchedBalances(ctx, sdk.MustAccAddressFromBech32(addr3), sdk.NewCoins(sdk.NewInt64Coin("ubld", 100)))
+		am.EndBlock(ctx, abci.RequestEndBlock{})
+	}
+
+	// An unwatched user account gets no updates.
+	endBlock()
+	if len(msgsSent) != 0 {
+		t.Errorf("got msgs = %v, want none", msgsSent)
+	}
+
+	for _, msgType := range []string{"VBANK_WATCH_ADDRESS", "VBANK_WATCH_ADDRESS"} {
+		ret, err := ch.Receive(ctlCtx, `{"type": "`+msgType+`", "address": "`+addr3+`"}`)
+		if err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		if ret != "true" {
+			t.Errorf("got %v, want true", ret)
+		}
+	}
+	res, err := keeper.WatchedAddresses(ctlCtx, &types.QueryWatchedAddressesRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !reflect.DeepEqual(res.Addresses, []string{addr3}) {
+		t.Errorf("got watched addresses %v, want [%s]", res.Addresses, addr3)
+	}
+
+	endBlock()
+	if len(msgsSent) != 1 {
+		t.Fatalf("got msgs = %v, want one message", msgsSent)
+	}
+	gotMsg, _, err := decodeBalances([]byte(msgsSent[0]))
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	wantMsg := newBalances(account(addr3, coin("ubld", "300")))
+	if !reflect.DeepEqual(gotMsg, wantMsg) {
+		t.Errorf("got sent message %v, want %v", gotMsg, wantMsg)
+	}
+
+	ret, err := ch.Receive(ctlCtx, `{"type": "VBANK_UNWATCH_ADDRESS", "address": "`+addr3+`"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if ret != "true" {
+		t.Errorf("got %v, want true", ret)
+	}
+	endBlock()
+	if len(msgsSent) != 1 {
+		t.Errorf("got msgs = %v, want no more after unwatching", msgsSent)
+	}
+
+	if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_WATCH_ADDRESS", "address": "nonsense"}`); err == nil {
+		t.Errorf("got no error watching an invalid address")
+	}
+}
+
 func Test_EndBlock_Rewards(t *testing.T) {
 	bank := &mockBank{
 		balances: map[string]sdk.Coins{
@@ -746,6 +876,75 @@ func Test_EndBlock_Rewards(t *testing.T) {
 	}
 }
 
+func Test_RewardSchedule(t *testing.T) {
+	tests := []struct {
+		name          string
+		fraction      sdk.Dec
+		wantExhausted bool
+	}{
+		{name: "half", fraction: sdk.NewDecWithPrec(5, 1), wantExhausted: false},
+		{name: "all", fraction: sdk.OneDec(), wantExhausted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keeper, ctx := makeTestKit(nil, &mockBank{})
+			keeper.SetParams(ctx, types.Params{
+				RewardEpochDurationBlocks: 10,
+				RewardSmoothingBlocks:     4,
+				PerEpochRewardFraction:    tt.fraction,
+			})
+			keeper.SetState(ctx, types.State{
+				RewardPool:                  sdk.NewCoins(sdk.NewInt64Coin("urun", 1000), sdk.NewInt64Coin("stickers", 3)),
+				LastRewardDistributionBlock: 2,
+			})
+			ctx = ctx.WithBlockHeight(5)
+
+			blocks := int64(100)
+			res, err := keeper.RewardSchedule(sdk.WrapSDKContext(ctx), &types.QueryRewardScheduleRequest{Blocks: blocks})
+			if err != nil {
+				t.Fatalf("got error = %v", err)
+			}
+			if res.EpochStartHeight != 2 || res.NextEpochHeight != 12 || res.BlocksUntilNextEpoch != 7 {
+				t.Errorf("got epoch %d..%d in %d blocks, want 2..12 in 7 blocks",
+					res.EpochStartHeight, res.NextEpochHeight, res.BlocksUntilNextEpoch)
+			}
+
+			// Run the state machine and compare each payout with the projection.
+			projected := map[int64]sdk.Coins{}
+			for _, payout := range res.Projection {
+				for h := payout.StartHeight; h <= payout.EndHeight; h++ {
+					projected[h] = payout.Amount
+				}
+			}
+			exhausted := int64(0)
+			for h := int64(6); h <= 5+blocks; h++ {
+				before := keeper.GetState(ctx).RewardPool
+				ctx = ctx.WithBlockHeight(h)
+				if err := keeper.DistributeRewards(ctx); err != nil {
+					t.Fatalf("got error = %v", err)
+				}
+				after := keeper.GetState(ctx).RewardPool
+				if got, want := before.Sub(after...), projected[h]; !got.IsEqual(want) {
+					t.Errorf("got payout %s at height %d, want %s", got, h, want)
+				}
+				if exhausted == 0 && !before.IsZero() && after.IsZero() {
+					exhausted = h
+				}
+			}
+			if (exhausted != 0) != tt.wantExhausted || res.PoolExhaustedHeight != exhausted {
+				t.Errorf("got pool exhausted at height %d, want %d", res.PoolExhaustedHeight, exhausted)
+			}
+		})
+	}
+
+	keeper, ctx := makeTestKit(nil, &mockBank{})
+	for _, blocks := range []int64{-1, types.MaxRewardScheduleBlocks + 1} {
+		if _, err := keeper.RewardSchedule(sdk.WrapSDKContext(ctx), &types.QueryRewardScheduleRequest{Blocks: blocks}); err == nil {
+			t.Errorf("got no error projecting %d blocks", blocks)
+		}
+	}
+}
+
 type mockAuthKeeper struct {
 	accounts map[string]authtypes.AccountI
 	modAddrs map[string]string
@@ -816,3 +1015,51 @@ func Test_Module_Account(t *testing.T) {
 		t.Errorf("got IsModuleAccount missingAddr = false, want true")
 	}
 }
+
+func Test_UpdateParams(t *testing.T) {
+	vbankKeeper, ctx := makeTestKit(nil, nil)
+	msgServer := keeper.NewMsgServerImpl(vbankKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	newParams := types.DefaultParams()
+	newParams.RewardEpochDurationBlocks = 17
+
+	_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(addr1, newParams))
+	if err == nil {
+		t.Fatalf("expected error for non-authority signer")
+	}
+	if got := vbankKeeper.GetParams(ctx); !got.Equal(types.DefaultParams()) {
+		t.Errorf("params changed by non-authority: got %v", got)
+	}
+
+	badParams := types.DefaultParams()
+	badParams.RewardSmoothingBlocks = -1
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(govAuthority, badParams))
+	if err == nil {
+		t.Fatalf("expected error for invalid params")
+	}
+
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(govAuthority, newParams))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if got := vbankKeeper.GetParams(ctx); !got.Equal(newParams) {
+		t.Errorf("got params %v, want %v", got, newParams)
+	}
+}
+
+func Test_MigrateParamsToStore(t *testing.T) {
+	vbankKeeper, subspace, ctx := makeTestKitWithSubspace(nil, nil)
+
+	legacyParams := types.DefaultParams()
+	legacyParams.RewardEpochDurationBlocks = 42
+	subspace.SetParamSet(ctx, &legacyParams)
+
+	err := keeper.NewMigrator(vbankKeeper).Migrate1to2(ctx)
+	if err != nil {
+		t.Fatalf("unexpected migration error %s", err)
+	}
+	if got := vbankKeeper.GetParams(ctx); !got.Equal(legacyParams) {
+		t.Errorf("got params %v, want %v", got, legacyParams)
+	}
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	vibcQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vibc module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vibcQueryCmd.AddCommand(
+		GetCmdQueryPorts(),
+		GetCmdQueryChannels(),
+		GetCmdQueryParams(),
+		GetCmdQuerySendUsage(),
+		GetCmdQueryPendingAcks(),
+	)
+
+	return vibcQueryCmd
+}
+
+// GetCmdQueryPorts implements the query ports command.
+func GetCmdQueryPorts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ports",
+		Args:  cobra.NoArgs,
+		Short: "Query the IBC ports bound by vibc",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Ports(cmd.Context(), &types.QueryPortsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ports")
+	return cmd
+}
+
+// GetCmdQueryChannels implements the query channels command.
+func GetCmdQueryChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channels [port-id]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the IBC channels on the ports bound by vibc",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.PortId = args[0]
+			}
+			res, err := queryClient.Channels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels")
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the vibc params, including the send limits of ports",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySendUsage implements the query send-usage command.
+func GetCmdQuerySendUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-usage [port-id]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the sending on the ports bound by vibc against their send limits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySendUsageRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.PortId = args[0]
+			}
+			res, err := queryClient.SendUsage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send-usage")
+	return cmd
+}
+
+// GetCmdQueryPendingAcks implements the query pending-acks command.
+func GetCmdQueryPendingAcks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-acks [port-id] [channel-id]",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Query the received packets whose acknowledgements the controller has yet to write",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingAcksRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.PortId = args[0]
+			}
+			if len(args) > 1 {
+				req.ChannelId = args[1]
+			}
+			res, err := queryClient.PendingAcks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-acks")
+	return cmd
+}
@@ -0,0 +1,28 @@
+package vibc
+
+import (
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func DefaultGenesisState() *types.GenesisState {
+	return &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
+}
+
+func ValidateGenesis(data *types.GenesisState) error {
+	return data.Params.ValidateBasic()
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, data.Params)
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries params of the vibc module.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Ports queries the IBC ports bound by vibc on behalf of the controller.
+func (k Keeper) Ports(c context.Context, req *types.QueryPortsRequest) (*types.QueryPortsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	portIDs, pageRes, err := k.PaginateBoundPorts(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPortsResponse{
+		PortIds:    portIDs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Channels queries the IBC channels on the ports bound by vibc, with their
+// number of packets in flight.
+func (k Keeper) Channels(c context.Context, req *types.QueryChannelsRequest) (*types.QueryChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.PortId != "" {
+		if err := host.PortIdentifierValidator(req.PortId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !k.IsBoundPort(ctx, req.PortId) {
+			return nil, status.Errorf(codes.NotFound, "port %s is not bound by vibc", req.PortId)
+		}
+	}
+
+	// The channels live in the IBC store, so filter them before paginating.
+	channels := []channeltypes.IdentifiedChannel{}
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if req.PortId != "" && channel.PortId != req.PortId {
+			return false
+		}
+		if k.IsBoundPort(ctx, channel.PortId) {
+			channels = append(channels, channel)
+		}
+		return false
+	})
+	start, end, pageRes, err := paginateOffsets(len(channels), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	infos := make([]types.ChannelInfo, 0, end-start)
+	for _, channel := range channels[start:end] {
+		commitments := k.channelKeeper.GetAllPacketCommitmentsAtChannel(ctx, channel.PortId, channel.ChannelId)
+		infos = append(infos, types.ChannelInfo{
+			Channel:         channel,
+			PacketsInFlight: uint64(len(commitments)),
+		})
+	}
+
+	return &types.QueryChannelsResponse{
+		Channels:   infos,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SendUsage queries the packets sent on the ports bound by vibc against their
+// send limits.
+func (k Keeper) SendUsage(c context.Context, req *types.QuerySendUsageRequest) (*types.QuerySendUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.PortId != "" {
+		if !k.IsBoundPort(ctx, req.PortId) {
+			return nil, status.Errorf(codes.NotFound, "port %s is not bound by vibc", req.PortId)
+		}
+		return &types.QuerySendUsageResponse{
+			Usage: []types.PortSendUsage{k.GetPortSendUsage(ctx, req.PortId)},
+		}, nil
+	}
+
+	portIDs, pageRes, err := k.PaginateBoundPorts(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	usage := make([]types.PortSendUsage, 0, len(portIDs))
+	for _, portID := range portIDs {
+		usage = append(usage, k.GetPortSendUsage(ctx, portID))
+	}
+
+	return &types.QuerySendUsageResponse{
+		Usage:      usage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PendingAcks queries the packets received on the ports bound by vibc whose
+// acknowledgements the controller has yet to write.
+func (k Keeper) PendingAcks(c context.Context, req *types.QueryPendingAcksRequest) (*types.QueryPendingAcksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ChannelId != "" && req.PortId == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel_id requires port_id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingAcks, pageRes, err := k.PaginatePendingAcknowledgements(ctx, req.PortId, req.ChannelId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPendingAcksResponse{
+		PendingAcks:            pendingAcks,
+		Height:                 ctx.BlockHeight(),
+		AsyncAckDeadlineBlocks: k.GetParams(ctx).AsyncAckDeadlineBlocks,
+		Pagination:             pageRes,
+	}, nil
+}
+
+// paginateOffsets returns the bounds of the requested page of a list of n
+// items, which cannot be resumed from a key.
+func paginateOffsets(n int, pageReq *query.PageRequest) (start, end int, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Key != nil {
+		return 0, 0, nil, errors.New("pagination by key is not supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start, end = n, n
+	if pageReq.Offset < uint64(n) {
+		start = int(pageReq.Offset)
+		if remaining := uint64(n - start); limit < remaining {
+			end = start + int(limit)
+		}
+	}
+
+	pageRes = &query.PageResponse{}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(n)
+	}
+	return start, end, pageRes, nil
+}
@@ -25,12 +25,17 @@ var (
 	_ types.ReceiverImpl    = Keeper{}
 )
 
+const paramsKey = "params"
+
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	cdc codec.Codec
 
-	channelKeeper types.ChannelKeeper
-	portKeeper    types.PortKeeper
+	channelKeeper    types.ChannelKeeper
+	portKeeper       types.PortKeeper
+	capabilityKeeper types.CapabilityKeeper
+	// authority is the address allowed to update the params, usually x/gov.
+	authority string
 
 	// Filled out by `WithScope`
 	scopedKeeper types.ScopedKeeper
@@ -43,12 +48,16 @@ func NewKeeper(
 	cdc codec.Codec,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
+	capabilityKeeper types.CapabilityKeeper,
+	authority string,
 ) Keeper {
 
 	return Keeper{
-		cdc:           cdc,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
+		cdc:              cdc,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		capabilityKeeper: capabilityKeeper,
+		authority:        authority,
 	}
 }
 
@@ -66,6 +75,28 @@ func (k Keeper) PushAction(ctx sdk.Context, action vm.Action) error {
 	return k.pushAction(ctx, action)
 }
 
+// GetAuthority returns the address allowed to update the params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the vibc params, which are the defaults until set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(paramsKey))
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the vibc params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	bz := k.cdc.MustMarshal(&params)
+	ctx.KVStore(k.storeKey).Set([]byte(paramsKey), bz)
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper interface for the keeper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k
@@ -109,7 +140,8 @@ func (k Keeper) ReceiveChanOpenInit(ctx sdk.Context, order channeltypes.Order, c
 	return nil
 }
 
-// ReceiveSendPacket wraps the keeper's SendPacket function.
+// ReceiveSendPacket wraps the keeper's SendPacket function, subject to the send
+// limit of the source port.
 func (k Keeper) ReceiveSendPacket(ctx sdk.Context, packet ibcexported.PacketI) (uint64, error) {
 	sourcePort := packet.GetSourcePort()
 	sourceChannel := packet.GetSourceChannel()
@@ -125,7 +157,15 @@ func (k Keeper) ReceiveSendPacket(ctx sdk.Context, packet ibcexported.PacketI) (
 	if !ok {
 		return 0, sdkioerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
 	}
-	return k.SendPacket(ctx, chanCap, sourcePort, sourceChannel, clientTimeoutHeight, timeoutTimestamp, data)
+	if err := k.CheckSendLimit(ctx, sourcePort, len(data)); err != nil {
+		return 0, err
+	}
+	sequence, err := k.SendPacket(ctx, chanCap, sourcePort, sourceChannel, clientTimeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+	k.RecordSentPacket(ctx, sourcePort, sourceChannel, sequence, len(data))
+	return sequence, nil
 }
 
 // SendPacket defines a wrapper function for the channel Keeper's function
@@ -157,7 +197,11 @@ func (k Keeper) ReceiveWriteAcknowledgement(ctx sdk.Context, packet ibcexported.
 // WriteAcknowledgement defines a wrapper function for the channel Keeper's function
 // in order to expose it to the vibc IBC handler.
 func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capability.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
-	return k.channelKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+	if err := k.channelKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+	k.ReleasePendingAcknowledgement(ctx, packet)
+	return nil
 }
 
 // ReceiveWriteOpenTryChannel wraps the keeper's WriteOpenTryChannel function.
@@ -187,6 +231,7 @@ func (k Keeper) ReceiveChanCloseInit(ctx sdk.Context, portID, channelID string)
 	if err != nil {
 		return err
 	}
+	k.ReleaseChannelPackets(ctx, portID, channelID)
 	return nil
 }
 
@@ -199,7 +244,11 @@ func (k Keeper) ReceiveBindPort(ctx sdk.Context, portID string) error {
 		return fmt.Errorf("port %s is already bound", portID)
 	}
 	cap := k.portKeeper.BindPort(ctx, portID)
-	return k.ClaimCapability(ctx, cap, portPath)
+	if err := k.ClaimCapability(ctx, cap, portPath); err != nil {
+		return err
+	}
+	k.SetBoundPort(ctx, portID)
+	return nil
 }
 
 // ReceiveTimeoutExecuted is a wrapper function for the channel Keeper's
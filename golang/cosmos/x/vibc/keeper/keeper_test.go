@@ -0,0 +1,613 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capability "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+var govAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+type mockPortKeeper struct {
+	ibcScoped capabilitykeeper.ScopedKeeper
+}
+
+func (pk mockPortKeeper) BindPort(ctx sdk.Context, portID string) *capability.Capability {
+	cap, err := pk.ibcScoped.NewCapability(ctx, host.PortPath(portID))
+	if err != nil {
+		panic(err)
+	}
+	return cap
+}
+
+type mockChannelKeeper struct {
+	types.ChannelKeeper
+	channels    []channeltypes.IdentifiedChannel
+	commitments map[string]int
+	acks        map[string]ibcexported.Acknowledgement
+	sent        []sentPacket
+}
+
+type sentPacket struct {
+	portID, channelID string
+	sequence          uint64
+}
+
+func (ck *mockChannelKeeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capability.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	sequence := uint64(len(ck.sent) + 1)
+	ck.sent = append(ck.sent, sentPacket{sourcePort, sourceChannel, sequence})
+	return sequence, nil
+}
+
+func (ck *mockChannelKeeper) GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	for _, channel := range ck.channels {
+		if channel.PortId == portID && channel.ChannelId == channelID {
+			return channeltypes.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version), true
+		}
+	}
+	return channeltypes.Channel{}, false
+}
+
+func (ck *mockChannelKeeper) ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capability.Capability) error {
+	for i, channel := range ck.channels {
+		if channel.PortId == portID && channel.ChannelId == channelID {
+			ck.channels[i].State = channeltypes.CLOSED
+			return nil
+		}
+	}
+	return fmt.Errorf("channel %s/%s not found", portID, channelID)
+}
+
+func (ck *mockChannelKeeper) IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool) {
+	for _, channel := range ck.channels {
+		if cb(channel) {
+			return
+		}
+	}
+}
+
+func (ck *mockChannelKeeper) GetAllPacketCommitmentsAtChannel(ctx sdk.Context, portID, channelID string) []channeltypes.PacketState {
+	return make([]channeltypes.PacketState, ck.commitments[portID+"/"+channelID])
+}
+
+func (ck *mockChannelKeeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capability.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	key := fmt.Sprintf("%s/%s/%d", packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	if _, found := ck.acks[key]; found {
+		return fmt.Errorf("acknowledgement for packet %s already exists", key)
+	}
+	ck.acks[key] = ack
+	return nil
+}
+
+func identifiedChannel(portID, channelID string) channeltypes.IdentifiedChannel {
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty("counterparty", "channel-9"),
+		[]string{"connection-0"}, "version",
+	)
+	return channeltypes.NewIdentifiedChannel(portID, channelID, channel)
+}
+
+type testKit struct {
+	keeper     Keeper
+	ctx        sdk.Context
+	ibcScoped  capabilitykeeper.ScopedKeeper
+	vibcScoped capabilitykeeper.ScopedKeeper
+	channels   *mockChannelKeeper
+	actions    *[]vm.Action
+}
+
+func makeTestKit() testKit {
+	encodingConfig := params.MakeEncodingConfig()
+	cdc := encodingConfig.Marshaler
+
+	vibcStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	capStoreKey := storetypes.NewKVStoreKey(capability.StoreKey)
+	capMemKey := storetypes.NewMemoryStoreKey(capability.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(vibcStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(capStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(capMemKey, storetypes.StoreTypeMemory, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	capKeeper := capabilitykeeper.NewKeeper(cdc, capStoreKey, capMemKey)
+	ibcScoped := capKeeper.ScopeToModule("ibc")
+	vibcScoped := capKeeper.ScopeToModule(types.ModuleName)
+	capKeeper.Seal()
+	// Capability indexes start at one, as in the default genesis.
+	if err := capKeeper.InitializeIndex(ctx, 1); err != nil {
+		panic(err)
+	}
+	capKeeper.InitMemStore(ctx)
+
+	channels := &mockChannelKeeper{
+		commitments: map[string]int{},
+		acks:        map[string]ibcexported.Acknowledgement{},
+	}
+	actions := &[]vm.Action{}
+	pushAction := func(ctx sdk.Context, action vm.Action) error {
+		*actions = append(*actions, action)
+		return nil
+	}
+	keeper := NewKeeper(cdc, channels, mockPortKeeper{ibcScoped: ibcScoped}, capKeeper, govAuthority).
+		WithScope(vibcStoreKey, vibcScoped, pushAction)
+	return testKit{keeper, ctx, ibcScoped, vibcScoped, channels, actions}
+}
+
+func TestPorts(t *testing.T) {
+	kit := makeTestKit()
+	keeper, ctx := kit.keeper, kit.ctx
+	for _, portID := range []string{"icacontroller-2", "icacontroller-1"} {
+		if err := keeper.ReceiveBindPort(ctx, portID); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	if err := keeper.ReceiveBindPort(ctx, "icacontroller-1"); err == nil {
+		t.Errorf("got no error binding a port twice")
+	}
+
+	res, err := keeper.Ports(sdk.WrapSDKContext(ctx), &types.QueryPortsRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if want := []string{"icacontroller-1", "icacontroller-2"}; !reflect.DeepEqual(res.PortIds, want) {
+		t.Errorf("got ports %v, want %v", res.PortIds, want)
+	}
+}
+
+func TestChannels(t *testing.T) {
+	kit := makeTestKit()
+	keeper, ctx := kit.keeper, kit.ctx
+	for _, portID := range []string{"icacontroller-1", "icacontroller-2"} {
+		if err := keeper.ReceiveBindPort(ctx, portID); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	kit.channels.channels = []channeltypes.IdentifiedChannel{
+		identifiedChannel("icacontroller-1", "channel-0"),
+		identifiedChannel("icacontroller-1", "channel-3"),
+		identifiedChannel("icacontroller-2", "channel-1"),
+		identifiedChannel("transfer", "channel-2"),
+	}
+	kit.channels.commitments["icacontroller-1/channel-3"] = 2
+	kit.channels.commitments["transfer/channel-2"] = 5
+
+	channelIDs := func(res *types.QueryChannelsResponse) []string {
+		ids := []string{}
+		for _, info := range res.Channels {
+			ids = append(ids, info.Channel.ChannelId)
+		}
+		return ids
+	}
+
+	res, err := keeper.Channels(sdk.WrapSDKContext(ctx), &types.QueryChannelsRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if want := []string{"channel-0", "channel-3", "channel-1"}; !reflect.DeepEqual(channelIDs(res), want) {
+		t.Errorf("got channels %v, want %v", channelIDs(res), want)
+	}
+	if got := res.Channels[1]; got.PacketsInFlight != 2 || got.Channel.Version != "version" || got.Channel.State != channeltypes.OPEN {
+		t.Errorf("got channel %v, want an open channel with 2 packets in flight", got)
+	}
+
+	res, err = keeper.Channels(sdk.WrapSDKContext(ctx), &types.QueryChannelsRequest{
+		PortId:     "icacontroller-1",
+		Pagination: &query.PageRequest{Offset: 1, Limit: 5, CountTotal: true},
+	})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if want := []string{"channel-3"}; !reflect.DeepEqual(channelIDs(res), want) || res.Pagination.Total != 2 {
+		t.Errorf("got channels %v of %d, want %v of 2", channelIDs(res), res.Pagination.Total, want)
+	}
+
+	_, err = keeper.Channels(sdk.WrapSDKContext(ctx), &types.QueryChannelsRequest{PortId: "transfer"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("got error %v, want NotFound for a port not bound by vibc", err)
+	}
+	_, err = keeper.Channels(sdk.WrapSDKContext(ctx), &types.QueryChannelsRequest{
+		Pagination: &query.PageRequest{Key: []byte("channel-0")},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v, want InvalidArgument for pagination by key", err)
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	kit := makeTestKit()
+	keeper, ctx := kit.keeper, kit.ctx
+
+	// Bind ports as before the store recorded them.
+	for _, name := range []string{
+		host.PortPath("icacontroller-1"),
+		host.ChannelCapabilityPath("icacontroller-1", "channel-0"),
+	} {
+		cap, err := kit.ibcScoped.NewCapability(ctx, name)
+		if err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		if err := kit.vibcScoped.ClaimCapability(ctx, cap, name); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	if _, err := kit.ibcScoped.NewCapability(ctx, host.PortPath("transfer")); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if got := keeper.GetAllBoundPorts(ctx); len(got) != 0 {
+		t.Fatalf("got bound ports %v before migration, want none", got)
+	}
+
+	if err := NewMigrator(keeper).Migrate1to2(ctx); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if got, want := keeper.GetAllBoundPorts(ctx), []string{"icacontroller-1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got bound ports %v, want %v", got, want)
+	}
+}
+
+// openChannel binds the port and gives vibc the capability of a channel on it.
+func openChannel(t *testing.T, kit testKit, portID, channelID string) {
+	t.Helper()
+	if !kit.keeper.IsBoundPort(kit.ctx, portID) {
+		if err := kit.keeper.ReceiveBindPort(kit.ctx, portID); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	name := host.ChannelCapabilityPath(portID, channelID)
+	cap, err := kit.ibcScoped.NewCapability(kit.ctx, name)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if err := kit.vibcScoped.ClaimCapability(kit.ctx, cap, name); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+}
+
+func sendPacket(kit testKit, ctx sdk.Context, portID, channelID string, data string) (uint64, error) {
+	return kit.keeper.ReceiveSendPacket(ctx, channeltypes.Packet{
+		SourcePort:    portID,
+		SourceChannel: channelID,
+		TimeoutHeight: clienttypes.NewHeight(0, 100),
+		Data:          []byte(data),
+	})
+}
+
+func TestSendLimits(t *testing.T) {
+	kit := makeTestKit()
+	keeper, ctx := kit.keeper, kit.ctx.WithBlockHeight(10)
+	openChannel(t, kit, "icacontroller-1", "channel-0")
+	openChannel(t, kit, "icacontroller-2", "channel-1")
+
+	keeper.SetParams(ctx, types.Params{
+		DefaultSendLimit: types.SendLimit{MaxPacketsPerBlock: 2},
+		PortSendLimits: []types.PortSendLimit{
+			{PortId: "icacontroller-2", Limit: types.SendLimit{MaxBytesPerBlock: 10, MaxPacketsInFlight: 3}},
+		},
+	})
+
+	// The default limit applies to ports without their own.
+	for i := 0; i < 2; i++ {
+		if _, err := sendPacket(kit, ctx, "icacontroller-1", "channel-0", "hello"); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	if _, err := sendPacket(kit, ctx, "icacontroller-1", "channel-0", "hello"); err == nil {
+		t.Errorf("got no error exceeding the packets per block")
+	}
+	ctx = ctx.WithBlockHeight(11)
+	if _, err := sendPacket(kit, ctx, "icacontroller-1", "channel-0", "hello"); err != nil {
+		t.Errorf("got error = %v in the next block", err)
+	}
+
+	// Bytes per block.
+	if _, err := sendPacket(kit, ctx, "icacontroller-2", "channel-1", "123456"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if _, err := sendPacket(kit, ctx, "icacontroller-2", "channel-1", "12345"); err == nil {
+		t.Errorf("got no error exceeding the bytes per block")
+	}
+	if _, err := sendPacket(kit, ctx, "icacontroller-2", "channel-1", "1234"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+
+	// Packets in flight.
+	ctx = ctx.WithBlockHeight(12)
+	seq, err := sendPacket(kit, ctx, "icacontroller-2", "channel-1", "x")
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if _, err := sendPacket(kit, ctx, "icacontroller-2", "channel-1", "x"); err == nil {
+		t.Errorf("got no error exceeding the packets in flight")
+	}
+	if len(kit.channels.sent) != 6 {
+		t.Errorf("got %d packets sent, want 6", len(kit.channels.sent))
+	}
+
+	res, err := keeper.SendUsage(sdk.WrapSDKContext(ctx), &types.QuerySendUsageRequest{PortId: "icacontroller-2"})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	want := types.PortSendUsage{
+		PortId:           "icacontroller-2",
+		Limit:            types.SendLimit{MaxBytesPerBlock: 10, MaxPacketsInFlight: 3},
+		PacketsThisBlock: 1,
+		BytesThisBlock:   1,
+		PacketsInFlight:  3,
+	}
+	if !reflect.DeepEqual(res.Usage, []types.PortSendUsage{want}) {
+		t.Errorf("got usage %v, want %v", res.Usage, want)
+	}
+
+	// Acknowledgements and timeouts release packets in flight, but only once.
+	packet := channeltypes.Packet{SourcePort: "icacontroller-2", SourceChannel: "channel-1", Sequence: seq}
+	if err := keeper.TriggerOnAcknowledgementPacket(ctx, "", packet, []byte("ack"), nil); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if err := keeper.TriggerOnAcknowledgementPacket(ctx, "", packet, []byte("ack"), nil); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	packet.Sequence = seq - 1
+	if err := keeper.TriggerOnTimeoutPacket(ctx, "", packet, nil); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	// A packet that was never counted is not released.
+	packet.Sequence = 99
+	if err := keeper.TriggerOnTimeoutPacket(ctx, "", packet, nil); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if got := keeper.GetPacketsInFlight(ctx, "icacontroller-2"); got != 1 {
+		t.Errorf("got %d packets in flight, want 1", got)
+	}
+
+	_, err = keeper.SendUsage(sdk.WrapSDKContext(ctx), &types.QuerySendUsageRequest{PortId: "transfer"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("got error %v, want NotFound for a port not bound by vibc", err)
+	}
+	all, err := keeper.SendUsage(sdk.WrapSDKContext(ctx), &types.QuerySendUsageRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(all.Usage) != 2 || all.Usage[0].PortId != "icacontroller-1" || all.Usage[0].Limit.MaxPacketsPerBlock != 2 {
+		t.Errorf("got usage %v, want both ports with their limits", all.Usage)
+	}
+}
+
+func TestReleaseChannelPackets(t *testing.T) {
+	kit := makeTestKit()
+	keeper, ctx := kit.keeper, kit.ctx
+	im := types.NewIBCModule(keeper)
+	for _, channelID := range []string{"channel-0", "channel-1", "channel-2", "channel-3"} {
+		openChannel(t, kit, "icacontroller-1", channelID)
+		kit.channels.channels = append(kit.channels.channels, identifiedChannel("icacontroller-1", channelID))
+	}
+	kit.channels.channels[3].Ordering = channeltypes.ORDERED
+
+	sequences := map[string][]uint64{}
+	for _, channelID := range []string{"channel-0", "channel-0", "channel-1", "channel-2", "channel-3", "channel-3"} {
+		seq, err := sendPacket(kit, ctx, "icacontroller-1", channelID, "x")
+		if err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		sequences[channelID] = append(sequences[channelID], seq)
+	}
+	inFlight := func(want uint64) {
+		t.Helper()
+		if got := keeper.GetPacketsInFlight(ctx, "icacontroller-1"); got != want {
+			t.Errorf("got %d packets in flight, want %d", got, want)
+		}
+	}
+	inFlight(6)
+
+	// The VM closes a channel.
+	if err := keeper.ReceiveChanCloseInit(ctx, "icacontroller-1", "channel-0"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	inFlight(4)
+
+	// A packet on the closed channel times out, and is not released again.
+	packet := channeltypes.Packet{SourcePort: "icacontroller-1", SourceChannel: "channel-0", Sequence: sequences["channel-0"][0]}
+	if err := keeper.TriggerOnTimeoutPacket(ctx, "", packet, nil); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	inFlight(4)
+
+	// The counterparty closes a channel, or we do on a relayed message.
+	if err := im.OnChanCloseConfirm(ctx, "icacontroller-1", "channel-1"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if err := im.OnChanCloseInit(ctx, "icacontroller-1", "channel-2"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	inFlight(2)
+
+	// A timeout on an ORDERED channel closes it.
+	packet = channeltypes.Packet{SourcePort: "icacontroller-1", SourceChannel: "channel-3", Sequence: sequences["channel-3"][0]}
+	if err := im.OnTimeoutPacket(ctx, packet, nil); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	inFlight(0)
+}
+
+func TestUpdateParams(t *testing.T) {
+	kit := makeTestKit()
+	msgServer := NewMsgServerImpl(kit.keeper)
+	goCtx := sdk.WrapSDKContext(kit.ctx)
+	params := types.Params{
+		PortSendLimits: []types.PortSendLimit{
+			{PortId: "icacontroller-1", Limit: types.SendLimit{MaxPacketsInFlight: 5}},
+		},
+	}
+
+	if _, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("other").String(), params)); err == nil {
+		t.Errorf("got no error updating params without the authority")
+	}
+	bad := types.Params{PortSendLimits: append(params.PortSendLimits, params.PortSendLimits...)}
+	if _, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(govAuthority, bad)); err == nil {
+		t.Errorf("got no error updating params with a duplicate port")
+	}
+	if _, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(govAuthority, params)); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if got := kit.keeper.GetParams(kit.ctx); !got.Equal(params) {
+		t.Errorf("got params %v, want %v", got, params)
+	}
+}
+
+func TestPendingAcks(t *testing.T) {
+	kit := makeTestKit()
+	keeper, ctx := kit.keeper, kit.ctx.WithBlockHeight(10)
+	openChannel(t, kit, "icahost", "channel-0")
+	openChannel(t, kit, "icahost", "channel-1")
+	ibcModule := types.NewIBCModule(keeper)
+
+	receive := func(ctx sdk.Context, channelID string, sequence uint64) channeltypes.Packet {
+		t.Helper()
+		packet := channeltypes.Packet{
+			Sequence:           sequence,
+			SourcePort:         "icacontroller-1",
+			SourceChannel:      "channel-9",
+			DestinationPort:    "icahost",
+			DestinationChannel: channelID,
+			Data:               []byte("data"),
+		}
+		if ack := ibcModule.OnRecvPacket(ctx, packet, nil); ack != nil {
+			t.Fatalf("got ack %v, want an async acknowledgement", ack)
+		}
+		return packet
+	}
+	sequences := func(res *types.QueryPendingAcksResponse) []uint64 {
+		seqs := []uint64{}
+		for _, pending := range res.PendingAcks {
+			seqs = append(seqs, pending.Packet.Sequence)
+		}
+		return seqs
+	}
+
+	first := receive(ctx, "channel-0", 1)
+	receive(ctx, "channel-0", 2)
+	receive(ctx.WithBlockHeight(12), "channel-1", 3)
+
+	res, err := keeper.PendingAcks(sdk.WrapSDKContext(ctx), &types.QueryPendingAcksRequest{PortId: "icahost", ChannelId: "channel-0"})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if want := []uint64{1, 2}; !reflect.DeepEqual(sequences(res), want) {
+		t.Errorf("got pending sequences %v, want %v", sequences(res), want)
+	}
+	if res.PendingAcks[0].ReceiveHeight != 10 || !reflect.DeepEqual(res.PendingAcks[0].Packet, first) {
+		t.Errorf("got pending ack %v, want %v received at 10", res.PendingAcks[0], first)
+	}
+	_, err = keeper.PendingAcks(sdk.WrapSDKContext(ctx), &types.QueryPendingAcksRequest{ChannelId: "channel-0"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v, want InvalidArgument for a channel without a port", err)
+	}
+
+	// Writing the acknowledgement releases the packet.
+	ack := channeltypes.NewResultAcknowledgement([]byte("ok"))
+	if err := keeper.ReceiveWriteAcknowledgement(ctx.WithBlockHeight(13), first, ack); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if _, found := keeper.GetPendingAcknowledgement(ctx, "icahost", "channel-0", 1); found {
+		t.Errorf("got pending ack after writing the acknowledgement")
+	}
+
+	// Without a deadline, nothing expires.
+	ctx = ctx.WithBlockHeight(100)
+	keeper.ExpirePendingAcknowledgements(ctx)
+	res, err = keeper.PendingAcks(sdk.WrapSDKContext(ctx), &types.QueryPendingAcksRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if want := []uint64{2, 3}; !reflect.DeepEqual(sequences(res), want) || res.Height != 100 {
+		t.Errorf("got pending sequences %v at %d, want %v at 100", sequences(res), res.Height, want)
+	}
+
+	// With a deadline, only the packets received at or before the cutoff expire.
+	keeper.SetParams(ctx, types.Params{AsyncAckDeadlineBlocks: 5})
+	kit.channels.acks["icahost/channel-1/3"] = ack
+	em := sdk.NewEventManager()
+	keeper.ExpirePendingAcknowledgements(ctx.WithBlockHeight(16).WithEventManager(em))
+	if got, want := kit.channels.acks["icahost/channel-0/2"], channeltypes.NewErrorAcknowledgement(errAsyncAckDeadline); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ack %v, want error acknowledgement %v", got, want)
+	}
+	if _, found := keeper.GetPendingAcknowledgement(ctx, "icahost", "channel-0", 2); found {
+		t.Errorf("got pending ack after it expired")
+	}
+	if _, found := keeper.GetPendingAcknowledgement(ctx, "icahost", "channel-1", 3); !found {
+		t.Errorf("got no pending ack before its deadline")
+	}
+
+	// A packet whose acknowledgement cannot be written is dropped.
+	em = sdk.NewEventManager()
+	keeper.ExpirePendingAcknowledgements(ctx.WithBlockHeight(17).WithEventManager(em))
+	if got := keeper.getPendingAckCount(ctx); got != 0 {
+		t.Errorf("got %d pending acks, want none", got)
+	}
+	events := em.Events()
+	if len(events) != 1 || events[0].Type != types.EventTypeAsyncAckExpired {
+		t.Fatalf("got events %v, want one %s", events, types.EventTypeAsyncAckExpired)
+	}
+	if attrs := events[0].Attributes; len(attrs) != 5 || string(attrs[4].Key) != types.AttributeKeyError {
+		t.Errorf("got attributes %v, want an error", attrs)
+	}
+
+	// The controller is told of each expired packet.
+	expired := []AsyncAckExpiredEvent{}
+	for _, action := range *kit.actions {
+		if event, ok := action.(AsyncAckExpiredEvent); ok {
+			expired = append(expired, event)
+		}
+	}
+	if len(expired) != 2 {
+		t.Fatalf("got expired events %v, want 2", expired)
+	}
+	if got := expired[0]; got.Packet.Sequence != 2 || got.ReceiveHeight != 10 ||
+		!bytes.Equal(got.Acknowledgement, channeltypes.NewErrorAcknowledgement(errAsyncAckDeadline).Acknowledgement()) || got.Error != "" {
+		t.Errorf("got expired event %+v, want sequence 2 with its error acknowledgement", got)
+	}
+	if got := expired[1]; got.Packet.Sequence != 3 || got.ReceiveHeight != 12 || got.Acknowledgement != nil || got.Error == "" {
+		t.Errorf("got expired event %+v, want sequence 3 with an error", got)
+	}
+}
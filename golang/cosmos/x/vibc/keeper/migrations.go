@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// Migrator handles in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new migrator based on the keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.RecordBoundPorts(ctx)
+	return nil
+}
+
+// RecordBoundPorts records the ports bound by vibc before they were kept in
+// its store.  It reads the persistent capability owners rather than the scoped
+// keeper, whose in-memory index may not yet be initialized during an upgrade.
+func (k Keeper) RecordBoundPorts(ctx sdk.Context) {
+	portPrefix := host.PortPath("")
+	latest := k.capabilityKeeper.GetLatestIndex(ctx)
+	for index := uint64(1); index < latest; index++ {
+		owners, found := k.capabilityKeeper.GetOwners(ctx, index)
+		if !found {
+			continue
+		}
+		for _, owner := range owners.Owners {
+			if owner.Module == types.ModuleName && strings.HasPrefix(owner.Name, portPrefix) {
+				k.SetBoundPort(ctx, strings.TrimPrefix(owner.Name, portPrefix))
+			}
+		}
+	}
+}
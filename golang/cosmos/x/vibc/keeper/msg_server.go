@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+type msgServer struct {
+	// SendPacket is only handled by the legacy router.
+	types.UnimplementedMsgServer
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vibc MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+var _ types.MsgServer = &msgServer{}
+
+// UpdateParams replaces the module params on behalf of the governance authority.
+func (s msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != s.keeper.GetAuthority() {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", s.keeper.GetAuthority(), msg.Authority)
+	}
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	s.keeper.SetParams(ctx, msg.Params)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
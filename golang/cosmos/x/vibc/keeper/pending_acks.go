@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// The packets received for the controller to acknowledge asynchronously are
+// kept under pendingAckKeyPrefix, keyed by destination port, channel and
+// sequence.  They are indexed under pendingAckHeightKeyPrefix by their
+// receive height, so that the ones past the deadline are found in order.
+const (
+	pendingAckKeyPrefix       = "pendingAck."
+	pendingAckHeightKeyPrefix = "pendingAckHeight."
+	pendingAckCountKey        = "pendingAckCount"
+)
+
+var pendingAckHeightValue = []byte{1}
+
+// errAsyncAckDeadline is the error acknowledgement written for a packet whose
+// deadline has passed.  Only its ABCI code reaches the counterparty.
+var errAsyncAckDeadline = errors.New("async acknowledgement deadline exceeded")
+
+func pendingAckHeightKey(height int64, portID, channelID string, sequence uint64) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(height))
+	return append(key, packetKey(portID, channelID, sequence)...)
+}
+
+func (k Keeper) getPendingAckCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(pendingAckCountKey))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setPendingAckCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete([]byte(pendingAckCountKey))
+		return
+	}
+	store.Set([]byte(pendingAckCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// GetPendingAcknowledgement returns the received packet, if its
+// acknowledgement is pending.
+func (k Keeper) GetPendingAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingAcknowledgement, bool) {
+	bz := k.prefixStore(ctx, pendingAckKeyPrefix).Get(packetKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PendingAcknowledgement{}, false
+	}
+	var pending types.PendingAcknowledgement
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// RecordPendingAcknowledgement records that the controller is to acknowledge
+// the received packet asynchronously.
+func (k Keeper) RecordPendingAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI) {
+	portID, channelID, sequence := packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()
+	if _, found := k.GetPendingAcknowledgement(ctx, portID, channelID, sequence); found {
+		return
+	}
+	pending := types.PendingAcknowledgement{
+		Packet:        reifyPacket(packet),
+		ReceiveHeight: ctx.BlockHeight(),
+	}
+	k.prefixStore(ctx, pendingAckKeyPrefix).Set(packetKey(portID, channelID, sequence), k.cdc.MustMarshal(&pending))
+	k.prefixStore(ctx, pendingAckHeightKeyPrefix).Set(pendingAckHeightKey(pending.ReceiveHeight, portID, channelID, sequence), pendingAckHeightValue)
+	k.setPendingAckCount(ctx, k.getPendingAckCount(ctx)+1)
+}
+
+// ReleasePendingAcknowledgement stops tracking the received packet, if its
+// acknowledgement was pending.
+func (k Keeper) ReleasePendingAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI) {
+	portID, channelID, sequence := packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()
+	pending, found := k.GetPendingAcknowledgement(ctx, portID, channelID, sequence)
+	if !found {
+		return
+	}
+	k.prefixStore(ctx, pendingAckKeyPrefix).Delete(packetKey(portID, channelID, sequence))
+	k.prefixStore(ctx, pendingAckHeightKeyPrefix).Delete(pendingAckHeightKey(pending.ReceiveHeight, portID, channelID, sequence))
+	k.setPendingAckCount(ctx, k.getPendingAckCount(ctx)-1)
+
+	metrics.AddSampleWithLabels(
+		[]string{types.ModuleName, "async_ack", "age_blocks"},
+		float32(ctx.BlockHeight()-pending.ReceiveHeight),
+		[]metrics.Label{telemetry.NewLabel("port", portID)},
+	)
+}
+
+// PaginatePendingAcknowledgements returns a page of the pending
+// acknowledgements, restricted to a port or a channel of it if given.
+func (k Keeper) PaginatePendingAcknowledgements(ctx sdk.Context, portID, channelID string, pageReq *query.PageRequest) ([]types.PendingAcknowledgement, *query.PageResponse, error) {
+	var keyPrefix []byte
+	if portID != "" {
+		keyPrefix = address.MustLengthPrefix([]byte(portID))
+		if channelID != "" {
+			keyPrefix = append(keyPrefix, address.MustLengthPrefix([]byte(channelID))...)
+		}
+	}
+	store := prefix.NewStore(k.prefixStore(ctx, pendingAckKeyPrefix), keyPrefix)
+
+	pendingAcks := []types.PendingAcknowledgement{}
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var pending types.PendingAcknowledgement
+		if err := k.cdc.Unmarshal(value, &pending); err != nil {
+			return err
+		}
+		pendingAcks = append(pendingAcks, pending)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return pendingAcks, pageRes, nil
+}
+
+// getOldestPendingAcknowledgementHeight returns the earliest receive height of
+// the pending acknowledgements, if any.
+func (k Keeper) getOldestPendingAcknowledgementHeight(ctx sdk.Context) (int64, bool) {
+	iterator := k.prefixStore(ctx, pendingAckHeightKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(iterator.Key()[:8])), true
+}
+
+// ExpirePendingAcknowledgements writes an error acknowledgement for each
+// received packet that has been pending for the deadline of the params, if
+// any.  A packet whose acknowledgement cannot be written, such as on a closed
+// channel, is no longer tracked either way.  The controller is told of each
+// expired packet, so that it stops waiting to acknowledge it.
+func (k Keeper) ExpirePendingAcknowledgements(ctx sdk.Context) {
+	deadline := k.GetParams(ctx).AsyncAckDeadlineBlocks
+	if deadline == 0 || uint64(ctx.BlockHeight()) < deadline {
+		return
+	}
+	// The packets received at or before the cutoff height have expired.
+	end := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()) - deadline + 1)
+
+	expired := []types.PendingAcknowledgement{}
+	iterator := k.prefixStore(ctx, pendingAckHeightKeyPrefix).Iterator(nil, end)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		height := int64(sdk.BigEndianToUint64(key[:8]))
+		portID, channelID, sequence := parsePacketKey(key[8:])
+		pending, found := k.GetPendingAcknowledgement(ctx, portID, channelID, sequence)
+		if !found || pending.ReceiveHeight != height {
+			continue
+		}
+		expired = append(expired, pending)
+	}
+	iterator.Close()
+
+	for _, pending := range expired {
+		packet := pending.Packet
+		ack := channeltypes.NewErrorAcknowledgement(errAsyncAckDeadline)
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.ReceiveWriteAcknowledgement(cacheCtx, packet, ack)
+		if err == nil {
+			writeCache()
+		} else {
+			k.ReleasePendingAcknowledgement(ctx, packet)
+			ctx.Logger().Error("cannot write expired async acknowledgement",
+				"port", packet.DestinationPort, "channel", packet.DestinationChannel, "sequence", packet.Sequence, "error", err)
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyPort, packet.DestinationPort),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyReceiveHeight, fmt.Sprint(pending.ReceiveHeight)),
+		}
+		if err != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAsyncAckExpired, attrs...))
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "async_ack", "expired"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("port", packet.DestinationPort)},
+		)
+
+		if pushErr := k.TriggerAsyncAckExpired(ctx, pending, ack, err); pushErr != nil {
+			ctx.Logger().Error("cannot tell the controller of an expired async acknowledgement",
+				"port", packet.DestinationPort, "channel", packet.DestinationChannel, "sequence", packet.Sequence, "error", pushErr)
+		}
+	}
+}
+
+// ReportPendingAcknowledgements sets the telemetry gauges of the number of
+// pending acknowledgements and the age of the oldest.
+func (k Keeper) ReportPendingAcknowledgements(ctx sdk.Context) {
+	var oldestAge int64
+	if height, found := k.getOldestPendingAcknowledgementHeight(ctx); found {
+		oldestAge = ctx.BlockHeight() - height
+	}
+	telemetry.SetGauge(float32(k.getPendingAckCount(ctx)), types.ModuleName, "async_ack", "pending")
+	telemetry.SetGauge(float32(oldestAge), types.ModuleName, "async_ack", "oldest_age_blocks")
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// The ports bound on behalf of the controller are kept under this prefix,
+// keyed by port ID.
+const boundPortKeyPrefix = "port."
+
+var boundPortValue = []byte{1}
+
+func (k Keeper) boundPortStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(boundPortKeyPrefix))
+}
+
+// SetBoundPort records that vibc has bound the port.
+func (k Keeper) SetBoundPort(ctx sdk.Context, portID string) {
+	k.boundPortStore(ctx).Set([]byte(portID), boundPortValue)
+}
+
+// IsBoundPort returns whether vibc has bound the port.
+func (k Keeper) IsBoundPort(ctx sdk.Context, portID string) bool {
+	return k.boundPortStore(ctx).Has([]byte(portID))
+}
+
+// GetAllBoundPorts returns the IDs of the ports bound by vibc, in order.
+func (k Keeper) GetAllBoundPorts(ctx sdk.Context) []string {
+	portIDs := []string{}
+	iterator := k.boundPortStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		portIDs = append(portIDs, string(iterator.Key()))
+	}
+	return portIDs
+}
+
+// PaginateBoundPorts returns a page of the IDs of the ports bound by vibc.
+func (k Keeper) PaginateBoundPorts(ctx sdk.Context, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	portIDs := []string{}
+	pageRes, err := query.Paginate(k.boundPortStore(ctx), pageReq, func(key, _ []byte) error {
+		portIDs = append(portIDs, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return portIDs, pageRes, nil
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// The sending on each port is kept under these prefixes, keyed by port ID.
+// The packets in flight are also kept individually, so that only the packets
+// counted when they were sent are released by their acknowledgement, timeout
+// or the closing of their channel.
+const (
+	blockSendUsageKeyPrefix = "blockSendUsage."
+	inFlightCountKeyPrefix  = "inFlightCount."
+	inFlightPacketKeyPrefix = "inFlightPacket."
+)
+
+var inFlightPacketValue = []byte{1}
+
+func (k Keeper) prefixStore(ctx sdk.Context, keyPrefix string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+}
+
+// channelKey identifies a channel by the port and channel of one end.
+func channelKey(portID, channelID string) []byte {
+	key := address.MustLengthPrefix([]byte(portID))
+	return append(key, address.MustLengthPrefix([]byte(channelID))...)
+}
+
+// packetKey identifies a packet by the port and channel of one end, and its
+// sequence.
+func packetKey(portID, channelID string, sequence uint64) []byte {
+	return append(channelKey(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func parsePacketKey(key []byte) (portID, channelID string, sequence uint64) {
+	portLen := int(key[0])
+	portID = string(key[1 : 1+portLen])
+	key = key[1+portLen:]
+	channelLen := int(key[0])
+	channelID = string(key[1 : 1+channelLen])
+	return portID, channelID, sdk.BigEndianToUint64(key[1+channelLen:])
+}
+
+// GetBlockSendUsage returns what has been sent on the port in the current
+// block.
+func (k Keeper) GetBlockSendUsage(ctx sdk.Context, portID string) types.BlockSendUsage {
+	usage := types.BlockSendUsage{Height: ctx.BlockHeight()}
+	bz := k.prefixStore(ctx, blockSendUsageKeyPrefix).Get([]byte(portID))
+	if bz == nil {
+		return usage
+	}
+	var stored types.BlockSendUsage
+	k.cdc.MustUnmarshal(bz, &stored)
+	if stored.Height != usage.Height {
+		// The usage is of an earlier block.
+		return usage
+	}
+	return stored
+}
+
+// GetPacketsInFlight returns the number of packets sent on the port that have
+// been neither acknowledged nor timed out, and whose channel has not closed.
+func (k Keeper) GetPacketsInFlight(ctx sdk.Context, portID string) uint64 {
+	bz := k.prefixStore(ctx, inFlightCountKeyPrefix).Get([]byte(portID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setPacketsInFlight(ctx sdk.Context, portID string, count uint64) {
+	store := k.prefixStore(ctx, inFlightCountKeyPrefix)
+	if count == 0 {
+		store.Delete([]byte(portID))
+		return
+	}
+	store.Set([]byte(portID), sdk.Uint64ToBigEndian(count))
+}
+
+// CheckSendLimit returns an error if sending a packet with the given amount of
+// data on the port would exceed its send limit.
+func (k Keeper) CheckSendLimit(ctx sdk.Context, portID string, dataLen int) error {
+	limit := k.GetParams(ctx).GetSendLimit(portID)
+	usage := k.GetBlockSendUsage(ctx, portID)
+	if limit.MaxPacketsPerBlock > 0 && usage.Packets+1 > limit.MaxPacketsPerBlock {
+		return fmt.Errorf("port %s has reached its limit of %d packets per block", portID, limit.MaxPacketsPerBlock)
+	}
+	if limit.MaxBytesPerBlock > 0 && usage.Bytes+uint64(dataLen) > limit.MaxBytesPerBlock {
+		return fmt.Errorf("port %s cannot send %d more bytes in its limit of %d bytes per block", portID, dataLen, limit.MaxBytesPerBlock)
+	}
+	if limit.MaxPacketsInFlight > 0 && k.GetPacketsInFlight(ctx, portID)+1 > limit.MaxPacketsInFlight {
+		return fmt.Errorf("port %s has reached its limit of %d packets in flight", portID, limit.MaxPacketsInFlight)
+	}
+	return nil
+}
+
+// RecordSentPacket counts a packet sent on the port in the current block, and
+// in flight until it is acknowledged, times out or its channel closes.
+func (k Keeper) RecordSentPacket(ctx sdk.Context, portID, channelID string, sequence uint64, dataLen int) {
+	usage := k.GetBlockSendUsage(ctx, portID)
+	usage.Packets++
+	usage.Bytes += uint64(dataLen)
+	k.prefixStore(ctx, blockSendUsageKeyPrefix).Set([]byte(portID), k.cdc.MustMarshal(&usage))
+
+	k.prefixStore(ctx, inFlightPacketKeyPrefix).Set(packetKey(portID, channelID, sequence), inFlightPacketValue)
+	k.setPacketsInFlight(ctx, portID, k.GetPacketsInFlight(ctx, portID)+1)
+}
+
+// ReleaseSentPacket stops counting a packet as in flight, if it was counted.
+func (k Keeper) ReleaseSentPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := k.prefixStore(ctx, inFlightPacketKeyPrefix)
+	key := packetKey(portID, channelID, sequence)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	k.setPacketsInFlight(ctx, portID, k.GetPacketsInFlight(ctx, portID)-1)
+}
+
+// ReleaseChannelPackets stops counting the packets sent on a channel as in
+// flight, since once it closes they can no longer be acknowledged.  Any that
+// later time out are not released again.
+func (k Keeper) ReleaseChannelPackets(ctx sdk.Context, portID, channelID string) {
+	store := k.prefixStore(ctx, inFlightPacketKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, channelKey(portID, channelID))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	if len(keys) == 0 {
+		return
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.setPacketsInFlight(ctx, portID, k.GetPacketsInFlight(ctx, portID)-uint64(len(keys)))
+}
+
+// GetPortSendUsage reports the sending on the port against its send limit.
+func (k Keeper) GetPortSendUsage(ctx sdk.Context, portID string) types.PortSendUsage {
+	usage := k.GetBlockSendUsage(ctx, portID)
+	return types.PortSendUsage{
+		PortId:           portID,
+		Limit:            k.GetParams(ctx).GetSendLimit(portID),
+		PacketsThisBlock: usage.Packets,
+		BytesThisBlock:   usage.Bytes,
+		PacketsInFlight:  k.GetPacketsInFlight(ctx, portID),
+	}
+}
@@ -65,6 +65,8 @@ func (k Keeper) TriggerOnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	k.ReleaseSentPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
 	event := types.AcknowledgementPacketEvent{
 		Target:          target,
 		Packet:          reifyPacket(packet),
@@ -86,6 +88,15 @@ func (k Keeper) TriggerOnTimeoutPacket(
 	packet ibcexported.PacketI,
 	relayer sdk.AccAddress,
 ) error {
+	// A timeout closes an ORDERED channel, so its other packets will not be
+	// acknowledged either.
+	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if found && channel.Ordering == channeltypes.ORDERED {
+		k.ReleaseChannelPackets(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	} else {
+		k.ReleaseSentPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+
 	event := types.TimeoutPacketEvent{
 		Target:  target,
 		Packet:  reifyPacket(packet),
@@ -99,3 +110,39 @@ func (k Keeper) TriggerOnTimeoutPacket(
 
 	return nil
 }
+
+type AsyncAckExpiredEvent struct {
+	*vm.ActionHeader `actionType:"IBC_EVENT"`
+	Event            string              `json:"event" default:"asyncAckExpired"`
+	Packet           channeltypes.Packet `json:"packet"`
+	ReceiveHeight    int64               `json:"receiveHeight"`
+	Acknowledgement  []byte              `json:"acknowledgement,omitempty"`
+	Error            string              `json:"error,omitempty"`
+}
+
+// TriggerAsyncAckExpired tells the controller that the deadline passed for
+// acknowledging the received packet.  The acknowledgement written in its place
+// is given, or the error that prevented writing one.
+func (k Keeper) TriggerAsyncAckExpired(
+	ctx sdk.Context,
+	pending types.PendingAcknowledgement,
+	acknowledgement ibcexported.Acknowledgement,
+	writeErr error,
+) error {
+	event := AsyncAckExpiredEvent{
+		Packet:        pending.Packet,
+		ReceiveHeight: pending.ReceiveHeight,
+	}
+	if writeErr != nil {
+		event.Error = writeErr.Error()
+	} else {
+		event.Acknowledgement = acknowledgement.Acknowledgement()
+	}
+
+	err := k.PushAction(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
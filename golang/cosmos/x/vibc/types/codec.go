@@ -29,12 +29,14 @@ func init() {
 // RegisterCodec registers concrete types on the Amino codec
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSendPacket{}, ModuleName+"/SendPacket", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/UpdateParams", nil)
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendPacket{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,12 @@
+package types
+
+// Event types and attribute keys emitted by the vibc module.
+const (
+	EventTypeAsyncAckExpired = "async_ack_expired"
+
+	AttributeKeyPort          = "port_id"
+	AttributeKeyChannel       = "channel_id"
+	AttributeKeySequence      = "sequence"
+	AttributeKeyReceiveHeight = "receive_height"
+	AttributeKeyError         = "error"
+)
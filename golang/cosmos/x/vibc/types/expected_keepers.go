@@ -35,6 +35,8 @@ type ChannelKeeper interface {
 		connectionHops []string, counterparty channel.Counterparty, version string)
 	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capability.Capability) error
 	TimeoutExecuted(ctx sdk.Context, channelCap *capability.Capability, packet ibcexported.PacketI) error
+	IterateChannels(ctx sdk.Context, cb func(channel.IdentifiedChannel) bool)
+	GetAllPacketCommitmentsAtChannel(ctx sdk.Context, portID, channelID string) []channel.PacketState
 }
 
 // ClientKeeper defines the expected IBC client keeper
@@ -52,6 +54,13 @@ type PortKeeper interface {
 	BindPort(ctx sdk.Context, portID string) *capability.Capability
 }
 
+// CapabilityKeeper defines the expected capability keeper, whose persistent
+// owners are consulted to find the ports bound before vibc recorded them.
+type CapabilityKeeper interface {
+	GetLatestIndex(ctx sdk.Context) uint64
+	GetOwners(ctx sdk.Context, index uint64) (capability.CapabilityOwners, bool)
+}
+
 // ScopedKeeper defines the expected scoped capability keeper
 type ScopedKeeper interface {
 	ClaimCapability(ctx sdk.Context, cap *capability.Capability, name string) error
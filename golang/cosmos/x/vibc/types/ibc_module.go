@@ -31,6 +31,11 @@ type IBCModuleImpl interface {
 	ClaimCapability(ctx sdk.Context, channelCap *capability.Capability, path string) error
 	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
 	PushAction(ctx sdk.Context, action vm.Action) error
+	TriggerOnAcknowledgementPacket(ctx sdk.Context, target string, packet exported.PacketI, acknowledgement []byte, relayer sdk.AccAddress) error
+	TriggerOnTimeoutPacket(ctx sdk.Context, target string, packet exported.PacketI, relayer sdk.AccAddress) error
+	RecordPendingAcknowledgement(ctx sdk.Context, packet exported.PacketI)
+	ReleasePendingAcknowledgement(ctx sdk.Context, packet exported.PacketI)
+	ReleaseChannelPackets(ctx sdk.Context, portID, channelID string)
 }
 
 type IBCModule struct {
@@ -220,6 +225,7 @@ func (im IBCModule) OnChanCloseInit(
 		ChannelID: channelID,
 	}
 
+	im.impl.ReleaseChannelPackets(ctx, portID, channelID)
 	err := im.impl.PushAction(ctx, event)
 	return err
 }
@@ -242,6 +248,7 @@ func (im IBCModule) OnChanCloseConfirm(
 		ChannelID: channelID,
 	}
 
+	im.impl.ReleaseChannelPackets(ctx, portID, channelID)
 	err := im.impl.PushAction(ctx, event)
 	return err
 }
@@ -272,8 +279,12 @@ func (im IBCModule) OnRecvPacket(
 		Relayer: relayer,
 	}
 
+	// The controller acknowledges the packet asynchronously, so track it until
+	// it does.
+	im.impl.RecordPendingAcknowledgement(ctx, packet)
 	err := im.impl.PushAction(ctx, event)
 	if err != nil {
+		im.impl.ReleasePendingAcknowledgement(ctx, packet)
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
@@ -295,18 +306,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	event := AcknowledgementPacketEvent{
-		Packet:          packet,
-		Acknowledgement: acknowledgement,
-		Relayer:         relayer,
-	}
-
-	err := im.impl.PushAction(ctx, event)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return im.impl.TriggerOnAcknowledgementPacket(ctx, "", packet, acknowledgement, relayer)
 }
 
 type TimeoutPacketEvent struct {
@@ -322,15 +322,5 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	event := TimeoutPacketEvent{
-		Packet:  packet,
-		Relayer: relayer,
-	}
-
-	err := im.impl.PushAction(ctx, event)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return im.impl.TriggerOnTimeoutPacket(ctx, "", packet, relayer)
 }
@@ -120,38 +120,138 @@ func (m *MsgSendPacketResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendPacketResponse proto.InternalMessageInfo
 
+// MsgUpdateParams replaces all of the vibc module parameters.
+type MsgUpdateParams struct {
+	// The address of the governance authority, as a bech32 string.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// The complete set of parameters to install.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is an empty reply.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSendPacket)(nil), "agoric.vibc.MsgSendPacket")
 	proto.RegisterType((*MsgSendPacketResponse)(nil), "agoric.vibc.MsgSendPacketResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "agoric.vibc.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "agoric.vibc.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("agoric/vibc/msgs.proto", fileDescriptor_78e9bb7be62a4c00) }
 
 var fileDescriptor_78e9bb7be62a4c00 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x6e, 0xea, 0x30,
-	0x14, 0xc6, 0x93, 0x7b, 0xaf, 0x90, 0xae, 0xb9, 0x48, 0x57, 0x51, 0xff, 0xa0, 0x54, 0x8a, 0x69,
-	0x26, 0x16, 0x6c, 0x41, 0x87, 0x4a, 0x6c, 0x30, 0x17, 0xa9, 0x0d, 0x9d, 0xba, 0x25, 0x8e, 0x65,
-	0x52, 0x48, 0x1c, 0xe5, 0x04, 0x54, 0xde, 0xa2, 0x8f, 0xd0, 0xc7, 0x61, 0x64, 0x6b, 0xa7, 0xa8,
-	0x82, 0xa5, 0x62, 0x64, 0xec, 0x54, 0x25, 0x4e, 0x2a, 0x18, 0x3a, 0xf9, 0xf8, 0x3b, 0xe7, 0x3b,
-	0xd6, 0xcf, 0x1f, 0x3a, 0x73, 0x85, 0x4c, 0x02, 0x46, 0x17, 0x81, 0xc7, 0x68, 0x08, 0x02, 0x48,
-	0x9c, 0xc8, 0x54, 0x1a, 0x75, 0xa5, 0x93, 0x5c, 0x37, 0x4f, 0x84, 0x14, 0xb2, 0xd0, 0x69, 0x5e,
-	0xa9, 0x11, 0xf3, 0x32, 0xb7, 0x30, 0x99, 0x70, 0xca, 0x26, 0x6e, 0x14, 0xf1, 0x19, 0x5d, 0x74,
-	0xab, 0x52, 0x8d, 0xd8, 0xaf, 0x3a, 0x6a, 0x8c, 0x40, 0x8c, 0x79, 0xe4, 0xdf, 0xba, 0x6c, 0xca,
-	0x53, 0xe3, 0x1e, 0xd5, 0xe2, 0xa2, 0x6a, 0xea, 0x2d, 0xbd, 0x5d, 0xef, 0x5d, 0x90, 0xc0, 0x63,
-	0x24, 0xdf, 0x42, 0x2a, 0xeb, 0xa2, 0x4b, 0xd4, 0xf0, 0x10, 0xaf, 0x32, 0xac, 0xed, 0x32, 0x5c,
-	0x5a, 0xf6, 0x19, 0x6e, 0x2c, 0xdd, 0x70, 0xd6, 0xb7, 0xd5, 0xdd, 0x76, 0xca, 0x86, 0xf1, 0x88,
-	0x6a, 0xc0, 0x23, 0x9f, 0x27, 0xcd, 0x5f, 0x2d, 0xbd, 0xfd, 0x6f, 0xe8, 0xec, 0x32, 0xfc, 0x17,
-	0xe6, 0x5e, 0x18, 0xa4, 0x29, 0x4f, 0xf6, 0x19, 0xfe, 0xaf, 0x7c, 0xdf, 0x92, 0xfd, 0x99, 0xe1,
-	0x8e, 0x08, 0xd2, 0xc9, 0xdc, 0x23, 0x4c, 0x86, 0x94, 0x49, 0x08, 0x25, 0x94, 0x47, 0x07, 0xfc,
-	0x29, 0x4d, 0x97, 0x31, 0x07, 0x32, 0x60, 0x6c, 0xe0, 0xfb, 0x09, 0x07, 0x70, 0xca, 0x17, 0xfa,
-	0x7f, 0x3e, 0x5e, 0xb0, 0x66, 0x9f, 0xa3, 0xd3, 0x23, 0x30, 0x87, 0x43, 0x2c, 0x23, 0xe0, 0xbd,
-	0x31, 0xfa, 0x3d, 0x02, 0x61, 0xdc, 0x20, 0x74, 0x40, 0x6d, 0x92, 0x83, 0xef, 0x24, 0x47, 0x46,
-	0xd3, 0xfe, 0xb9, 0x57, 0x2d, 0x1d, 0xde, 0xad, 0x36, 0x96, 0xbe, 0xde, 0x58, 0xfa, 0xfb, 0xc6,
-	0xd2, 0x9f, 0xb7, 0x96, 0xb6, 0xde, 0x5a, 0xda, 0xdb, 0xd6, 0xd2, 0x1e, 0xae, 0x0f, 0x20, 0x06,
-	0x2a, 0x4a, 0xb5, 0xae, 0x80, 0x10, 0x72, 0xe6, 0x46, 0xa2, 0xa2, 0x7b, 0x52, 0x29, 0x17, 0x64,
-	0x5e, 0xad, 0x48, 0xe8, 0xea, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x01, 0xfd, 0x45, 0x01, 0x02,
-	0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x01, 0x8a, 0x94, 0x4b, 0x2b, 0x90, 0xf9, 0xd1, 0x62, 0x90, 0xdd, 0x5a, 0x0c, 0x5d,
+	0x7a, 0x56, 0xc3, 0x80, 0xd4, 0x05, 0x25, 0x73, 0x23, 0x15, 0x03, 0x0b, 0xdb, 0xf9, 0x7c, 0xba,
+	0x98, 0xc6, 0x3e, 0xcb, 0xef, 0x12, 0x91, 0xff, 0x82, 0x3f, 0x81, 0x09, 0xfe, 0x95, 0x8e, 0xdd,
+	0x60, 0xb2, 0x50, 0xb2, 0xa0, 0x8e, 0x1d, 0x99, 0xd0, 0xf9, 0xec, 0xd4, 0x8e, 0x44, 0x17, 0xfb,
+	0xdd, 0xf7, 0xde, 0xfb, 0xee, 0xbe, 0xf7, 0x3d, 0xfc, 0x8c, 0x0a, 0x59, 0x24, 0x2c, 0x58, 0x24,
+	0x11, 0x0b, 0x52, 0x10, 0x40, 0xf2, 0x42, 0x2a, 0x69, 0x0f, 0x0c, 0x4e, 0x34, 0xee, 0x3c, 0x11,
+	0x52, 0xc8, 0x0a, 0x0f, 0x74, 0x64, 0x4a, 0x9c, 0x43, 0xdd, 0xc2, 0x64, 0xc1, 0x03, 0x36, 0xa5,
+	0x59, 0xc6, 0x67, 0xc1, 0xe2, 0xa4, 0x09, 0xeb, 0x92, 0x0e, 0xbb, 0xfe, 0x18, 0xdc, 0xff, 0x89,
+	0xf0, 0xee, 0x04, 0xc4, 0x7b, 0x9e, 0xc5, 0xe7, 0x94, 0x5d, 0x70, 0x65, 0x7f, 0xc0, 0xbd, 0xbc,
+	0x8a, 0xf6, 0xd1, 0x01, 0x3a, 0x1a, 0x0c, 0x5f, 0x10, 0x5d, 0xad, 0xd9, 0x49, 0x43, 0xb9, 0x38,
+	0x21, 0xa6, 0x78, 0xec, 0x5d, 0x96, 0x9e, 0x75, 0x5d, 0x7a, 0x75, 0xcb, 0x4d, 0xe9, 0xed, 0x2e,
+	0x69, 0x3a, 0x3b, 0xf5, 0xcd, 0xd9, 0x0f, 0xeb, 0x84, 0xfd, 0x19, 0xf7, 0x80, 0x67, 0x31, 0x2f,
+	0xf6, 0xef, 0x1d, 0xa0, 0xa3, 0x9d, 0x71, 0x78, 0x5d, 0x7a, 0x7d, 0x98, 0x47, 0x69, 0xa2, 0x14,
+	0x2f, 0x6e, 0x4a, 0xef, 0x91, 0xe9, 0xdb, 0x40, 0xfe, 0xdf, 0xd2, 0x3b, 0x16, 0x89, 0x9a, 0xce,
+	0x23, 0xc2, 0x64, 0x1a, 0x30, 0x09, 0xa9, 0x84, 0xfa, 0x77, 0x0c, 0xf1, 0x45, 0xa0, 0x96, 0x39,
+	0x07, 0x32, 0x62, 0x6c, 0x14, 0xc7, 0x05, 0x07, 0x08, 0xeb, 0x1b, 0x4e, 0x1f, 0xfc, 0xf9, 0xe6,
+	0x59, 0xfe, 0x1e, 0x7e, 0xda, 0x11, 0x16, 0x72, 0xc8, 0x65, 0x06, 0xdc, 0xff, 0x8e, 0xf0, 0xc3,
+	0x09, 0x88, 0x8f, 0x79, 0x4c, 0x15, 0x3f, 0xa7, 0x05, 0x4d, 0xc1, 0x7e, 0x8b, 0xfb, 0x74, 0xae,
+	0xa6, 0xb2, 0x48, 0xd4, 0xb2, 0xd2, 0xdd, 0x1f, 0x1f, 0xea, 0x17, 0x6e, 0xc0, 0xdb, 0x17, 0x6e,
+	0x20, 0x3f, 0xbc, 0x4d, 0xdb, 0x67, 0x7a, 0x6a, 0x9a, 0xaa, 0xd2, 0x37, 0x18, 0x3e, 0x26, 0x2d,
+	0xdb, 0x88, 0xb9, 0xa5, 0x3d, 0x2d, 0x7d, 0x6e, 0x4f, 0x4b, 0x9f, 0xab, 0x69, 0xe9, 0xa0, 0x56,
+	0xf0, 0x1c, 0xef, 0x6d, 0xbd, 0xb3, 0xd1, 0x30, 0xfc, 0x81, 0xf0, 0xfd, 0x09, 0x08, 0xfb, 0x0c,
+	0xe3, 0x96, 0x75, 0x4e, 0xe7, 0xd2, 0x8e, 0x7a, 0xc7, 0xff, 0x7f, 0xae, 0x61, 0xb5, 0x43, 0xbc,
+	0xd3, 0x99, 0xca, 0xcb, 0xed, 0x9e, 0x76, 0xd6, 0x79, 0x75, 0x57, 0xb6, 0xe1, 0x1c, 0xbf, 0xbb,
+	0x5c, 0xb9, 0xe8, 0x6a, 0xe5, 0xa2, 0xdf, 0x2b, 0x17, 0x7d, 0x5d, 0xbb, 0xd6, 0xd5, 0xda, 0xb5,
+	0x7e, 0xad, 0x5d, 0xeb, 0xd3, 0x9b, 0x96, 0xbb, 0x23, 0xb3, 0x9d, 0x86, 0xb0, 0x72, 0x57, 0xc8,
+	0x19, 0xcd, 0x44, 0x63, 0xfb, 0x17, 0xb3, 0xb8, 0x95, 0xe5, 0x51, 0xaf, 0x5a, 0xdd, 0xd7, 0xff,
+	0x06, 0x00, 0x9e, 0x1c, 0x4a, 0xd5, 0x32, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -168,6 +268,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Force sending an arbitrary packet on a channel.
 	SendPacket(ctx context.Context, in *MsgSendPacket, opts ...grpc.CallOption) (*MsgSendPacketResponse, error)
+	// Replace the module parameters.  Only the governance authority may do so.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -187,10 +289,21 @@ func (c *msgClient) SendPacket(ctx context.Context, in *MsgSendPacket, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Force sending an arbitrary packet on a channel.
 	SendPacket(context.Context, *MsgSendPacket) (*MsgSendPacketResponse, error)
+	// Replace the module parameters.  Only the governance authority may do so.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -200,6 +313,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SendPacket(ctx context.Context, req *MsgSendPacket) (*MsgSendPacketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendPacket not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -223,6 +339,24 @@ func _Msg_SendPacket_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "agoric.vibc.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -231,6 +365,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendPacket",
 			Handler:    _Msg_SendPacket_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agoric/vibc/msgs.proto",
@@ -299,6 +437,69 @@ func (m *MsgSendPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -334,6 +535,30 @@ func (m *MsgSendPacketResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -507,6 +732,171 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
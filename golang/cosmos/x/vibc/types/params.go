@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DefaultParams returns default vibc parameters, which do not limit sending.
+func DefaultParams() Params {
+	return Params{
+		DefaultSendLimit: SendLimit{},
+		PortSendLimits:   []PortSendLimit{},
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// GetSendLimit returns the effective send limit of the port.
+func (p Params) GetSendLimit(portID string) SendLimit {
+	for _, portLimit := range p.PortSendLimits {
+		if portLimit.PortId == portID {
+			return portLimit.Limit
+		}
+	}
+	return p.DefaultSendLimit
+}
+
+// ValidateBasic performs basic validation on vibc parameters.
+func (p Params) ValidateBasic() error {
+	seen := make(map[string]bool, len(p.PortSendLimits))
+	for _, portLimit := range p.PortSendLimits {
+		if err := host.PortIdentifierValidator(portLimit.PortId); err != nil {
+			return fmt.Errorf("invalid send limit port %s: %w", portLimit.PortId, err)
+		}
+		if seen[portLimit.PortId] {
+			return fmt.Errorf("duplicate send limit for port %s", portLimit.PortId)
+		}
+		seen[portLimit.PortId] = true
+	}
+	return nil
+}
@@ -0,0 +1,1765 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: agoric/vibc/vibc.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The module governance/configuration parameters.
+type Params struct {
+	// default_send_limit applies to the ports without a port_send_limits entry.
+	DefaultSendLimit SendLimit `protobuf:"bytes,1,opt,name=default_send_limit,json=defaultSendLimit,proto3" json:"default_send_limit" yaml:"default_send_limit"`
+	// port_send_limits are the limits of particular ports.
+	PortSendLimits []PortSendLimit `protobuf:"bytes,2,rep,name=port_send_limits,json=portSendLimits,proto3" json:"port_send_limits" yaml:"port_send_limits"`
+	// async_ack_deadline_blocks is the number of blocks after which vibc
+	// writes an error acknowledgement for a received packet that the controller
+	// has not yet acknowledged.  Zero means never.
+	AsyncAckDeadlineBlocks uint64 `protobuf:"varint,3,opt,name=async_ack_deadline_blocks,json=asyncAckDeadlineBlocks,proto3" json:"async_ack_deadline_blocks,omitempty" yaml:"async_ack_deadline_blocks"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDefaultSendLimit() SendLimit {
+	if m != nil {
+		return m.DefaultSendLimit
+	}
+	return SendLimit{}
+}
+
+func (m *Params) GetPortSendLimits() []PortSendLimit {
+	if m != nil {
+		return m.PortSendLimits
+	}
+	return nil
+}
+
+func (m *Params) GetAsyncAckDeadlineBlocks() uint64 {
+	if m != nil {
+		return m.AsyncAckDeadlineBlocks
+	}
+	return 0
+}
+
+// SendLimit limits the packets that the controller sends on a port.  A value
+// of zero means no limit.
+type SendLimit struct {
+	// max_packets_per_block is the most packets sent in a block.
+	MaxPacketsPerBlock uint64 `protobuf:"varint,1,opt,name=max_packets_per_block,json=maxPacketsPerBlock,proto3" json:"max_packets_per_block,omitempty" yaml:"max_packets_per_block"`
+	// max_bytes_per_block is the most packet data bytes sent in a block.
+	MaxBytesPerBlock uint64 `protobuf:"varint,2,opt,name=max_bytes_per_block,json=maxBytesPerBlock,proto3" json:"max_bytes_per_block,omitempty" yaml:"max_bytes_per_block"`
+	// max_packets_in_flight is the most packets sent that have been neither
+	// acknowledged nor timed out.
+	MaxPacketsInFlight uint64 `protobuf:"varint,3,opt,name=max_packets_in_flight,json=maxPacketsInFlight,proto3" json:"max_packets_in_flight,omitempty" yaml:"max_packets_in_flight"`
+}
+
+func (m *SendLimit) Reset()         { *m = SendLimit{} }
+func (m *SendLimit) String() string { return proto.CompactTextString(m) }
+func (*SendLimit) ProtoMessage()    {}
+func (*SendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{1}
+}
+func (m *SendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendLimit.Merge(m, src)
+}
+func (m *SendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendLimit proto.InternalMessageInfo
+
+func (m *SendLimit) GetMaxPacketsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPacketsPerBlock
+	}
+	return 0
+}
+
+func (m *SendLimit) GetMaxBytesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxBytesPerBlock
+	}
+	return 0
+}
+
+func (m *SendLimit) GetMaxPacketsInFlight() uint64 {
+	if m != nil {
+		return m.MaxPacketsInFlight
+	}
+	return 0
+}
+
+// PortSendLimit is the send limit of a port.
+type PortSendLimit struct {
+	PortId string    `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	Limit  SendLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *PortSendLimit) Reset()         { *m = PortSendLimit{} }
+func (m *PortSendLimit) String() string { return proto.CompactTextString(m) }
+func (*PortSendLimit) ProtoMessage()    {}
+func (*PortSendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{2}
+}
+func (m *PortSendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortSendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortSendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PortSendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSendLimit.Merge(m, src)
+}
+func (m *PortSendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortSendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSendLimit proto.InternalMessageInfo
+
+func (m *PortSendLimit) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PortSendLimit) GetLimit() SendLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return SendLimit{}
+}
+
+// BlockSendUsage is what the controller has sent on a port in a block.
+type BlockSendUsage struct {
+	Height  int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Packets uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes   uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (m *BlockSendUsage) Reset()         { *m = BlockSendUsage{} }
+func (m *BlockSendUsage) String() string { return proto.CompactTextString(m) }
+func (*BlockSendUsage) ProtoMessage()    {}
+func (*BlockSendUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{3}
+}
+func (m *BlockSendUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockSendUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockSendUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockSendUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSendUsage.Merge(m, src)
+}
+func (m *BlockSendUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockSendUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSendUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSendUsage proto.InternalMessageInfo
+
+func (m *BlockSendUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockSendUsage) GetPackets() uint64 {
+	if m != nil {
+		return m.Packets
+	}
+	return 0
+}
+
+func (m *BlockSendUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+// PortSendUsage reports the sending on a port against its limit.
+type PortSendUsage struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// limit is the effective send limit of the port.
+	Limit            SendLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+	PacketsThisBlock uint64    `protobuf:"varint,3,opt,name=packets_this_block,json=packetsThisBlock,proto3" json:"packets_this_block,omitempty"`
+	BytesThisBlock   uint64    `protobuf:"varint,4,opt,name=bytes_this_block,json=bytesThisBlock,proto3" json:"bytes_this_block,omitempty"`
+	PacketsInFlight  uint64    `protobuf:"varint,5,opt,name=packets_in_flight,json=packetsInFlight,proto3" json:"packets_in_flight,omitempty"`
+}
+
+func (m *PortSendUsage) Reset()         { *m = PortSendUsage{} }
+func (m *PortSendUsage) String() string { return proto.CompactTextString(m) }
+func (*PortSendUsage) ProtoMessage()    {}
+func (*PortSendUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{4}
+}
+func (m *PortSendUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortSendUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortSendUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PortSendUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSendUsage.Merge(m, src)
+}
+func (m *PortSendUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortSendUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSendUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSendUsage proto.InternalMessageInfo
+
+func (m *PortSendUsage) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PortSendUsage) GetLimit() SendLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return SendLimit{}
+}
+
+func (m *PortSendUsage) GetPacketsThisBlock() uint64 {
+	if m != nil {
+		return m.PacketsThisBlock
+	}
+	return 0
+}
+
+func (m *PortSendUsage) GetBytesThisBlock() uint64 {
+	if m != nil {
+		return m.BytesThisBlock
+	}
+	return 0
+}
+
+func (m *PortSendUsage) GetPacketsInFlight() uint64 {
+	if m != nil {
+		return m.PacketsInFlight
+	}
+	return 0
+}
+
+// PendingAcknowledgement is a packet received on a port bound by vibc, whose
+// acknowledgement the controller has yet to write.
+type PendingAcknowledgement struct {
+	Packet types.Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// receive_height is the height of the block in which the packet was
+	// received.
+	ReceiveHeight int64 `protobuf:"varint,2,opt,name=receive_height,json=receiveHeight,proto3" json:"receive_height,omitempty"`
+}
+
+func (m *PendingAcknowledgement) Reset()         { *m = PendingAcknowledgement{} }
+func (m *PendingAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*PendingAcknowledgement) ProtoMessage()    {}
+func (*PendingAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{5}
+}
+func (m *PendingAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAcknowledgement.Merge(m, src)
+}
+func (m *PendingAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAcknowledgement proto.InternalMessageInfo
+
+func (m *PendingAcknowledgement) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *PendingAcknowledgement) GetReceiveHeight() int64 {
+	if m != nil {
+		return m.ReceiveHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "agoric.vibc.Params")
+	proto.RegisterType((*SendLimit)(nil), "agoric.vibc.SendLimit")
+	proto.RegisterType((*PortSendLimit)(nil), "agoric.vibc.PortSendLimit")
+	proto.RegisterType((*BlockSendUsage)(nil), "agoric.vibc.BlockSendUsage")
+	proto.RegisterType((*PortSendUsage)(nil), "agoric.vibc.PortSendUsage")
+	proto.RegisterType((*PendingAcknowledgement)(nil), "agoric.vibc.PendingAcknowledgement")
+}
+
+func init() { proto.RegisterFile("agoric/vibc/vibc.proto", fileDescriptor_108461a452569267) }
+
+var fileDescriptor_108461a452569267 = []byte{
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x93, 0x34, 0xbd, 0x9d, 0xaa, 0xb9, 0xb9, 0x73, 0x4b, 0x9a, 0x06, 0x64, 0xa7, 0x16,
+	0x48, 0x11, 0x3f, 0xb6, 0x5a, 0x16, 0x88, 0xee, 0x6a, 0x21, 0x44, 0x25, 0x90, 0x82, 0x0b, 0x12,
+	0x62, 0x63, 0x4d, 0xec, 0xa9, 0x33, 0x8a, 0x3d, 0x63, 0x79, 0xdc, 0x90, 0xf0, 0x08, 0xac, 0x58,
+	0xb2, 0xec, 0xbb, 0xb0, 0xe9, 0xb2, 0x4b, 0x56, 0x51, 0xd5, 0x6e, 0x58, 0xe7, 0x09, 0x90, 0x67,
+	0x26, 0xcd, 0x4f, 0xa1, 0x1b, 0x36, 0x96, 0xe7, 0x3b, 0xdf, 0xf9, 0xfd, 0x8e, 0x0e, 0xa8, 0xa3,
+	0x90, 0xa5, 0xc4, 0xb7, 0x07, 0xa4, 0x2b, 0x3f, 0x56, 0x92, 0xb2, 0x8c, 0xc1, 0x75, 0x89, 0x5b,
+	0x39, 0xd4, 0xdc, 0x0c, 0x59, 0xc8, 0x04, 0x6e, 0xe7, 0x7f, 0x92, 0xd2, 0xdc, 0xc9, 0x5d, 0x7c,
+	0x96, 0x62, 0xdb, 0xef, 0x21, 0x4a, 0x71, 0x64, 0x0f, 0x76, 0xa7, 0xbf, 0x92, 0x62, 0x7e, 0x2f,
+	0x82, 0x4a, 0x07, 0xa5, 0x28, 0xe6, 0x30, 0x04, 0x30, 0xc0, 0xc7, 0xe8, 0x24, 0xca, 0x3c, 0x8e,
+	0x69, 0xe0, 0x45, 0x24, 0x26, 0x59, 0x43, 0x6b, 0x69, 0xed, 0xf5, 0xbd, 0xba, 0x35, 0x97, 0xcd,
+	0x3a, 0xc2, 0x34, 0x78, 0x9d, 0x5b, 0x9d, 0x9d, 0xb3, 0xb1, 0x51, 0x98, 0x8c, 0x8d, 0xed, 0x11,
+	0x8a, 0xa3, 0x7d, 0xf3, 0xa6, 0xbf, 0xe9, 0xd6, 0x14, 0x78, 0xed, 0x04, 0x31, 0xa8, 0x25, 0x2c,
+	0x9d, 0x67, 0xf1, 0x46, 0xb1, 0x55, 0x6a, 0xaf, 0xef, 0x35, 0x17, 0xd2, 0x74, 0x58, 0x3a, 0xf3,
+	0x72, 0x0c, 0x95, 0x6a, 0x4b, 0xa6, 0x5a, 0x8e, 0x60, 0xba, 0xd5, 0x64, 0x9e, 0xcf, 0xa1, 0x07,
+	0xb6, 0x11, 0x1f, 0x51, 0xdf, 0x43, 0x7e, 0xdf, 0x0b, 0x30, 0x0a, 0x22, 0x42, 0xb1, 0xd7, 0x8d,
+	0x98, 0xdf, 0xe7, 0x8d, 0x52, 0x4b, 0x6b, 0x97, 0x9d, 0xfb, 0x93, 0xb1, 0xd1, 0x92, 0xf1, 0xfe,
+	0x48, 0x35, 0xdd, 0xba, 0xb0, 0x1d, 0xf8, 0xfd, 0x17, 0xca, 0xe2, 0x08, 0xc3, 0xfe, 0x3f, 0xdf,
+	0x4e, 0x8d, 0xc2, 0xcf, 0x53, 0x43, 0x33, 0xbf, 0x14, 0xc1, 0xda, 0xac, 0xbf, 0x23, 0x70, 0x27,
+	0x46, 0x43, 0x2f, 0x41, 0x7e, 0x1f, 0x67, 0xdc, 0x4b, 0x70, 0x2a, 0x43, 0x89, 0x59, 0x96, 0x9d,
+	0xd6, 0x64, 0x6c, 0xdc, 0x93, 0x49, 0x7f, 0x4b, 0x33, 0x5d, 0x18, 0xa3, 0x61, 0x47, 0xc2, 0x1d,
+	0x9c, 0x8a, 0x6c, 0xf0, 0x0d, 0xf8, 0x3f, 0x67, 0x77, 0x47, 0x19, 0x9e, 0x0f, 0x59, 0x14, 0x21,
+	0xf5, 0xc9, 0xd8, 0x68, 0xce, 0x42, 0x2e, 0x91, 0x4c, 0xb7, 0x16, 0xa3, 0xa1, 0x93, 0x83, 0xd7,
+	0xe1, 0x96, 0x6a, 0x24, 0xd4, 0x3b, 0x8e, 0x48, 0xd8, 0xcb, 0x1a, 0xa5, 0xdb, 0x6a, 0xbc, 0xa6,
+	0x2d, 0xd4, 0x78, 0x48, 0x5f, 0x0a, 0x70, 0xbf, 0x2c, 0x86, 0x31, 0x00, 0x1b, 0x0b, 0xca, 0xc1,
+	0x47, 0x60, 0x55, 0xa8, 0x45, 0x02, 0x31, 0x81, 0x35, 0x07, 0x4e, 0xc6, 0x46, 0x75, 0x4e, 0x46,
+	0x12, 0x98, 0x6e, 0x25, 0xff, 0x3b, 0x0c, 0xe0, 0x1e, 0x58, 0x91, 0x8b, 0x57, 0xbc, 0x75, 0xf1,
+	0xca, 0xf9, 0x36, 0xb8, 0x92, 0xaa, 0xf2, 0x7e, 0x00, 0x55, 0xd1, 0x5b, 0x4e, 0x7a, 0xcf, 0x51,
+	0x88, 0x61, 0x1d, 0x54, 0x7a, 0x58, 0x74, 0x95, 0xe7, 0x2d, 0xb9, 0xea, 0x05, 0x1b, 0x60, 0x55,
+	0x75, 0x24, 0xe7, 0xe7, 0x4e, 0x9f, 0x70, 0x13, 0xac, 0x88, 0xe1, 0xc9, 0x31, 0xb8, 0xf2, 0x61,
+	0x5e, 0x68, 0xb3, 0x96, 0x64, 0xe4, 0xad, 0xa5, 0x96, 0xfe, 0xa6, 0x7c, 0xf8, 0x18, 0xc0, 0xe9,
+	0x80, 0xb3, 0x1e, 0xe1, 0x4a, 0x59, 0x59, 0x41, 0x4d, 0x59, 0xde, 0xf5, 0x08, 0x97, 0xca, 0xb5,
+	0x41, 0x4d, 0xea, 0x3b, 0xc7, 0x2d, 0x0b, 0x6e, 0x55, 0xe0, 0x33, 0xe6, 0x43, 0xf0, 0xdf, 0x4d,
+	0x7d, 0x57, 0x04, 0xf5, 0xdf, 0x64, 0x51, 0x3a, 0xf3, 0x33, 0xa8, 0x77, 0x30, 0x0d, 0x08, 0x0d,
+	0x0f, 0xfc, 0x3e, 0x65, 0x9f, 0x22, 0x1c, 0x84, 0x38, 0xc6, 0x34, 0x83, 0xcf, 0x41, 0x45, 0x92,
+	0xd5, 0x29, 0xb8, 0x6b, 0xe5, 0xad, 0xe4, 0x57, 0xc5, 0x9a, 0x9e, 0x92, 0xc1, 0xae, 0x25, 0x57,
+	0x41, 0xf5, 0xa5, 0x1c, 0xe0, 0x03, 0x50, 0x4d, 0xb1, 0x8f, 0xc9, 0x00, 0x7b, 0x4a, 0x87, 0xa2,
+	0xd0, 0x61, 0x43, 0xa1, 0xaf, 0x04, 0xe8, 0xbc, 0x3d, 0xbb, 0xd4, 0xb5, 0xf3, 0x4b, 0x5d, 0xbb,
+	0xb8, 0xd4, 0xb5, 0xaf, 0x57, 0x7a, 0xe1, 0xfc, 0x4a, 0x2f, 0xfc, 0xb8, 0xd2, 0x0b, 0x1f, 0x9f,
+	0x85, 0x24, 0xeb, 0x9d, 0x74, 0x2d, 0x9f, 0xc5, 0xf6, 0x81, 0x3c, 0x83, 0x72, 0x9e, 0x4f, 0x78,
+	0xd0, 0xb7, 0x43, 0x16, 0x21, 0x1a, 0xda, 0x3e, 0xe3, 0x31, 0xe3, 0xf6, 0x50, 0x5e, 0xc8, 0x6c,
+	0x94, 0x60, 0xde, 0xad, 0x88, 0xeb, 0xf6, 0xf4, 0xd7, 0x00, 0xc6, 0x8e, 0x6d, 0x4a, 0x3d, 0x05,
+	0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DefaultSendLimit.Equal(&that1.DefaultSendLimit) {
+		return false
+	}
+	if len(this.PortSendLimits) != len(that1.PortSendLimits) {
+		return false
+	}
+	for i := range this.PortSendLimits {
+		if !this.PortSendLimits[i].Equal(&that1.PortSendLimits[i]) {
+			return false
+		}
+	}
+	if this.AsyncAckDeadlineBlocks != that1.AsyncAckDeadlineBlocks {
+		return false
+	}
+	return true
+}
+func (this *SendLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendLimit)
+	if !ok {
+		that2, ok := that.(SendLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxPacketsPerBlock != that1.MaxPacketsPerBlock {
+		return false
+	}
+	if this.MaxBytesPerBlock != that1.MaxBytesPerBlock {
+		return false
+	}
+	if this.MaxPacketsInFlight != that1.MaxPacketsInFlight {
+		return false
+	}
+	return true
+}
+func (this *PortSendLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PortSendLimit)
+	if !ok {
+		that2, ok := that.(PortSendLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PortId != that1.PortId {
+		return false
+	}
+	if !this.Limit.Equal(&that1.Limit) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AsyncAckDeadlineBlocks != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.AsyncAckDeadlineBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortSendLimits) > 0 {
+		for iNdEx := len(m.PortSendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PortSendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.DefaultSendLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPacketsInFlight != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.MaxPacketsInFlight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBytesPerBlock != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.MaxBytesPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxPacketsPerBlock != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.MaxPacketsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PortSendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortSendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortSendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockSendUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockSendUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockSendUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Packets != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.Packets))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PortSendUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortSendUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortSendUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PacketsInFlight != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.PacketsInFlight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BytesThisBlock != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.BytesThisBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PacketsThisBlock != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.PacketsThisBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveHeight != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.ReceiveHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVibc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVibc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DefaultSendLimit.Size()
+	n += 1 + l + sovVibc(uint64(l))
+	if len(m.PortSendLimits) > 0 {
+		for _, e := range m.PortSendLimits {
+			l = e.Size()
+			n += 1 + l + sovVibc(uint64(l))
+		}
+	}
+	if m.AsyncAckDeadlineBlocks != 0 {
+		n += 1 + sovVibc(uint64(m.AsyncAckDeadlineBlocks))
+	}
+	return n
+}
+
+func (m *SendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPacketsPerBlock != 0 {
+		n += 1 + sovVibc(uint64(m.MaxPacketsPerBlock))
+	}
+	if m.MaxBytesPerBlock != 0 {
+		n += 1 + sovVibc(uint64(m.MaxBytesPerBlock))
+	}
+	if m.MaxPacketsInFlight != 0 {
+		n += 1 + sovVibc(uint64(m.MaxPacketsInFlight))
+	}
+	return n
+}
+
+func (m *PortSendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovVibc(uint64(l))
+	return n
+}
+
+func (m *BlockSendUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovVibc(uint64(m.Height))
+	}
+	if m.Packets != 0 {
+		n += 1 + sovVibc(uint64(m.Packets))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovVibc(uint64(m.Bytes))
+	}
+	return n
+}
+
+func (m *PortSendUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovVibc(uint64(l))
+	if m.PacketsThisBlock != 0 {
+		n += 1 + sovVibc(uint64(m.PacketsThisBlock))
+	}
+	if m.BytesThisBlock != 0 {
+		n += 1 + sovVibc(uint64(m.BytesThisBlock))
+	}
+	if m.PacketsInFlight != 0 {
+		n += 1 + sovVibc(uint64(m.PacketsInFlight))
+	}
+	return n
+}
+
+func (m *PendingAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovVibc(uint64(l))
+	if m.ReceiveHeight != 0 {
+		n += 1 + sovVibc(uint64(m.ReceiveHeight))
+	}
+	return n
+}
+
+func sovVibc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVibc(x uint64) (n int) {
+	return sovVibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultSendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortSendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortSendLimits = append(m.PortSendLimits, PortSendLimit{})
+			if err := m.PortSendLimits[len(m.PortSendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncAckDeadlineBlocks", wireType)
+			}
+			m.AsyncAckDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AsyncAckDeadlineBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsPerBlock", wireType)
+			}
+			m.MaxPacketsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytesPerBlock", wireType)
+			}
+			m.MaxBytesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsInFlight", wireType)
+			}
+			m.MaxPacketsInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketsInFlight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortSendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortSendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortSendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockSendUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockSendUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockSendUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			m.Packets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Packets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortSendUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortSendUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortSendUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsThisBlock", wireType)
+			}
+			m.PacketsThisBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsThisBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesThisBlock", wireType)
+			}
+			m.BytesThisBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesThisBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsInFlight", wireType)
+			}
+			m.PacketsInFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsInFlight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveHeight", wireType)
+			}
+			m.ReceiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVibc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVibc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVibc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVibc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVibc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVibc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVibc = fmt.Errorf("proto: unexpected end of group")
+)
@@ -626,6 +626,12 @@ export default async function main(progname, args, { env, homedir, agcc }) {
       case 'discard': {
         return discardStateSyncExport();
       }
+      case 'abort': {
+        // Sent by cosmos when an export operation timed out or was cancelled.
+        // Any in-progress export is stopped and its partial export directory
+        // removed, which also fails a pending 'retrieve'.
+        return discardStateSyncExport();
+      }
       case 'retrieve': {
         const exportData = stateSyncExport;
         if (!exportData || !exportData.exporter) {
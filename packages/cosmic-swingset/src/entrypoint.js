@@ -14,6 +14,7 @@ import process from 'process';
 import './anylogger-agoric.js';
 import anylogger from 'anylogger';
 import main from './chain-main.js';
+import { makeSocketAgcc } from './vm-socket.js';
 
 const log = anylogger('ag-chain-cosmos');
 
@@ -21,7 +22,8 @@ main(process.argv[1], process.argv.splice(2), {
   path,
   homedir: os.homedir(),
   env: process.env,
-  agcc,
+  // Link to a separate agd if asked, rather than running it embedded.
+  agcc: makeSocketAgcc({ env: process.env, homedir: os.homedir() }) || agcc,
 }).then(
   _res => 0,
   rej => {
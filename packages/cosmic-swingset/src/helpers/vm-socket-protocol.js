@@ -0,0 +1,195 @@
+// @ts-check
+// The VM's side of the protocol spoken with agd over a socket, as described
+// in golang/cosmos/vm/vmsocket and golang/cosmos/vm/jsonrpcconn.  This module
+// is also loaded by the socket worker, so it must not depend on SES.
+
+export const PROTOCOL_NAME = 'agvm-jsonrpc';
+export const PROTOCOL_VERSION = 1;
+
+export const FRAMING_JSON = 'json';
+export const FRAMING_LENGTH_PREFIXED = 'length-prefixed';
+
+/**
+ * @typedef {object} Hello
+ * @property {string} [protocol]
+ * @property {number} [version]
+ * @property {'agd' | 'vm'} [role]
+ * @property {string} [software]
+ * @property {number} [blockHeight] last committed block height, or 0 if none
+ * @property {string} [framing] empty means JSON framing
+ * @property {string} [error] why the sender is refusing the connection
+ */
+
+/**
+ * @param {string | undefined} name
+ * @returns {string}
+ */
+export const parseFraming = name => {
+  switch (name) {
+    case undefined:
+    case '':
+    case FRAMING_JSON:
+      return FRAMING_JSON;
+    case FRAMING_LENGTH_PREFIXED:
+      return FRAMING_LENGTH_PREFIXED;
+    default:
+      throw Error(`unknown JSON-RPC framing ${JSON.stringify(name)}`);
+  }
+};
+
+/**
+ * Parse an endpoint of the form unix:///path/to/socket or
+ * tcp://127.0.0.1:port into options for net.connect or server.listen.  Like
+ * agd, refuse TCP endpoints that are not on a loopback interface.
+ *
+ * @param {string} endpoint
+ * @returns {{ path: string } | { host: string, port: number }}
+ */
+export const parseEndpoint = endpoint => {
+  const unixMatch = endpoint.match(/^unix:\/\/(.+)$/);
+  if (unixMatch) {
+    return { path: unixMatch[1] };
+  }
+  const tcpMatch = endpoint.match(/^tcp:\/\/(?:\[([^\]]+)\]|([^:]+)):(\d+)$/);
+  if (!tcpMatch) {
+    throw Error(
+      `VM endpoint ${JSON.stringify(endpoint)} must be unix:///path or tcp://host:port`,
+    );
+  }
+  const host = tcpMatch[1] || tcpMatch[2];
+  if (!/^(localhost|127(\.\d+){3}|::1)$/.test(host)) {
+    throw Error(
+      `VM endpoint ${JSON.stringify(endpoint)} is not a loopback address`,
+    );
+  }
+  return { host, port: Number(tcpMatch[3]) };
+};
+
+/**
+ * @param {Hello} hello
+ * @returns {string} the newline-terminated Hello to send
+ */
+export const encodeHello = hello =>
+  `${JSON.stringify({
+    ...hello,
+    protocol: PROTOCOL_NAME,
+    version: PROTOCOL_VERSION,
+    role: 'vm',
+  })}\n`;
+
+/**
+ * Check agd's Hello, throwing if it refuses us or does not speak our
+ * protocol and framing.
+ *
+ * @param {Hello} hello
+ * @param {string} framing
+ */
+export const checkAgdHello = (hello, framing) => {
+  if (hello.error) {
+    throw Error(`agd refused connection: ${hello.error}`);
+  }
+  if (hello.protocol !== PROTOCOL_NAME) {
+    throw Error(`agd speaks protocol ${JSON.stringify(hello.protocol)}`);
+  }
+  if (hello.version !== PROTOCOL_VERSION) {
+    throw Error(
+      `agd speaks ${PROTOCOL_NAME} version ${hello.version}, not ${PROTOCOL_VERSION}`,
+    );
+  }
+  if (hello.role !== 'agd') {
+    throw Error(`peer has role ${JSON.stringify(hello.role)}, not "agd"`);
+  }
+  if (parseFraming(hello.framing) !== framing) {
+    throw Error(
+      `agd uses framing ${JSON.stringify(hello.framing)}, not ${JSON.stringify(framing)}`,
+    );
+  }
+};
+
+/**
+ * @param {string} framing
+ * @param {object} message a JSON-RPC request or response
+ * @returns {Buffer}
+ */
+export const encodeMessage = (framing, message) => {
+  const body = Buffer.from(JSON.stringify(message));
+  if (framing !== FRAMING_LENGTH_PREFIXED) {
+    return body;
+  }
+  const frame = Buffer.alloc(4 + body.length);
+  frame.writeUInt32BE(body.length, 0);
+  body.copy(frame, 4);
+  return frame;
+};
+
+/**
+ * Make a function that accepts chunks of the connection after the Hellos, and
+ * calls onMessage with the text of each whole message.  JSON messages are
+ * delimited by matching brackets outside of strings, as agd does, and scanned
+ * only once however they are split into chunks.
+ *
+ * @param {string} framing
+ * @param {(text: string) => void} onMessage
+ * @returns {(chunk: Buffer) => void}
+ */
+export const makeMessageReader = (framing, onMessage) => {
+  let buffered = Buffer.alloc(0);
+
+  if (framing === FRAMING_LENGTH_PREFIXED) {
+    return chunk => {
+      buffered = Buffer.concat([buffered, chunk]);
+      while (buffered.length >= 4) {
+        const size = buffered.readUInt32BE(0);
+        if (buffered.length < 4 + size) {
+          return;
+        }
+        onMessage(buffered.subarray(4, 4 + size).toString());
+        buffered = buffered.subarray(4 + size);
+      }
+    };
+  }
+
+  // The scanning state of the partial message at the start of buffered.
+  let scanned = 0;
+  let depth = 0;
+  let inString = false;
+  let escaped = false;
+  return chunk => {
+    buffered = Buffer.concat([buffered, chunk]);
+    while (scanned < buffered.length) {
+      const c = buffered[scanned];
+      scanned += 1;
+      if (depth === 0) {
+        if (c === 0x7b /* { */) {
+          depth = 1;
+        } else if (c === 0x20 || c === 0x09 || c === 0x0a || c === 0x0d) {
+          buffered = buffered.subarray(scanned);
+          scanned = 0;
+        } else {
+          throw Error(
+            `message starts with ${JSON.stringify(String.fromCharCode(c))}, not an object`,
+          );
+        }
+      } else if (inString) {
+        if (escaped) {
+          escaped = false;
+        } else if (c === 0x5c /* \ */) {
+          escaped = true;
+        } else if (c === 0x22 /* " */) {
+          inString = false;
+        }
+      } else if (c === 0x22) {
+        inString = true;
+      } else if (c === 0x7b || c === 0x5b /* [ */) {
+        depth += 1;
+      } else if (c === 0x7d /* } */ || c === 0x5d /* ] */) {
+        depth -= 1;
+        if (depth === 0) {
+          onMessage(buffered.subarray(0, scanned).toString());
+          buffered = buffered.subarray(scanned);
+          scanned = 0;
+        }
+      }
+    }
+  };
+};
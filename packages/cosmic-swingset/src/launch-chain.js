@@ -25,6 +25,7 @@ import {
 } from '@agoric/swingset-vat';
 import { waitUntilQuiescent } from '@agoric/internal/src/lib-nodejs/waitUntilQuiescent.js';
 import { assert, Fail } from '@agoric/assert';
+import { kslot, kunser } from '@agoric/kmarshal';
 import { openSwingStore } from '@agoric/swing-store';
 import { BridgeId as BRIDGE_ID } from '@agoric/internal';
 import { makeWithQueue } from '@agoric/internal/src/queue.js';
@@ -369,6 +370,18 @@ export async function launch({
    */
   const runThisBlock = makeQueue(makeQueueStorageMock().storage);
 
+  /**
+   * Governance core-evals whose outcome is not yet reported to x/swingset,
+   * as the kpid of each eval's result and the content_hash under which
+   * x/swingset records it. A kpid without a content hash is only released.
+   * Saved in the swing-store so that it is committed with the kernel state.
+   *
+   * @type {{ kpid: string; contentHash?: string }[]}
+   */
+  let pendingCoreEvals = JSON.parse(
+    kvStore.get(getHostKey('pendingCoreEvals')) || '[]',
+  );
+
   // Not to be confused with the gas model, this meter is for OpenTelemetry.
   const metricMeter = metricsProvider.getMeter('ag-chain-cosmos');
   const slogCallbacks = makeSlogCallbacks({
@@ -506,6 +519,82 @@ export async function launch({
     bridgeInbound(source, body);
   }
 
+  function savePendingCoreEvals() {
+    kvStore.set(
+      getHostKey('pendingCoreEvals'),
+      JSON.stringify(pendingCoreEvals),
+    );
+  }
+
+  /**
+   * Queue a core-eval to the bootstrap vat's core-eval handler. The evals of
+   * a governance proposal carry the content_hash under which x/swingset
+   * records their outcome, so each of them is sent as its own message whose
+   * result is kept until reportCoreEvalOutcomes sees it settle. Other evals,
+   * such as the core proposals of a chain upgrade, go over the bridge.
+   *
+   * @param {{ evals: { content_hash?: string }[] }} action
+   * @param {number} inboundNum
+   */
+  async function doCoreEval(action, inboundNum) {
+    const { evals } = action;
+    if (!bridgeOutbound || !evals.some(({ content_hash: hash }) => hash)) {
+      return doBridgeInbound(BRIDGE_ID.CORE, action, inboundNum);
+    }
+    controller.writeSlogObject({
+      type: 'cosmic-swingset-bridge-inbound',
+      inboundNum,
+      source: BRIDGE_ID.CORE,
+    });
+    const handlerKpid = controller.queueToVatRoot('bootstrap', 'consumeItem', [
+      'coreEvalBridgeHandler',
+    ]);
+    pendingCoreEvals.push({ kpid: handlerKpid });
+    for (const coreEval of evals) {
+      const kpid = controller.queueToVatObject(
+        kslot(handlerKpid),
+        'fromBridge',
+        [harden({ ...action, evals: [coreEval] })],
+      );
+      pendingCoreEvals.push({ kpid, contentHash: coreEval.content_hash });
+    }
+    savePendingCoreEvals();
+  }
+
+  /**
+   * Report to x/swingset the outcome of each governance core-eval whose
+   * result has settled, and release the result.
+   */
+  function reportCoreEvalOutcomes() {
+    if (pendingCoreEvals.length === 0) {
+      return;
+    }
+    const stillPending = [];
+    for (const pending of pendingCoreEvals) {
+      const { kpid, contentHash } = pending;
+      const status = controller.kpStatus(kpid);
+      if (status === 'unresolved') {
+        stillPending.push(pending);
+        continue;
+      }
+      const resolution = controller.kpResolution(kpid, { incref: false });
+      if (!contentHash || !bridgeOutbound) {
+        continue;
+      }
+      const error = status === 'rejected' ? `${kunser(resolution)}` : '';
+      try {
+        bridgeOutbound(BRIDGE_ID.SWINGSET, {
+          method: 'coreEvalOutcome',
+          args: [{ content_hash: contentHash, error }],
+        });
+      } catch (e) {
+        blockManagerConsole.warn('CORE_EVAL outcome warn:', e);
+      }
+    }
+    pendingCoreEvals = stillPending;
+    savePendingCoreEvals();
+  }
+
   async function installBundle(bundleJson) {
     let bundle;
     try {
@@ -523,6 +612,24 @@ export async function launch({
 
     const { endoZipBase64Sha512 } = bundle;
 
+    // Tell x/swingset whether the bundle was installed, so that a rejected
+    // bundle may be submitted again.
+    if (bridgeOutbound) {
+      try {
+        bridgeOutbound(BRIDGE_ID.SWINGSET, {
+          method: 'bundleInstallOutcome',
+          args: [
+            {
+              bundle_id: `b1-${endoZipBase64Sha512}`,
+              error: error === null ? '' : `${error}`,
+            },
+          ],
+        });
+      } catch (e) {
+        blockManagerConsole.warn('INSTALL_BUNDLE outcome warn:', e);
+      }
+    }
+
     if (installationPublisher === undefined) {
       return;
     }
@@ -592,7 +699,7 @@ export async function launch({
       }
 
       case ActionType.CORE_EVAL: {
-        p = doBridgeInbound(BRIDGE_ID.CORE, action, inboundNum);
+        p = doCoreEval(action, inboundNum);
         break;
       }
 
@@ -691,6 +798,8 @@ export async function launch({
 
     await runKernel(runSwingset, blockHeight, blockTime);
 
+    reportCoreEvalOutcomes();
+
     if (END_BLOCK_SPIN_MS) {
       // Introduce a busy-wait to artificially put load on the chain.
       const startTime = Date.now();
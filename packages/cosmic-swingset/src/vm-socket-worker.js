@@ -0,0 +1,192 @@
+// @ts-check
+// The worker thread of vm-socket.js, which owns the connection to agd so that
+// the main thread can block while sending to agd.  It speaks the Hello and
+// then JSON-RPC, relaying agd's calls to the main thread, and the main
+// thread's sends to agd.
+import fs from 'fs';
+import net from 'net';
+import { parentPort, workerData } from 'worker_threads';
+
+import {
+  checkAgdHello,
+  encodeHello,
+  encodeMessage,
+  makeMessageReader,
+  parseEndpoint,
+} from './helpers/vm-socket-protocol.js';
+
+/** How long to wait between attempts to dial agd. */
+const REDIAL_INTERVAL_MS = 1000;
+/** How long agd has to reply to our Hello, as agd allows us. */
+const HANDSHAKE_TIMEOUT_MS = 10_000;
+
+const { endpoint, listen, framing, hello, signalBuffer, sendPort } =
+  workerData;
+const signal = new Int32Array(signalBuffer);
+if (!parentPort) {
+  throw Error('vm-socket-worker.js must run in a worker thread');
+}
+const mainPort = parentPort;
+
+/** @type {net.Socket | undefined} */
+let connection;
+/** @type {number | undefined} */
+let pendingSendId;
+let nextSendId = 1;
+
+/**
+ * Reply to the blocked main thread.
+ *
+ * @param {{ result?: string, error?: string }} reply
+ */
+const finishSend = reply => {
+  pendingSendId = undefined;
+  sendPort.postMessage(reply);
+  Atomics.store(signal, 0, 1);
+  Atomics.notify(signal, 0);
+};
+
+/** @param {object} message */
+const write = message => {
+  if (connection) {
+    connection.write(encodeMessage(framing, message));
+  }
+};
+
+sendPort.on('message', ({ port, data }) => {
+  if (!connection) {
+    finishSend({ error: 'not connected to agd' });
+    return;
+  }
+  pendingSendId = nextSendId;
+  nextSendId += 1;
+  write({
+    method: 'agd.ReceiveMessage',
+    params: [{ Port: port, Data: data, NeedsReply: true }],
+    id: pendingSendId,
+  });
+});
+
+mainPort.on('message', ({ type, id, result, error }) => {
+  if (type !== 'reply') {
+    return;
+  }
+  if (error !== undefined) {
+    write({ id, result: null, error });
+  } else {
+    write({ id, result, error: null });
+  }
+});
+
+/** @param {string} text */
+const onMessage = text => {
+  const msg = JSON.parse(text);
+  if (msg.method !== undefined) {
+    const [params] = msg.params || [];
+    if (msg.method !== 'agvm.ReceiveMessage' || !params) {
+      const error = `unknown method ${msg.method}`;
+      write({ id: msg.id, result: null, error });
+      return;
+    }
+    mainPort.postMessage({
+      type: 'request',
+      id: msg.id,
+      port: params.Port,
+      data: params.Data,
+    });
+  } else if (msg.id !== undefined && msg.id === pendingSendId) {
+    if (msg.error !== null && msg.error !== undefined) {
+      finishSend({ error: `${msg.error}` });
+    } else {
+      finishSend({ result: msg.result });
+    }
+  }
+};
+
+/**
+ * Exchange Hellos on a new socket, then serve JSON-RPC on it.
+ *
+ * @param {net.Socket} socket
+ */
+const serve = socket => {
+  let buffered = Buffer.alloc(0);
+  /** @type {((chunk: Buffer) => void) | undefined} */
+  let reader;
+  let closeReason = 'connection to agd lost';
+  /** @param {unknown} err */
+  const fail = err => {
+    closeReason = `${err}`;
+    socket.destroy();
+  };
+  const timer = setTimeout(
+    () => fail(Error('timed out waiting for the agd Hello')),
+    HANDSHAKE_TIMEOUT_MS,
+  );
+
+  socket.on('data', chunk => {
+    try {
+      if (reader) {
+        reader(chunk);
+        return;
+      }
+      buffered = Buffer.concat([buffered, chunk]);
+      const newline = buffered.indexOf(0x0a);
+      if (newline < 0) {
+        return;
+      }
+      clearTimeout(timer);
+      const theirs = JSON.parse(buffered.subarray(0, newline).toString());
+      checkAgdHello(theirs, framing);
+      connection = socket;
+      reader = makeMessageReader(framing, onMessage);
+      mainPort.postMessage({ type: 'connected', software: theirs.software });
+      reader(buffered.subarray(newline + 1));
+    } catch (err) {
+      fail(err);
+    }
+  });
+  socket.on('error', fail);
+  socket.on('close', () => {
+    clearTimeout(timer);
+    connection = undefined;
+    if (pendingSendId !== undefined) {
+      finishSend({ error: closeReason });
+    }
+    mainPort.postMessage({ type: 'closed', error: closeReason });
+  });
+  socket.write(encodeHello(hello));
+};
+
+const options = parseEndpoint(endpoint);
+if (listen) {
+  if ('path' in options && fs.existsSync(options.path)) {
+    // Remove a stale socket, as agd does.
+    if (!fs.statSync(options.path).isSocket()) {
+      throw Error(`${options.path} exists and is not a socket`);
+    }
+    fs.unlinkSync(options.path);
+  }
+  // Accept a single connection, since we exit once it is lost.
+  const server = net.createServer(socket => {
+    server.close();
+    serve(socket);
+  });
+  server.on('error', err =>
+    mainPort.postMessage({ type: 'closed', error: `${err}` }),
+  );
+  server.listen(options);
+} else {
+  const dial = () => {
+    const socket = net.connect(options);
+    const retry = () => {
+      socket.destroy();
+      setTimeout(dial, REDIAL_INTERVAL_MS);
+    };
+    socket.once('error', retry);
+    socket.once('connect', () => {
+      socket.off('error', retry);
+      serve(socket);
+    });
+  };
+  dial();
+}
@@ -0,0 +1,140 @@
+// @ts-check
+// The VM's side of a link to an agd started with --split-vm-listen or
+// --split-vm-dial, as described in golang/cosmos/vm/vmsocket.  It stands in
+// for the embedded @agoric/cosmos, so chain-main.js runs unchanged.
+import { MessageChannel, Worker, receiveMessageOnPort } from 'worker_threads';
+
+import { Fail } from '@agoric/assert';
+import { isSwingStore, openSwingStore } from '@agoric/swing-store';
+
+import { makeProcessValue } from './helpers/process-value.js';
+import { parseFraming } from './helpers/vm-socket-protocol.js';
+
+/**
+ * Return the height of the last block committed by the swing store in
+ * stateDBDir, or 0 if none, which agd checks against its own.
+ *
+ * @param {string} stateDBDir
+ */
+export const readCommittedHeight = stateDBDir => {
+  if (!isSwingStore(stateDBDir)) {
+    return 0;
+  }
+  const { hostStorage } = openSwingStore(stateDBDir);
+  try {
+    return Number(hostStorage.kvStore.get('host.height') || 0);
+  } finally {
+    void hostStorage.close();
+  }
+};
+
+/**
+ * Make a replacement for the embedded agcc that links to agd over a socket,
+ * if AGVM_DIAL (where agd listens) or AGVM_LISTEN (where agd dials) is set in
+ * the environment, with the jsonrpcconn framing of AGVM_FRAMING.
+ *
+ * A VM cannot be initialized twice, so once the connection is lost the process
+ * exits for its supervisor to restart it, and agd re-sends AG_COSMOS_INIT to
+ * the new one.
+ *
+ * @param {object} powers
+ * @param {Record<string, string | undefined>} powers.env
+ * @param {string} powers.homedir
+ * @param {string} [powers.software] describes us in our Hello
+ */
+export const makeSocketAgcc = ({
+  env,
+  homedir,
+  software = `ag-chain-cosmos (Node.js ${process.version})`,
+}) => {
+  const { AGVM_DIAL: dial, AGVM_LISTEN: listen, AGVM_FRAMING } = env;
+  if (!dial && !listen) {
+    return undefined;
+  }
+  !(dial && listen) || Fail`Cannot set both AGVM_DIAL and AGVM_LISTEN`;
+  const framing = parseFraming(AGVM_FRAMING);
+
+  /** @type {MessageChannel | undefined} */
+  let sendChannel;
+  const signal = new Int32Array(new SharedArrayBuffer(4));
+
+  /**
+   * @param {number} _nodePort agd always sends to the first port registered
+   * @param {(port: number, str: string, replier: { resolve: (res: string) => void, reject: (err: string) => void }) => void} fromGo
+   * @param {string[]} args
+   */
+  const runAgCosmosDaemon = (_nodePort, fromGo, args) => {
+    !sendChannel || Fail`Cannot start multiple agd links`;
+    const processValue = makeProcessValue({ env, args });
+    const cosmosHome = processValue.getFlag(
+      'home',
+      `${homedir}/.ag-chain-cosmos`,
+    );
+    const blockHeight = readCommittedHeight(`${cosmosHome}/data/agoric`);
+
+    sendChannel = new MessageChannel();
+    const workerURL = new URL('./vm-socket-worker.js', import.meta.url);
+    const worker = new Worker(workerURL, {
+      workerData: {
+        endpoint: dial || listen,
+        listen: !!listen,
+        framing,
+        hello: { software, blockHeight, framing },
+        signalBuffer: signal.buffer,
+        sendPort: sendChannel.port2,
+      },
+      transferList: [sendChannel.port2],
+    });
+    worker.on('message', ({ type, id, port, data, software: agd, error }) => {
+      switch (type) {
+        case 'request': {
+          fromGo(port, data, {
+            resolve: result =>
+              worker.postMessage({ type: 'reply', id, result }),
+            reject: err =>
+              worker.postMessage({ type: 'reply', id, error: `${err}` }),
+          });
+          break;
+        }
+        case 'connected': {
+          console.info(`connected to ${agd} at block ${blockHeight}`);
+          break;
+        }
+        case 'closed': {
+          console.error(`exiting for restart: ${error}`);
+          process.exit(1);
+          break;
+        }
+        default:
+      }
+    });
+    worker.on('error', err => {
+      console.error(`agd link failed, exiting for restart:`, err);
+      process.exit(1);
+    });
+  };
+
+  /**
+   * Send to an agd port, blocking until it replies, as the embedded agcc does.
+   * Errors are returned in the same form.
+   *
+   * @param {number} port
+   * @param {string} str
+   * @returns {string}
+   */
+  const send = (port, str) => {
+    sendChannel || Fail`Cannot send before the agd link is started`;
+    Atomics.store(signal, 0, 0);
+    sendChannel.port1.postMessage({ port, data: str });
+    Atomics.wait(signal, 0, 0);
+    const reply = receiveMessageOnPort(sendChannel.port1);
+    reply || Fail`No reply from the agd link`;
+    const { result, error } = reply.message;
+    if (error !== undefined) {
+      return JSON.stringify({ error });
+    }
+    return result;
+  };
+
+  return harden({ runAgCosmosDaemon, send });
+};
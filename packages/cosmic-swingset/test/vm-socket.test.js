@@ -0,0 +1,85 @@
+// @ts-check
+import test from 'ava';
+import {
+  checkAgdHello,
+  encodeHello,
+  encodeMessage,
+  makeMessageReader,
+  parseEndpoint,
+} from '../src/helpers/vm-socket-protocol.js';
+
+test('parseEndpoint', t => {
+  t.deepEqual(parseEndpoint('unix:///tmp/agvm.sock'), {
+    path: '/tmp/agvm.sock',
+  });
+  t.deepEqual(parseEndpoint('tcp://127.0.0.1:26699'), {
+    host: '127.0.0.1',
+    port: 26699,
+  });
+  t.deepEqual(parseEndpoint('tcp://[::1]:26699'), { host: '::1', port: 26699 });
+  t.throws(() => parseEndpoint('tcp://10.0.0.1:26699'), {
+    message: /not a loopback address/,
+  });
+  t.throws(() => parseEndpoint('http://127.0.0.1:80'), {
+    message: /must be unix/,
+  });
+});
+
+test('hello', t => {
+  t.deepEqual(JSON.parse(encodeHello({ blockHeight: 3, framing: 'json' })), {
+    protocol: 'agvm-jsonrpc',
+    version: 1,
+    role: 'vm',
+    blockHeight: 3,
+    framing: 'json',
+  });
+
+  const agd = { protocol: 'agvm-jsonrpc', version: 1, role: 'agd' };
+  t.notThrows(() => checkAgdHello(agd, 'json'));
+  t.notThrows(() => checkAgdHello({ ...agd, framing: 'json' }, 'json'));
+  t.throws(() => checkAgdHello({ ...agd, version: 2 }, 'json'), {
+    message: /version 2, not 1/,
+  });
+  t.throws(() => checkAgdHello(agd, 'length-prefixed'), {
+    message: /framing/,
+  });
+  t.throws(
+    () =>
+      checkAgdHello({ ...agd, error: 'VM has committed block 0, not 5' }, ''),
+    { message: /refused connection: VM has committed block 0/ },
+  );
+});
+
+for (const framing of ['json', 'length-prefixed']) {
+  test(`${framing} framing`, t => {
+    const messages = [
+      {
+        method: 'agd.ReceiveMessage',
+        params: [{ Data: '{"a":["}"]}' }],
+        id: 1,
+      },
+      { id: 2, result: 'true', error: null },
+    ];
+    const wire = Buffer.concat([
+      ...messages.map(msg => encodeMessage(framing, msg)),
+      Buffer.from(framing === 'json' ? '\n' : ''),
+    ]);
+
+    // Deliver the wire a byte at a time, to split every token.
+    const received = [];
+    const reader = makeMessageReader(framing, text =>
+      received.push(JSON.parse(text)),
+    );
+    for (let i = 0; i < wire.length; i += 1) {
+      reader(wire.subarray(i, i + 1));
+    }
+    t.deepEqual(received, messages);
+  });
+}
+
+test('json framing rejects a non-object', t => {
+  const reader = makeMessageReader('json', () => {});
+  t.throws(() => reader(Buffer.from('"oops"')), {
+    message: /not an object/,
+  });
+});
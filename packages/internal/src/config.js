@@ -19,6 +19,7 @@ export const BridgeId = {
   CORE: 'core',
   DIBC: 'dibc',
   STORAGE: 'storage',
+  SWINGSET: 'swingset',
   PROVISION: 'provision',
   PROVISION_SMART_WALLET: 'provisionWallet',
   VLOCALCHAIN: 'vlocalchain',
@@ -585,6 +585,21 @@ export const prepareIBCProtocol = (zone, powers) => {
               break;
             }
 
+            case 'asyncAckExpired': {
+              // The chain acknowledged the packet with an error in our place,
+              // so our own acknowledgement, if any, will be refused.
+              const { packet, receiveHeight, error } =
+                /** @type {IBCEvent<'asyncAckExpired'>} */ (obj);
+              console.warn(
+                'Acknowledgement deadline passed for packet',
+                packet,
+                'received at block',
+                receiveHeight,
+                ...(error ? ['and could not be acknowledged:', error] : []),
+              );
+              break;
+            }
+
             case 'channelCloseInit':
             case 'channelCloseConfirm': {
               const { portID, channelID } =
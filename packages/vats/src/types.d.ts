@@ -152,6 +152,7 @@ export type IBCBridgeEvent =
   | 'receivePacket'
   | 'acknowledgementPacket'
   | 'timeoutPacket'
+  | 'asyncAckExpired'
   | 'channelCloseInit'
   | 'channelCloseConfirm'
   | 'sendPacket';
@@ -172,6 +173,12 @@ type IBCPacketEvents = {
   timeoutPacket: {
     packet: IBCPacket;
   };
+  asyncAckExpired: {
+    packet: IBCPacket;
+    receiveHeight: number;
+    acknowledgement?: Bytes;
+    error?: string;
+  };
   channelCloseInit: ConnectingInfo; // TODO update
   channelCloseConfirm: ConnectingInfo; // TODO update
   sendPacket: { relativeTimeoutNs: bigint; packet: IBCPacket };